@@ -0,0 +1,400 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: amadeus-go/api/amadeus/admin/amadeus.admin.proto
+
+package amadeus_admin
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Period string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Date   string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetUsageRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type GetUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant         string         `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Period         string         `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Date           string         `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	UpstreamCalls  int64          `protobuf:"varint,4,opt,name=upstreamCalls,proto3" json:"upstreamCalls,omitempty"`
+	CacheHits      int64          `protobuf:"varint,5,opt,name=cacheHits,proto3" json:"cacheHits,omitempty"`
+	Quota          int64          `protobuf:"varint,6,opt,name=quota,proto3" json:"quota,omitempty"`
+	Methods        []*MethodUsage `protobuf:"bytes,7,rep,name=methods,proto3" json:"methods,omitempty"`
+	CoalescedCalls int64          `protobuf:"varint,8,opt,name=coalescedCalls,proto3" json:"coalescedCalls,omitempty"`
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetUsageResponse) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetUsageResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetUsageResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetUsageResponse) GetUpstreamCalls() int64 {
+	if x != nil {
+		return x.UpstreamCalls
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetCacheHits() int64 {
+	if x != nil {
+		return x.CacheHits
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *GetUsageResponse) GetMethods() []*MethodUsage {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *GetUsageResponse) GetCoalescedCalls() int64 {
+	if x != nil {
+		return x.CoalescedCalls
+	}
+	return 0
+}
+
+type MethodUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method         string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	UpstreamCalls  int64  `protobuf:"varint,2,opt,name=upstreamCalls,proto3" json:"upstreamCalls,omitempty"`
+	CacheHits      int64  `protobuf:"varint,3,opt,name=cacheHits,proto3" json:"cacheHits,omitempty"`
+	CoalescedCalls int64  `protobuf:"varint,4,opt,name=coalescedCalls,proto3" json:"coalescedCalls,omitempty"`
+}
+
+func (x *MethodUsage) Reset() {
+	*x = MethodUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MethodUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodUsage) ProtoMessage() {}
+
+func (x *MethodUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodUsage.ProtoReflect.Descriptor instead.
+func (*MethodUsage) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MethodUsage) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *MethodUsage) GetUpstreamCalls() int64 {
+	if x != nil {
+		return x.UpstreamCalls
+	}
+	return 0
+}
+
+func (x *MethodUsage) GetCacheHits() int64 {
+	if x != nil {
+		return x.CacheHits
+	}
+	return 0
+}
+
+func (x *MethodUsage) GetCoalescedCalls() int64 {
+	if x != nil {
+		return x.CoalescedCalls
+	}
+	return 0
+}
+
+var File_amadeus_go_api_amadeus_admin_amadeus_admin_proto protoreflect.FileDescriptor
+
+var file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDesc = []byte{
+	0x0a, 0x30, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0d, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x22, 0x55, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x61, 0x6c,
+	0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x48, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x48, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x07, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64, 0x43, 0x61,
+	0x6c, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x61, 0x6c, 0x65,
+	0x73, 0x63, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0b, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x61, 0x6c,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x48, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x48, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63,
+	0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63,
+	0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x32, 0x5b, 0x0a,
+	0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x3b, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDescOnce sync.Once
+	file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDescData = file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDesc
+)
+
+func file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDescGZIP() []byte {
+	file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDescOnce.Do(func() {
+		file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDescData)
+	})
+	return file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDescData
+}
+
+var file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_goTypes = []any{
+	(*GetUsageRequest)(nil),  // 0: amadeus.admin.GetUsageRequest
+	(*GetUsageResponse)(nil), // 1: amadeus.admin.GetUsageResponse
+	(*MethodUsage)(nil),      // 2: amadeus.admin.MethodUsage
+}
+var file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_depIdxs = []int32{
+	2, // 0: amadeus.admin.GetUsageResponse.methods:type_name -> amadeus.admin.MethodUsage
+	0, // 1: amadeus.admin.AdminService.GetUsage:input_type -> amadeus.admin.GetUsageRequest
+	1, // 2: amadeus.admin.AdminService.GetUsage:output_type -> amadeus.admin.GetUsageResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_init() }
+func file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_init() {
+	if File_amadeus_go_api_amadeus_admin_amadeus_admin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*MethodUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_goTypes,
+		DependencyIndexes: file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_depIdxs,
+		MessageInfos:      file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_msgTypes,
+	}.Build()
+	File_amadeus_go_api_amadeus_admin_amadeus_admin_proto = out.File
+	file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_rawDesc = nil
+	file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_goTypes = nil
+	file_amadeus_go_api_amadeus_admin_amadeus_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,2092 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: amadeus-go/api/amadeus/func/amadeus.func.proto
+
+package amadeus_func
+
+import (
+	_type "amadeus-go/api/amadeus/type"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FlightLowFareSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Origin        string `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination   string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	DepartureDate string `protobuf:"bytes,3,opt,name=departureDate,proto3" json:"departureDate,omitempty"`
+	ReturnDate    string `protobuf:"bytes,4,opt,name=returnDate,proto3" json:"returnDate,omitempty"`
+}
+
+func (x *FlightLowFareSearchRequest) Reset() {
+	*x = FlightLowFareSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightLowFareSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightLowFareSearchRequest) ProtoMessage() {}
+
+func (x *FlightLowFareSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightLowFareSearchRequest.ProtoReflect.Descriptor instead.
+func (*FlightLowFareSearchRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FlightLowFareSearchRequest) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *FlightLowFareSearchRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *FlightLowFareSearchRequest) GetDepartureDate() string {
+	if x != nil {
+		return x.DepartureDate
+	}
+	return ""
+}
+
+func (x *FlightLowFareSearchRequest) GetReturnDate() string {
+	if x != nil {
+		return x.ReturnDate
+	}
+	return ""
+}
+
+type FlightInspirationSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Origin   string `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	MaxPrice int32  `protobuf:"varint,2,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+}
+
+func (x *FlightInspirationSearchRequest) Reset() {
+	*x = FlightInspirationSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightInspirationSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightInspirationSearchRequest) ProtoMessage() {}
+
+func (x *FlightInspirationSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightInspirationSearchRequest.ProtoReflect.Descriptor instead.
+func (*FlightInspirationSearchRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FlightInspirationSearchRequest) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *FlightInspirationSearchRequest) GetMaxPrice() int32 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+type FlightCheapestDateSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Origin      string `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (x *FlightCheapestDateSearchRequest) Reset() {
+	*x = FlightCheapestDateSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightCheapestDateSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightCheapestDateSearchRequest) ProtoMessage() {}
+
+func (x *FlightCheapestDateSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightCheapestDateSearchRequest.ProtoReflect.Descriptor instead.
+func (*FlightCheapestDateSearchRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FlightCheapestDateSearchRequest) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *FlightCheapestDateSearchRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+type FlightCheckInLinksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AirlineCode string `protobuf:"bytes,1,opt,name=airlineCode,proto3" json:"airlineCode,omitempty"`
+}
+
+func (x *FlightCheckInLinksRequest) Reset() {
+	*x = FlightCheckInLinksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightCheckInLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightCheckInLinksRequest) ProtoMessage() {}
+
+func (x *FlightCheckInLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightCheckInLinksRequest.ProtoReflect.Descriptor instead.
+func (*FlightCheckInLinksRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FlightCheckInLinksRequest) GetAirlineCode() string {
+	if x != nil {
+		return x.AirlineCode
+	}
+	return ""
+}
+
+type FlightMostSearchedDestinationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginCityCode    string `protobuf:"bytes,1,opt,name=originCityCode,proto3" json:"originCityCode,omitempty"`
+	SearchPeriod      string `protobuf:"bytes,2,opt,name=searchPeriod,proto3" json:"searchPeriod,omitempty"`
+	MarketCountryCode string `protobuf:"bytes,3,opt,name=marketCountryCode,proto3" json:"marketCountryCode,omitempty"`
+}
+
+func (x *FlightMostSearchedDestinationsRequest) Reset() {
+	*x = FlightMostSearchedDestinationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightMostSearchedDestinationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightMostSearchedDestinationsRequest) ProtoMessage() {}
+
+func (x *FlightMostSearchedDestinationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightMostSearchedDestinationsRequest.ProtoReflect.Descriptor instead.
+func (*FlightMostSearchedDestinationsRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FlightMostSearchedDestinationsRequest) GetOriginCityCode() string {
+	if x != nil {
+		return x.OriginCityCode
+	}
+	return ""
+}
+
+func (x *FlightMostSearchedDestinationsRequest) GetSearchPeriod() string {
+	if x != nil {
+		return x.SearchPeriod
+	}
+	return ""
+}
+
+func (x *FlightMostSearchedDestinationsRequest) GetMarketCountryCode() string {
+	if x != nil {
+		return x.MarketCountryCode
+	}
+	return ""
+}
+
+type FlightMostTraveledDestinationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginCityCode string `protobuf:"bytes,1,opt,name=originCityCode,proto3" json:"originCityCode,omitempty"`
+	Period         string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *FlightMostTraveledDestinationsRequest) Reset() {
+	*x = FlightMostTraveledDestinationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightMostTraveledDestinationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightMostTraveledDestinationsRequest) ProtoMessage() {}
+
+func (x *FlightMostTraveledDestinationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightMostTraveledDestinationsRequest.ProtoReflect.Descriptor instead.
+func (*FlightMostTraveledDestinationsRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FlightMostTraveledDestinationsRequest) GetOriginCityCode() string {
+	if x != nil {
+		return x.OriginCityCode
+	}
+	return ""
+}
+
+func (x *FlightMostTraveledDestinationsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type FlightMostBookedDestinationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginCityCode string `protobuf:"bytes,1,opt,name=originCityCode,proto3" json:"originCityCode,omitempty"`
+	Period         string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (x *FlightMostBookedDestinationsRequest) Reset() {
+	*x = FlightMostBookedDestinationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightMostBookedDestinationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightMostBookedDestinationsRequest) ProtoMessage() {}
+
+func (x *FlightMostBookedDestinationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightMostBookedDestinationsRequest.ProtoReflect.Descriptor instead.
+func (*FlightMostBookedDestinationsRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FlightMostBookedDestinationsRequest) GetOriginCityCode() string {
+	if x != nil {
+		return x.OriginCityCode
+	}
+	return ""
+}
+
+func (x *FlightMostBookedDestinationsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type FlightBusiestTravelingPeriodRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityCode  string `protobuf:"bytes,1,opt,name=cityCode,proto3" json:"cityCode,omitempty"`
+	Period    string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Direction string `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+}
+
+func (x *FlightBusiestTravelingPeriodRequest) Reset() {
+	*x = FlightBusiestTravelingPeriodRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightBusiestTravelingPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightBusiestTravelingPeriodRequest) ProtoMessage() {}
+
+func (x *FlightBusiestTravelingPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightBusiestTravelingPeriodRequest.ProtoReflect.Descriptor instead.
+func (*FlightBusiestTravelingPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FlightBusiestTravelingPeriodRequest) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *FlightBusiestTravelingPeriodRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *FlightBusiestTravelingPeriodRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type AirportNearestRelevantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float32 `protobuf:"fixed32,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float32 `protobuf:"fixed32,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Sort      string  `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+}
+
+func (x *AirportNearestRelevantRequest) Reset() {
+	*x = AirportNearestRelevantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AirportNearestRelevantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirportNearestRelevantRequest) ProtoMessage() {}
+
+func (x *AirportNearestRelevantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirportNearestRelevantRequest.ProtoReflect.Descriptor instead.
+func (*AirportNearestRelevantRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AirportNearestRelevantRequest) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *AirportNearestRelevantRequest) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *AirportNearestRelevantRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type AirportAndCitySearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubType     string `protobuf:"bytes,1,opt,name=subType,proto3" json:"subType,omitempty"`
+	Keyword     string `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	CountryCode string `protobuf:"bytes,3,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+}
+
+func (x *AirportAndCitySearchRequest) Reset() {
+	*x = AirportAndCitySearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AirportAndCitySearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirportAndCitySearchRequest) ProtoMessage() {}
+
+func (x *AirportAndCitySearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirportAndCitySearchRequest.ProtoReflect.Descriptor instead.
+func (*AirportAndCitySearchRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AirportAndCitySearchRequest) GetSubType() string {
+	if x != nil {
+		return x.SubType
+	}
+	return ""
+}
+
+func (x *AirportAndCitySearchRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *AirportAndCitySearchRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+type AirlineCodeLookupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AirlineCodes string `protobuf:"bytes,1,opt,name=airlineCodes,proto3" json:"airlineCodes,omitempty"`
+}
+
+func (x *AirlineCodeLookupRequest) Reset() {
+	*x = AirlineCodeLookupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AirlineCodeLookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AirlineCodeLookupRequest) ProtoMessage() {}
+
+func (x *AirlineCodeLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AirlineCodeLookupRequest.ProtoReflect.Descriptor instead.
+func (*AirlineCodeLookupRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AirlineCodeLookupRequest) GetAirlineCodes() string {
+	if x != nil {
+		return x.AirlineCodes
+	}
+	return ""
+}
+
+type FlightMostSearchedByDestinationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginCityCode      string `protobuf:"bytes,1,opt,name=originCityCode,proto3" json:"originCityCode,omitempty"`
+	DestinationCityCode string `protobuf:"bytes,2,opt,name=destinationCityCode,proto3" json:"destinationCityCode,omitempty"`
+	SearchPeriod        string `protobuf:"bytes,3,opt,name=searchPeriod,proto3" json:"searchPeriod,omitempty"`
+	MarketCountryCode   string `protobuf:"bytes,4,opt,name=marketCountryCode,proto3" json:"marketCountryCode,omitempty"`
+}
+
+func (x *FlightMostSearchedByDestinationRequest) Reset() {
+	*x = FlightMostSearchedByDestinationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightMostSearchedByDestinationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightMostSearchedByDestinationRequest) ProtoMessage() {}
+
+func (x *FlightMostSearchedByDestinationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightMostSearchedByDestinationRequest.ProtoReflect.Descriptor instead.
+func (*FlightMostSearchedByDestinationRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FlightMostSearchedByDestinationRequest) GetOriginCityCode() string {
+	if x != nil {
+		return x.OriginCityCode
+	}
+	return ""
+}
+
+func (x *FlightMostSearchedByDestinationRequest) GetDestinationCityCode() string {
+	if x != nil {
+		return x.DestinationCityCode
+	}
+	return ""
+}
+
+func (x *FlightMostSearchedByDestinationRequest) GetSearchPeriod() string {
+	if x != nil {
+		return x.SearchPeriod
+	}
+	return ""
+}
+
+func (x *FlightMostSearchedByDestinationRequest) GetMarketCountryCode() string {
+	if x != nil {
+		return x.MarketCountryCode
+	}
+	return ""
+}
+
+type FlightOffersSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginLocationCode      string               `protobuf:"bytes,1,opt,name=originLocationCode,proto3" json:"originLocationCode,omitempty"`
+	DestinationLocationCode string               `protobuf:"bytes,2,opt,name=destinationLocationCode,proto3" json:"destinationLocationCode,omitempty"`
+	DepartureDate           string               `protobuf:"bytes,3,opt,name=departureDate,proto3" json:"departureDate,omitempty"`
+	ReturnDate              string               `protobuf:"bytes,4,opt,name=returnDate,proto3" json:"returnDate,omitempty"`
+	Adults                  int32                `protobuf:"varint,5,opt,name=adults,proto3" json:"adults,omitempty"`
+	Children                int32                `protobuf:"varint,6,opt,name=children,proto3" json:"children,omitempty"`
+	Infants                 int32                `protobuf:"varint,7,opt,name=infants,proto3" json:"infants,omitempty"`
+	TravelClass             string               `protobuf:"bytes,8,opt,name=travelClass,proto3" json:"travelClass,omitempty"`
+	IncludedAirlineCodes    []string             `protobuf:"bytes,9,rep,name=includedAirlineCodes,proto3" json:"includedAirlineCodes,omitempty"`
+	ExcludedAirlineCodes    []string             `protobuf:"bytes,10,rep,name=excludedAirlineCodes,proto3" json:"excludedAirlineCodes,omitempty"`
+	NonStop                 bool                 `protobuf:"varint,11,opt,name=nonStop,proto3" json:"nonStop,omitempty"`
+	CurrencyCode            string               `protobuf:"bytes,12,opt,name=currencyCode,proto3" json:"currencyCode,omitempty"`
+	MaxPrice                int32                `protobuf:"varint,13,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	Max                     int32                `protobuf:"varint,14,opt,name=max,proto3" json:"max,omitempty"`
+	OriginDestinations      []*OriginDestination `protobuf:"bytes,15,rep,name=originDestinations,proto3" json:"originDestinations,omitempty"`
+}
+
+func (x *FlightOffersSearchRequest) Reset() {
+	*x = FlightOffersSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightOffersSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightOffersSearchRequest) ProtoMessage() {}
+
+func (x *FlightOffersSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightOffersSearchRequest.ProtoReflect.Descriptor instead.
+func (*FlightOffersSearchRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FlightOffersSearchRequest) GetOriginLocationCode() string {
+	if x != nil {
+		return x.OriginLocationCode
+	}
+	return ""
+}
+
+func (x *FlightOffersSearchRequest) GetDestinationLocationCode() string {
+	if x != nil {
+		return x.DestinationLocationCode
+	}
+	return ""
+}
+
+func (x *FlightOffersSearchRequest) GetDepartureDate() string {
+	if x != nil {
+		return x.DepartureDate
+	}
+	return ""
+}
+
+func (x *FlightOffersSearchRequest) GetReturnDate() string {
+	if x != nil {
+		return x.ReturnDate
+	}
+	return ""
+}
+
+func (x *FlightOffersSearchRequest) GetAdults() int32 {
+	if x != nil {
+		return x.Adults
+	}
+	return 0
+}
+
+func (x *FlightOffersSearchRequest) GetChildren() int32 {
+	if x != nil {
+		return x.Children
+	}
+	return 0
+}
+
+func (x *FlightOffersSearchRequest) GetInfants() int32 {
+	if x != nil {
+		return x.Infants
+	}
+	return 0
+}
+
+func (x *FlightOffersSearchRequest) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *FlightOffersSearchRequest) GetIncludedAirlineCodes() []string {
+	if x != nil {
+		return x.IncludedAirlineCodes
+	}
+	return nil
+}
+
+func (x *FlightOffersSearchRequest) GetExcludedAirlineCodes() []string {
+	if x != nil {
+		return x.ExcludedAirlineCodes
+	}
+	return nil
+}
+
+func (x *FlightOffersSearchRequest) GetNonStop() bool {
+	if x != nil {
+		return x.NonStop
+	}
+	return false
+}
+
+func (x *FlightOffersSearchRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *FlightOffersSearchRequest) GetMaxPrice() int32 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *FlightOffersSearchRequest) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *FlightOffersSearchRequest) GetOriginDestinations() []*OriginDestination {
+	if x != nil {
+		return x.OriginDestinations
+	}
+	return nil
+}
+
+type OriginDestination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OriginLocationCode      string `protobuf:"bytes,2,opt,name=originLocationCode,proto3" json:"originLocationCode,omitempty"`
+	DestinationLocationCode string `protobuf:"bytes,3,opt,name=destinationLocationCode,proto3" json:"destinationLocationCode,omitempty"`
+	DepartureDate           string `protobuf:"bytes,4,opt,name=departureDate,proto3" json:"departureDate,omitempty"`
+	DepartureTime           string `protobuf:"bytes,5,opt,name=departureTime,proto3" json:"departureTime,omitempty"`
+}
+
+func (x *OriginDestination) Reset() {
+	*x = OriginDestination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OriginDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OriginDestination) ProtoMessage() {}
+
+func (x *OriginDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OriginDestination.ProtoReflect.Descriptor instead.
+func (*OriginDestination) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OriginDestination) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OriginDestination) GetOriginLocationCode() string {
+	if x != nil {
+		return x.OriginLocationCode
+	}
+	return ""
+}
+
+func (x *OriginDestination) GetDestinationLocationCode() string {
+	if x != nil {
+		return x.DestinationLocationCode
+	}
+	return ""
+}
+
+func (x *OriginDestination) GetDepartureDate() string {
+	if x != nil {
+		return x.DepartureDate
+	}
+	return ""
+}
+
+func (x *OriginDestination) GetDepartureTime() string {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return ""
+}
+
+type FlightOffersPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlightOffers []*_type.FlightOffer `protobuf:"bytes,1,rep,name=flightOffers,proto3" json:"flightOffers,omitempty"`
+	Include      []string             `protobuf:"bytes,2,rep,name=include,proto3" json:"include,omitempty"`
+}
+
+func (x *FlightOffersPriceRequest) Reset() {
+	*x = FlightOffersPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightOffersPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightOffersPriceRequest) ProtoMessage() {}
+
+func (x *FlightOffersPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightOffersPriceRequest.ProtoReflect.Descriptor instead.
+func (*FlightOffersPriceRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FlightOffersPriceRequest) GetFlightOffers() []*_type.FlightOffer {
+	if x != nil {
+		return x.FlightOffers
+	}
+	return nil
+}
+
+func (x *FlightOffersPriceRequest) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+type CreateFlightOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlightOffers   []*_type.FlightOffer `protobuf:"bytes,1,rep,name=flightOffers,proto3" json:"flightOffers,omitempty"`
+	Travelers      []*_type.Traveler    `protobuf:"bytes,2,rep,name=travelers,proto3" json:"travelers,omitempty"`
+	Contacts       []*_type.Contact     `protobuf:"bytes,3,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	IdempotencyKey string               `protobuf:"bytes,4,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *CreateFlightOrderRequest) Reset() {
+	*x = CreateFlightOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFlightOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFlightOrderRequest) ProtoMessage() {}
+
+func (x *CreateFlightOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFlightOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateFlightOrderRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateFlightOrderRequest) GetFlightOffers() []*_type.FlightOffer {
+	if x != nil {
+		return x.FlightOffers
+	}
+	return nil
+}
+
+func (x *CreateFlightOrderRequest) GetTravelers() []*_type.Traveler {
+	if x != nil {
+		return x.Travelers
+	}
+	return nil
+}
+
+func (x *CreateFlightOrderRequest) GetContacts() []*_type.Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+func (x *CreateFlightOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type GetFlightOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlightOrderId string `protobuf:"bytes,1,opt,name=flightOrderId,proto3" json:"flightOrderId,omitempty"`
+}
+
+func (x *GetFlightOrderRequest) Reset() {
+	*x = GetFlightOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFlightOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlightOrderRequest) ProtoMessage() {}
+
+func (x *GetFlightOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlightOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetFlightOrderRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetFlightOrderRequest) GetFlightOrderId() string {
+	if x != nil {
+		return x.FlightOrderId
+	}
+	return ""
+}
+
+type CancelFlightOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlightOrderId string `protobuf:"bytes,1,opt,name=flightOrderId,proto3" json:"flightOrderId,omitempty"`
+}
+
+func (x *CancelFlightOrderRequest) Reset() {
+	*x = CancelFlightOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelFlightOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFlightOrderRequest) ProtoMessage() {}
+
+func (x *CancelFlightOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFlightOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelFlightOrderRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CancelFlightOrderRequest) GetFlightOrderId() string {
+	if x != nil {
+		return x.FlightOrderId
+	}
+	return ""
+}
+
+type SeatMapDisplayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlightOffers  []*_type.FlightOffer `protobuf:"bytes,1,rep,name=flightOffers,proto3" json:"flightOffers,omitempty"`
+	FlightOrderId string               `protobuf:"bytes,2,opt,name=flightOrderId,proto3" json:"flightOrderId,omitempty"`
+}
+
+func (x *SeatMapDisplayRequest) Reset() {
+	*x = SeatMapDisplayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatMapDisplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMapDisplayRequest) ProtoMessage() {}
+
+func (x *SeatMapDisplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMapDisplayRequest.ProtoReflect.Descriptor instead.
+func (*SeatMapDisplayRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SeatMapDisplayRequest) GetFlightOffers() []*_type.FlightOffer {
+	if x != nil {
+		return x.FlightOffers
+	}
+	return nil
+}
+
+func (x *SeatMapDisplayRequest) GetFlightOrderId() string {
+	if x != nil {
+		return x.FlightOrderId
+	}
+	return ""
+}
+
+type BrandedFaresUpsellRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlightOffers []*_type.FlightOffer `protobuf:"bytes,1,rep,name=flightOffers,proto3" json:"flightOffers,omitempty"`
+}
+
+func (x *BrandedFaresUpsellRequest) Reset() {
+	*x = BrandedFaresUpsellRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BrandedFaresUpsellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandedFaresUpsellRequest) ProtoMessage() {}
+
+func (x *BrandedFaresUpsellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandedFaresUpsellRequest.ProtoReflect.Descriptor instead.
+func (*BrandedFaresUpsellRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BrandedFaresUpsellRequest) GetFlightOffers() []*_type.FlightOffer {
+	if x != nil {
+		return x.FlightOffers
+	}
+	return nil
+}
+
+type FlightAvailabilitiesSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginDestinations   []*OriginDestination `protobuf:"bytes,1,rep,name=originDestinations,proto3" json:"originDestinations,omitempty"`
+	Adults               int32                `protobuf:"varint,2,opt,name=adults,proto3" json:"adults,omitempty"`
+	Children             int32                `protobuf:"varint,3,opt,name=children,proto3" json:"children,omitempty"`
+	IncludedAirlineCodes []string             `protobuf:"bytes,4,rep,name=includedAirlineCodes,proto3" json:"includedAirlineCodes,omitempty"`
+	ExcludedAirlineCodes []string             `protobuf:"bytes,5,rep,name=excludedAirlineCodes,proto3" json:"excludedAirlineCodes,omitempty"`
+}
+
+func (x *FlightAvailabilitiesSearchRequest) Reset() {
+	*x = FlightAvailabilitiesSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightAvailabilitiesSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightAvailabilitiesSearchRequest) ProtoMessage() {}
+
+func (x *FlightAvailabilitiesSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightAvailabilitiesSearchRequest.ProtoReflect.Descriptor instead.
+func (*FlightAvailabilitiesSearchRequest) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FlightAvailabilitiesSearchRequest) GetOriginDestinations() []*OriginDestination {
+	if x != nil {
+		return x.OriginDestinations
+	}
+	return nil
+}
+
+func (x *FlightAvailabilitiesSearchRequest) GetAdults() int32 {
+	if x != nil {
+		return x.Adults
+	}
+	return 0
+}
+
+func (x *FlightAvailabilitiesSearchRequest) GetChildren() int32 {
+	if x != nil {
+		return x.Children
+	}
+	return 0
+}
+
+func (x *FlightAvailabilitiesSearchRequest) GetIncludedAirlineCodes() []string {
+	if x != nil {
+		return x.IncludedAirlineCodes
+	}
+	return nil
+}
+
+func (x *FlightAvailabilitiesSearchRequest) GetExcludedAirlineCodes() []string {
+	if x != nil {
+		return x.ExcludedAirlineCodes
+	}
+	return nil
+}
+
+var File_amadeus_go_api_amadeus_func_amadeus_func_proto protoreflect.FileDescriptor
+
+var file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2f, 0x66, 0x75, 0x6e, 0x63, 0x2f, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0c, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x1a, 0x2e,
+	0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c,
+	0x01, 0x0a, 0x1a, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x6f, 0x77, 0x46, 0x61, 0x72, 0x65,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x54, 0x0a,
+	0x1e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x22, 0x5b, 0x0a, 0x1f, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x68, 0x65,
+	0x61, 0x70, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x3d, 0x0a, 0x19, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49,
+	0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0xa1, 0x01, 0x0a, 0x25, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x67, 0x0a, 0x25, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6f, 0x73,
+	0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x65, 0x0a, 0x23,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x65, 0x64,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x22, 0x77, 0x0a, 0x23, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x75, 0x73,
+	0x69, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x1d,
+	0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x73, 0x0a, 0x1b, 0x41,
+	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x64, 0x43, 0x69, 0x74, 0x79, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x22, 0x3e, 0x0a, 0x18, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73,
+	0x22, 0xd4, 0x01, 0x0a, 0x26, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x42, 0x79, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xe0, 0x04, 0x0a, 0x19, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x64, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x66,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x6e, 0x66, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x41, 0x69, 0x72,
+	0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6e, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x6e, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x4f, 0x0a, 0x12, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x66, 0x75, 0x6e, 0x63, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x11, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x38, 0x0a, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x73, 0x0a, 0x18, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x52, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0xea, 0x01, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x76, 0x65,
+	0x6c, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c,
+	0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x3d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x15, 0x53, 0x65, 0x61,
+	0x74, 0x4d, 0x61, 0x70, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x52, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x19, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x65, 0x64, 0x46, 0x61, 0x72, 0x65, 0x73, 0x55, 0x70, 0x73, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x22, 0x90, 0x02, 0x0a, 0x21, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x12, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x66, 0x75, 0x6e, 0x63, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x64, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x32,
+	0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x41, 0x69,
+	0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x32, 0xd5, 0x0f, 0x0a, 0x0e, 0x41, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x13, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x4c, 0x6f, 0x77, 0x46, 0x61, 0x72, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x28, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x6f, 0x77, 0x46, 0x61, 0x72, 0x65, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x17, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x73, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2c, 0x2e,
+	0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x49, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x18, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x68, 0x65,
+	0x61, 0x70, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x2d, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x1e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x4d, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6f,
+	0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x1f,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x65, 0x64, 0x42, 0x79, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x34, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x64, 0x42, 0x79, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a,
+	0x1e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65,
+	0x6c, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x33, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65,
+	0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x1c,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x65, 0x64,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x1c, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x42, 0x75, 0x73, 0x69, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x69, 0x6e,
+	0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x31, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75,
+	0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x75, 0x73,
+	0x69, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5d, 0x0a, 0x16, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x65, 0x61,
+	0x72, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x41, 0x69, 0x72, 0x70,
+	0x6f, 0x72, 0x74, 0x4e, 0x65, 0x61, 0x72, 0x65, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x59, 0x0a, 0x14, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x64, 0x43,
+	0x69, 0x74, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x29, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x6e, 0x64, 0x43, 0x69, 0x74, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x11,
+	0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x12, 0x26, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63,
+	0x2e, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x61, 0x0a, 0x12, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x27, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75,
+	0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x26, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0e, 0x53, 0x65,
+	0x61, 0x74, 0x4d, 0x61, 0x70, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x23, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x74,
+	0x4d, 0x61, 0x70, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x61, 0x0a, 0x12, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x46, 0x61, 0x72, 0x65, 0x73,
+	0x55, 0x70, 0x73, 0x65, 0x6c, 0x6c, 0x12, 0x27, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73,
+	0x2e, 0x66, 0x75, 0x6e, 0x63, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x46, 0x61, 0x72,
+	0x65, 0x73, 0x55, 0x70, 0x73, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x1a, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x2f, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x66, 0x75, 0x6e, 0x63,
+	0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2a,
+	0x5a, 0x28, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2f, 0x66, 0x75, 0x6e, 0x63, 0x3b, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescOnce sync.Once
+	file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescData = file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDesc
+)
+
+func file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescGZIP() []byte {
+	file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescOnce.Do(func() {
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescData = protoimpl.X.CompressGZIP(file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescData)
+	})
+	return file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDescData
+}
+
+var file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_amadeus_go_api_amadeus_func_amadeus_func_proto_goTypes = []any{
+	(*FlightLowFareSearchRequest)(nil),             // 0: amadeus.func.FlightLowFareSearchRequest
+	(*FlightInspirationSearchRequest)(nil),         // 1: amadeus.func.FlightInspirationSearchRequest
+	(*FlightCheapestDateSearchRequest)(nil),        // 2: amadeus.func.FlightCheapestDateSearchRequest
+	(*FlightCheckInLinksRequest)(nil),              // 3: amadeus.func.FlightCheckInLinksRequest
+	(*FlightMostSearchedDestinationsRequest)(nil),  // 4: amadeus.func.FlightMostSearchedDestinationsRequest
+	(*FlightMostTraveledDestinationsRequest)(nil),  // 5: amadeus.func.FlightMostTraveledDestinationsRequest
+	(*FlightMostBookedDestinationsRequest)(nil),    // 6: amadeus.func.FlightMostBookedDestinationsRequest
+	(*FlightBusiestTravelingPeriodRequest)(nil),    // 7: amadeus.func.FlightBusiestTravelingPeriodRequest
+	(*AirportNearestRelevantRequest)(nil),          // 8: amadeus.func.AirportNearestRelevantRequest
+	(*AirportAndCitySearchRequest)(nil),            // 9: amadeus.func.AirportAndCitySearchRequest
+	(*AirlineCodeLookupRequest)(nil),               // 10: amadeus.func.AirlineCodeLookupRequest
+	(*FlightMostSearchedByDestinationRequest)(nil), // 11: amadeus.func.FlightMostSearchedByDestinationRequest
+	(*FlightOffersSearchRequest)(nil),              // 12: amadeus.func.FlightOffersSearchRequest
+	(*OriginDestination)(nil),                      // 13: amadeus.func.OriginDestination
+	(*FlightOffersPriceRequest)(nil),               // 14: amadeus.func.FlightOffersPriceRequest
+	(*CreateFlightOrderRequest)(nil),               // 15: amadeus.func.CreateFlightOrderRequest
+	(*GetFlightOrderRequest)(nil),                  // 16: amadeus.func.GetFlightOrderRequest
+	(*CancelFlightOrderRequest)(nil),               // 17: amadeus.func.CancelFlightOrderRequest
+	(*SeatMapDisplayRequest)(nil),                  // 18: amadeus.func.SeatMapDisplayRequest
+	(*BrandedFaresUpsellRequest)(nil),              // 19: amadeus.func.BrandedFaresUpsellRequest
+	(*FlightAvailabilitiesSearchRequest)(nil),      // 20: amadeus.func.FlightAvailabilitiesSearchRequest
+	(*_type.FlightOffer)(nil),                      // 21: amadeus.type.FlightOffer
+	(*_type.Traveler)(nil),                         // 22: amadeus.type.Traveler
+	(*_type.Contact)(nil),                          // 23: amadeus.type.Contact
+	(*_type.Response)(nil),                         // 24: amadeus.type.Response
+	(*_type.FlightOffersResponse)(nil),             // 25: amadeus.type.FlightOffersResponse
+	(*_type.FlightOffersPriceResponse)(nil),        // 26: amadeus.type.FlightOffersPriceResponse
+	(*_type.FlightOrderResponse)(nil),              // 27: amadeus.type.FlightOrderResponse
+	(*_type.CancelFlightOrderResponse)(nil),        // 28: amadeus.type.CancelFlightOrderResponse
+	(*_type.SeatMapResponse)(nil),                  // 29: amadeus.type.SeatMapResponse
+	(*_type.FlightAvailabilitiesResponse)(nil),     // 30: amadeus.type.FlightAvailabilitiesResponse
+}
+var file_amadeus_go_api_amadeus_func_amadeus_func_proto_depIdxs = []int32{
+	13, // 0: amadeus.func.FlightOffersSearchRequest.originDestinations:type_name -> amadeus.func.OriginDestination
+	21, // 1: amadeus.func.FlightOffersPriceRequest.flightOffers:type_name -> amadeus.type.FlightOffer
+	21, // 2: amadeus.func.CreateFlightOrderRequest.flightOffers:type_name -> amadeus.type.FlightOffer
+	22, // 3: amadeus.func.CreateFlightOrderRequest.travelers:type_name -> amadeus.type.Traveler
+	23, // 4: amadeus.func.CreateFlightOrderRequest.contacts:type_name -> amadeus.type.Contact
+	21, // 5: amadeus.func.SeatMapDisplayRequest.flightOffers:type_name -> amadeus.type.FlightOffer
+	21, // 6: amadeus.func.BrandedFaresUpsellRequest.flightOffers:type_name -> amadeus.type.FlightOffer
+	13, // 7: amadeus.func.FlightAvailabilitiesSearchRequest.originDestinations:type_name -> amadeus.func.OriginDestination
+	0,  // 8: amadeus.func.AmadeusService.FlightLowFareSearch:input_type -> amadeus.func.FlightLowFareSearchRequest
+	1,  // 9: amadeus.func.AmadeusService.FlightInspirationSearch:input_type -> amadeus.func.FlightInspirationSearchRequest
+	2,  // 10: amadeus.func.AmadeusService.FlightCheapestDateSearch:input_type -> amadeus.func.FlightCheapestDateSearchRequest
+	4,  // 11: amadeus.func.AmadeusService.FlightMostSearchedDestinations:input_type -> amadeus.func.FlightMostSearchedDestinationsRequest
+	3,  // 12: amadeus.func.AmadeusService.FlightCheckInLinks:input_type -> amadeus.func.FlightCheckInLinksRequest
+	11, // 13: amadeus.func.AmadeusService.FlightMostSearchedByDestination:input_type -> amadeus.func.FlightMostSearchedByDestinationRequest
+	5,  // 14: amadeus.func.AmadeusService.FlightMostTraveledDestinations:input_type -> amadeus.func.FlightMostTraveledDestinationsRequest
+	6,  // 15: amadeus.func.AmadeusService.FlightMostBookedDestinations:input_type -> amadeus.func.FlightMostBookedDestinationsRequest
+	7,  // 16: amadeus.func.AmadeusService.FlightBusiestTravelingPeriod:input_type -> amadeus.func.FlightBusiestTravelingPeriodRequest
+	8,  // 17: amadeus.func.AmadeusService.AirportNearestRelevant:input_type -> amadeus.func.AirportNearestRelevantRequest
+	9,  // 18: amadeus.func.AmadeusService.AirportAndCitySearch:input_type -> amadeus.func.AirportAndCitySearchRequest
+	10, // 19: amadeus.func.AmadeusService.AirlineCodeLookup:input_type -> amadeus.func.AirlineCodeLookupRequest
+	12, // 20: amadeus.func.AmadeusService.FlightOffersSearch:input_type -> amadeus.func.FlightOffersSearchRequest
+	14, // 21: amadeus.func.AmadeusService.FlightOffersPrice:input_type -> amadeus.func.FlightOffersPriceRequest
+	15, // 22: amadeus.func.AmadeusService.CreateFlightOrder:input_type -> amadeus.func.CreateFlightOrderRequest
+	16, // 23: amadeus.func.AmadeusService.GetFlightOrder:input_type -> amadeus.func.GetFlightOrderRequest
+	17, // 24: amadeus.func.AmadeusService.CancelFlightOrder:input_type -> amadeus.func.CancelFlightOrderRequest
+	18, // 25: amadeus.func.AmadeusService.SeatMapDisplay:input_type -> amadeus.func.SeatMapDisplayRequest
+	19, // 26: amadeus.func.AmadeusService.BrandedFaresUpsell:input_type -> amadeus.func.BrandedFaresUpsellRequest
+	20, // 27: amadeus.func.AmadeusService.FlightAvailabilitiesSearch:input_type -> amadeus.func.FlightAvailabilitiesSearchRequest
+	24, // 28: amadeus.func.AmadeusService.FlightLowFareSearch:output_type -> amadeus.type.Response
+	24, // 29: amadeus.func.AmadeusService.FlightInspirationSearch:output_type -> amadeus.type.Response
+	24, // 30: amadeus.func.AmadeusService.FlightCheapestDateSearch:output_type -> amadeus.type.Response
+	24, // 31: amadeus.func.AmadeusService.FlightMostSearchedDestinations:output_type -> amadeus.type.Response
+	24, // 32: amadeus.func.AmadeusService.FlightCheckInLinks:output_type -> amadeus.type.Response
+	24, // 33: amadeus.func.AmadeusService.FlightMostSearchedByDestination:output_type -> amadeus.type.Response
+	24, // 34: amadeus.func.AmadeusService.FlightMostTraveledDestinations:output_type -> amadeus.type.Response
+	24, // 35: amadeus.func.AmadeusService.FlightMostBookedDestinations:output_type -> amadeus.type.Response
+	24, // 36: amadeus.func.AmadeusService.FlightBusiestTravelingPeriod:output_type -> amadeus.type.Response
+	24, // 37: amadeus.func.AmadeusService.AirportNearestRelevant:output_type -> amadeus.type.Response
+	24, // 38: amadeus.func.AmadeusService.AirportAndCitySearch:output_type -> amadeus.type.Response
+	24, // 39: amadeus.func.AmadeusService.AirlineCodeLookup:output_type -> amadeus.type.Response
+	25, // 40: amadeus.func.AmadeusService.FlightOffersSearch:output_type -> amadeus.type.FlightOffersResponse
+	26, // 41: amadeus.func.AmadeusService.FlightOffersPrice:output_type -> amadeus.type.FlightOffersPriceResponse
+	27, // 42: amadeus.func.AmadeusService.CreateFlightOrder:output_type -> amadeus.type.FlightOrderResponse
+	27, // 43: amadeus.func.AmadeusService.GetFlightOrder:output_type -> amadeus.type.FlightOrderResponse
+	28, // 44: amadeus.func.AmadeusService.CancelFlightOrder:output_type -> amadeus.type.CancelFlightOrderResponse
+	29, // 45: amadeus.func.AmadeusService.SeatMapDisplay:output_type -> amadeus.type.SeatMapResponse
+	25, // 46: amadeus.func.AmadeusService.BrandedFaresUpsell:output_type -> amadeus.type.FlightOffersResponse
+	30, // 47: amadeus.func.AmadeusService.FlightAvailabilitiesSearch:output_type -> amadeus.type.FlightAvailabilitiesResponse
+	28, // [28:48] is the sub-list for method output_type
+	8,  // [8:28] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_amadeus_go_api_amadeus_func_amadeus_func_proto_init() }
+func file_amadeus_go_api_amadeus_func_amadeus_func_proto_init() {
+	if File_amadeus_go_api_amadeus_func_amadeus_func_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightLowFareSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightInspirationSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightCheapestDateSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightCheckInLinksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightMostSearchedDestinationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightMostTraveledDestinationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightMostBookedDestinationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightBusiestTravelingPeriodRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*AirportNearestRelevantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*AirportAndCitySearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*AirlineCodeLookupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightMostSearchedByDestinationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightOffersSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*OriginDestination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightOffersPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFlightOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*GetFlightOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelFlightOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatMapDisplayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*BrandedFaresUpsellRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightAvailabilitiesSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_amadeus_go_api_amadeus_func_amadeus_func_proto_goTypes,
+		DependencyIndexes: file_amadeus_go_api_amadeus_func_amadeus_func_proto_depIdxs,
+		MessageInfos:      file_amadeus_go_api_amadeus_func_amadeus_func_proto_msgTypes,
+	}.Build()
+	File_amadeus_go_api_amadeus_func_amadeus_func_proto = out.File
+	file_amadeus_go_api_amadeus_func_amadeus_func_proto_rawDesc = nil
+	file_amadeus_go_api_amadeus_func_amadeus_func_proto_goTypes = nil
+	file_amadeus_go_api_amadeus_func_amadeus_func_proto_depIdxs = nil
+}
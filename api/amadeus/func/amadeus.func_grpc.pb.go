@@ -0,0 +1,811 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: amadeus-go/api/amadeus/func/amadeus.func.proto
+
+package amadeus_func
+
+import (
+	_type "amadeus-go/api/amadeus/type"
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AmadeusService_FlightLowFareSearch_FullMethodName             = "/amadeus.func.AmadeusService/FlightLowFareSearch"
+	AmadeusService_FlightInspirationSearch_FullMethodName         = "/amadeus.func.AmadeusService/FlightInspirationSearch"
+	AmadeusService_FlightCheapestDateSearch_FullMethodName        = "/amadeus.func.AmadeusService/FlightCheapestDateSearch"
+	AmadeusService_FlightMostSearchedDestinations_FullMethodName  = "/amadeus.func.AmadeusService/FlightMostSearchedDestinations"
+	AmadeusService_FlightCheckInLinks_FullMethodName              = "/amadeus.func.AmadeusService/FlightCheckInLinks"
+	AmadeusService_FlightMostSearchedByDestination_FullMethodName = "/amadeus.func.AmadeusService/FlightMostSearchedByDestination"
+	AmadeusService_FlightMostTraveledDestinations_FullMethodName  = "/amadeus.func.AmadeusService/FlightMostTraveledDestinations"
+	AmadeusService_FlightMostBookedDestinations_FullMethodName    = "/amadeus.func.AmadeusService/FlightMostBookedDestinations"
+	AmadeusService_FlightBusiestTravelingPeriod_FullMethodName    = "/amadeus.func.AmadeusService/FlightBusiestTravelingPeriod"
+	AmadeusService_AirportNearestRelevant_FullMethodName          = "/amadeus.func.AmadeusService/AirportNearestRelevant"
+	AmadeusService_AirportAndCitySearch_FullMethodName            = "/amadeus.func.AmadeusService/AirportAndCitySearch"
+	AmadeusService_AirlineCodeLookup_FullMethodName               = "/amadeus.func.AmadeusService/AirlineCodeLookup"
+	AmadeusService_FlightOffersSearch_FullMethodName              = "/amadeus.func.AmadeusService/FlightOffersSearch"
+	AmadeusService_FlightOffersPrice_FullMethodName               = "/amadeus.func.AmadeusService/FlightOffersPrice"
+	AmadeusService_CreateFlightOrder_FullMethodName               = "/amadeus.func.AmadeusService/CreateFlightOrder"
+	AmadeusService_GetFlightOrder_FullMethodName                  = "/amadeus.func.AmadeusService/GetFlightOrder"
+	AmadeusService_CancelFlightOrder_FullMethodName               = "/amadeus.func.AmadeusService/CancelFlightOrder"
+	AmadeusService_SeatMapDisplay_FullMethodName                  = "/amadeus.func.AmadeusService/SeatMapDisplay"
+	AmadeusService_BrandedFaresUpsell_FullMethodName              = "/amadeus.func.AmadeusService/BrandedFaresUpsell"
+	AmadeusService_FlightAvailabilitiesSearch_FullMethodName      = "/amadeus.func.AmadeusService/FlightAvailabilitiesSearch"
+)
+
+// AmadeusServiceClient is the client API for AmadeusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AmadeusServiceClient interface {
+	FlightLowFareSearch(ctx context.Context, in *FlightLowFareSearchRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	FlightInspirationSearch(ctx context.Context, in *FlightInspirationSearchRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	FlightCheapestDateSearch(ctx context.Context, in *FlightCheapestDateSearchRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	FlightMostSearchedDestinations(ctx context.Context, in *FlightMostSearchedDestinationsRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	FlightCheckInLinks(ctx context.Context, in *FlightCheckInLinksRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	FlightMostSearchedByDestination(ctx context.Context, in *FlightMostSearchedByDestinationRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	FlightMostTraveledDestinations(ctx context.Context, in *FlightMostTraveledDestinationsRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	FlightMostBookedDestinations(ctx context.Context, in *FlightMostBookedDestinationsRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	FlightBusiestTravelingPeriod(ctx context.Context, in *FlightBusiestTravelingPeriodRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	AirportNearestRelevant(ctx context.Context, in *AirportNearestRelevantRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	AirportAndCitySearch(ctx context.Context, in *AirportAndCitySearchRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	AirlineCodeLookup(ctx context.Context, in *AirlineCodeLookupRequest, opts ...grpc.CallOption) (*_type.Response, error)
+	FlightOffersSearch(ctx context.Context, in *FlightOffersSearchRequest, opts ...grpc.CallOption) (*_type.FlightOffersResponse, error)
+	FlightOffersPrice(ctx context.Context, in *FlightOffersPriceRequest, opts ...grpc.CallOption) (*_type.FlightOffersPriceResponse, error)
+	CreateFlightOrder(ctx context.Context, in *CreateFlightOrderRequest, opts ...grpc.CallOption) (*_type.FlightOrderResponse, error)
+	GetFlightOrder(ctx context.Context, in *GetFlightOrderRequest, opts ...grpc.CallOption) (*_type.FlightOrderResponse, error)
+	CancelFlightOrder(ctx context.Context, in *CancelFlightOrderRequest, opts ...grpc.CallOption) (*_type.CancelFlightOrderResponse, error)
+	SeatMapDisplay(ctx context.Context, in *SeatMapDisplayRequest, opts ...grpc.CallOption) (*_type.SeatMapResponse, error)
+	BrandedFaresUpsell(ctx context.Context, in *BrandedFaresUpsellRequest, opts ...grpc.CallOption) (*_type.FlightOffersResponse, error)
+	FlightAvailabilitiesSearch(ctx context.Context, in *FlightAvailabilitiesSearchRequest, opts ...grpc.CallOption) (*_type.FlightAvailabilitiesResponse, error)
+}
+
+type amadeusServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAmadeusServiceClient(cc grpc.ClientConnInterface) AmadeusServiceClient {
+	return &amadeusServiceClient{cc}
+}
+
+func (c *amadeusServiceClient) FlightLowFareSearch(ctx context.Context, in *FlightLowFareSearchRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightLowFareSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) FlightInspirationSearch(ctx context.Context, in *FlightInspirationSearchRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightInspirationSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) FlightCheapestDateSearch(ctx context.Context, in *FlightCheapestDateSearchRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightCheapestDateSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) FlightMostSearchedDestinations(ctx context.Context, in *FlightMostSearchedDestinationsRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightMostSearchedDestinations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) FlightCheckInLinks(ctx context.Context, in *FlightCheckInLinksRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightCheckInLinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) FlightMostSearchedByDestination(ctx context.Context, in *FlightMostSearchedByDestinationRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightMostSearchedByDestination_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) FlightMostTraveledDestinations(ctx context.Context, in *FlightMostTraveledDestinationsRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightMostTraveledDestinations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) FlightMostBookedDestinations(ctx context.Context, in *FlightMostBookedDestinationsRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightMostBookedDestinations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) FlightBusiestTravelingPeriod(ctx context.Context, in *FlightBusiestTravelingPeriodRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightBusiestTravelingPeriod_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) AirportNearestRelevant(ctx context.Context, in *AirportNearestRelevantRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_AirportNearestRelevant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) AirportAndCitySearch(ctx context.Context, in *AirportAndCitySearchRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_AirportAndCitySearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) AirlineCodeLookup(ctx context.Context, in *AirlineCodeLookupRequest, opts ...grpc.CallOption) (*_type.Response, error) {
+	out := new(_type.Response)
+	err := c.cc.Invoke(ctx, AmadeusService_AirlineCodeLookup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) FlightOffersSearch(ctx context.Context, in *FlightOffersSearchRequest, opts ...grpc.CallOption) (*_type.FlightOffersResponse, error) {
+	out := new(_type.FlightOffersResponse)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightOffersSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) FlightOffersPrice(ctx context.Context, in *FlightOffersPriceRequest, opts ...grpc.CallOption) (*_type.FlightOffersPriceResponse, error) {
+	out := new(_type.FlightOffersPriceResponse)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightOffersPrice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) CreateFlightOrder(ctx context.Context, in *CreateFlightOrderRequest, opts ...grpc.CallOption) (*_type.FlightOrderResponse, error) {
+	out := new(_type.FlightOrderResponse)
+	err := c.cc.Invoke(ctx, AmadeusService_CreateFlightOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) GetFlightOrder(ctx context.Context, in *GetFlightOrderRequest, opts ...grpc.CallOption) (*_type.FlightOrderResponse, error) {
+	out := new(_type.FlightOrderResponse)
+	err := c.cc.Invoke(ctx, AmadeusService_GetFlightOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) CancelFlightOrder(ctx context.Context, in *CancelFlightOrderRequest, opts ...grpc.CallOption) (*_type.CancelFlightOrderResponse, error) {
+	out := new(_type.CancelFlightOrderResponse)
+	err := c.cc.Invoke(ctx, AmadeusService_CancelFlightOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) SeatMapDisplay(ctx context.Context, in *SeatMapDisplayRequest, opts ...grpc.CallOption) (*_type.SeatMapResponse, error) {
+	out := new(_type.SeatMapResponse)
+	err := c.cc.Invoke(ctx, AmadeusService_SeatMapDisplay_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) BrandedFaresUpsell(ctx context.Context, in *BrandedFaresUpsellRequest, opts ...grpc.CallOption) (*_type.FlightOffersResponse, error) {
+	out := new(_type.FlightOffersResponse)
+	err := c.cc.Invoke(ctx, AmadeusService_BrandedFaresUpsell_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *amadeusServiceClient) FlightAvailabilitiesSearch(ctx context.Context, in *FlightAvailabilitiesSearchRequest, opts ...grpc.CallOption) (*_type.FlightAvailabilitiesResponse, error) {
+	out := new(_type.FlightAvailabilitiesResponse)
+	err := c.cc.Invoke(ctx, AmadeusService_FlightAvailabilitiesSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AmadeusServiceServer is the server API for AmadeusService service.
+// All implementations should embed UnimplementedAmadeusServiceServer
+// for forward compatibility
+type AmadeusServiceServer interface {
+	FlightLowFareSearch(context.Context, *FlightLowFareSearchRequest) (*_type.Response, error)
+	FlightInspirationSearch(context.Context, *FlightInspirationSearchRequest) (*_type.Response, error)
+	FlightCheapestDateSearch(context.Context, *FlightCheapestDateSearchRequest) (*_type.Response, error)
+	FlightMostSearchedDestinations(context.Context, *FlightMostSearchedDestinationsRequest) (*_type.Response, error)
+	FlightCheckInLinks(context.Context, *FlightCheckInLinksRequest) (*_type.Response, error)
+	FlightMostSearchedByDestination(context.Context, *FlightMostSearchedByDestinationRequest) (*_type.Response, error)
+	FlightMostTraveledDestinations(context.Context, *FlightMostTraveledDestinationsRequest) (*_type.Response, error)
+	FlightMostBookedDestinations(context.Context, *FlightMostBookedDestinationsRequest) (*_type.Response, error)
+	FlightBusiestTravelingPeriod(context.Context, *FlightBusiestTravelingPeriodRequest) (*_type.Response, error)
+	AirportNearestRelevant(context.Context, *AirportNearestRelevantRequest) (*_type.Response, error)
+	AirportAndCitySearch(context.Context, *AirportAndCitySearchRequest) (*_type.Response, error)
+	AirlineCodeLookup(context.Context, *AirlineCodeLookupRequest) (*_type.Response, error)
+	FlightOffersSearch(context.Context, *FlightOffersSearchRequest) (*_type.FlightOffersResponse, error)
+	FlightOffersPrice(context.Context, *FlightOffersPriceRequest) (*_type.FlightOffersPriceResponse, error)
+	CreateFlightOrder(context.Context, *CreateFlightOrderRequest) (*_type.FlightOrderResponse, error)
+	GetFlightOrder(context.Context, *GetFlightOrderRequest) (*_type.FlightOrderResponse, error)
+	CancelFlightOrder(context.Context, *CancelFlightOrderRequest) (*_type.CancelFlightOrderResponse, error)
+	SeatMapDisplay(context.Context, *SeatMapDisplayRequest) (*_type.SeatMapResponse, error)
+	BrandedFaresUpsell(context.Context, *BrandedFaresUpsellRequest) (*_type.FlightOffersResponse, error)
+	FlightAvailabilitiesSearch(context.Context, *FlightAvailabilitiesSearchRequest) (*_type.FlightAvailabilitiesResponse, error)
+}
+
+// UnimplementedAmadeusServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedAmadeusServiceServer struct {
+}
+
+func (UnimplementedAmadeusServiceServer) FlightLowFareSearch(context.Context, *FlightLowFareSearchRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightLowFareSearch not implemented")
+}
+func (UnimplementedAmadeusServiceServer) FlightInspirationSearch(context.Context, *FlightInspirationSearchRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightInspirationSearch not implemented")
+}
+func (UnimplementedAmadeusServiceServer) FlightCheapestDateSearch(context.Context, *FlightCheapestDateSearchRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightCheapestDateSearch not implemented")
+}
+func (UnimplementedAmadeusServiceServer) FlightMostSearchedDestinations(context.Context, *FlightMostSearchedDestinationsRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightMostSearchedDestinations not implemented")
+}
+func (UnimplementedAmadeusServiceServer) FlightCheckInLinks(context.Context, *FlightCheckInLinksRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightCheckInLinks not implemented")
+}
+func (UnimplementedAmadeusServiceServer) FlightMostSearchedByDestination(context.Context, *FlightMostSearchedByDestinationRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightMostSearchedByDestination not implemented")
+}
+func (UnimplementedAmadeusServiceServer) FlightMostTraveledDestinations(context.Context, *FlightMostTraveledDestinationsRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightMostTraveledDestinations not implemented")
+}
+func (UnimplementedAmadeusServiceServer) FlightMostBookedDestinations(context.Context, *FlightMostBookedDestinationsRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightMostBookedDestinations not implemented")
+}
+func (UnimplementedAmadeusServiceServer) FlightBusiestTravelingPeriod(context.Context, *FlightBusiestTravelingPeriodRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightBusiestTravelingPeriod not implemented")
+}
+func (UnimplementedAmadeusServiceServer) AirportNearestRelevant(context.Context, *AirportNearestRelevantRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AirportNearestRelevant not implemented")
+}
+func (UnimplementedAmadeusServiceServer) AirportAndCitySearch(context.Context, *AirportAndCitySearchRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AirportAndCitySearch not implemented")
+}
+func (UnimplementedAmadeusServiceServer) AirlineCodeLookup(context.Context, *AirlineCodeLookupRequest) (*_type.Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AirlineCodeLookup not implemented")
+}
+func (UnimplementedAmadeusServiceServer) FlightOffersSearch(context.Context, *FlightOffersSearchRequest) (*_type.FlightOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightOffersSearch not implemented")
+}
+func (UnimplementedAmadeusServiceServer) FlightOffersPrice(context.Context, *FlightOffersPriceRequest) (*_type.FlightOffersPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightOffersPrice not implemented")
+}
+func (UnimplementedAmadeusServiceServer) CreateFlightOrder(context.Context, *CreateFlightOrderRequest) (*_type.FlightOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFlightOrder not implemented")
+}
+func (UnimplementedAmadeusServiceServer) GetFlightOrder(context.Context, *GetFlightOrderRequest) (*_type.FlightOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlightOrder not implemented")
+}
+func (UnimplementedAmadeusServiceServer) CancelFlightOrder(context.Context, *CancelFlightOrderRequest) (*_type.CancelFlightOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFlightOrder not implemented")
+}
+func (UnimplementedAmadeusServiceServer) SeatMapDisplay(context.Context, *SeatMapDisplayRequest) (*_type.SeatMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeatMapDisplay not implemented")
+}
+func (UnimplementedAmadeusServiceServer) BrandedFaresUpsell(context.Context, *BrandedFaresUpsellRequest) (*_type.FlightOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrandedFaresUpsell not implemented")
+}
+func (UnimplementedAmadeusServiceServer) FlightAvailabilitiesSearch(context.Context, *FlightAvailabilitiesSearchRequest) (*_type.FlightAvailabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlightAvailabilitiesSearch not implemented")
+}
+
+// UnsafeAmadeusServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AmadeusServiceServer will
+// result in compilation errors.
+type UnsafeAmadeusServiceServer interface {
+	mustEmbedUnimplementedAmadeusServiceServer()
+}
+
+func RegisterAmadeusServiceServer(s grpc.ServiceRegistrar, srv AmadeusServiceServer) {
+	s.RegisterService(&AmadeusService_ServiceDesc, srv)
+}
+
+func _AmadeusService_FlightLowFareSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightLowFareSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightLowFareSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightLowFareSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightLowFareSearch(ctx, req.(*FlightLowFareSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_FlightInspirationSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightInspirationSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightInspirationSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightInspirationSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightInspirationSearch(ctx, req.(*FlightInspirationSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_FlightCheapestDateSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightCheapestDateSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightCheapestDateSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightCheapestDateSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightCheapestDateSearch(ctx, req.(*FlightCheapestDateSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_FlightMostSearchedDestinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightMostSearchedDestinationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightMostSearchedDestinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightMostSearchedDestinations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightMostSearchedDestinations(ctx, req.(*FlightMostSearchedDestinationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_FlightCheckInLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightCheckInLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightCheckInLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightCheckInLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightCheckInLinks(ctx, req.(*FlightCheckInLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_FlightMostSearchedByDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightMostSearchedByDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightMostSearchedByDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightMostSearchedByDestination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightMostSearchedByDestination(ctx, req.(*FlightMostSearchedByDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_FlightMostTraveledDestinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightMostTraveledDestinationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightMostTraveledDestinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightMostTraveledDestinations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightMostTraveledDestinations(ctx, req.(*FlightMostTraveledDestinationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_FlightMostBookedDestinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightMostBookedDestinationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightMostBookedDestinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightMostBookedDestinations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightMostBookedDestinations(ctx, req.(*FlightMostBookedDestinationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_FlightBusiestTravelingPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightBusiestTravelingPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightBusiestTravelingPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightBusiestTravelingPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightBusiestTravelingPeriod(ctx, req.(*FlightBusiestTravelingPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_AirportNearestRelevant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AirportNearestRelevantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).AirportNearestRelevant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_AirportNearestRelevant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).AirportNearestRelevant(ctx, req.(*AirportNearestRelevantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_AirportAndCitySearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AirportAndCitySearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).AirportAndCitySearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_AirportAndCitySearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).AirportAndCitySearch(ctx, req.(*AirportAndCitySearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_AirlineCodeLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AirlineCodeLookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).AirlineCodeLookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_AirlineCodeLookup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).AirlineCodeLookup(ctx, req.(*AirlineCodeLookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_FlightOffersSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightOffersSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightOffersSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightOffersSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightOffersSearch(ctx, req.(*FlightOffersSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_FlightOffersPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightOffersPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightOffersPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightOffersPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightOffersPrice(ctx, req.(*FlightOffersPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_CreateFlightOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFlightOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).CreateFlightOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_CreateFlightOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).CreateFlightOrder(ctx, req.(*CreateFlightOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_GetFlightOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlightOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).GetFlightOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_GetFlightOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).GetFlightOrder(ctx, req.(*GetFlightOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_CancelFlightOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFlightOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).CancelFlightOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_CancelFlightOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).CancelFlightOrder(ctx, req.(*CancelFlightOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_SeatMapDisplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeatMapDisplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).SeatMapDisplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_SeatMapDisplay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).SeatMapDisplay(ctx, req.(*SeatMapDisplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_BrandedFaresUpsell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandedFaresUpsellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).BrandedFaresUpsell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_BrandedFaresUpsell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).BrandedFaresUpsell(ctx, req.(*BrandedFaresUpsellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AmadeusService_FlightAvailabilitiesSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightAvailabilitiesSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmadeusServiceServer).FlightAvailabilitiesSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AmadeusService_FlightAvailabilitiesSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmadeusServiceServer).FlightAvailabilitiesSearch(ctx, req.(*FlightAvailabilitiesSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AmadeusService_ServiceDesc is the grpc.ServiceDesc for AmadeusService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AmadeusService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "amadeus.func.AmadeusService",
+	HandlerType: (*AmadeusServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FlightLowFareSearch",
+			Handler:    _AmadeusService_FlightLowFareSearch_Handler,
+		},
+		{
+			MethodName: "FlightInspirationSearch",
+			Handler:    _AmadeusService_FlightInspirationSearch_Handler,
+		},
+		{
+			MethodName: "FlightCheapestDateSearch",
+			Handler:    _AmadeusService_FlightCheapestDateSearch_Handler,
+		},
+		{
+			MethodName: "FlightMostSearchedDestinations",
+			Handler:    _AmadeusService_FlightMostSearchedDestinations_Handler,
+		},
+		{
+			MethodName: "FlightCheckInLinks",
+			Handler:    _AmadeusService_FlightCheckInLinks_Handler,
+		},
+		{
+			MethodName: "FlightMostSearchedByDestination",
+			Handler:    _AmadeusService_FlightMostSearchedByDestination_Handler,
+		},
+		{
+			MethodName: "FlightMostTraveledDestinations",
+			Handler:    _AmadeusService_FlightMostTraveledDestinations_Handler,
+		},
+		{
+			MethodName: "FlightMostBookedDestinations",
+			Handler:    _AmadeusService_FlightMostBookedDestinations_Handler,
+		},
+		{
+			MethodName: "FlightBusiestTravelingPeriod",
+			Handler:    _AmadeusService_FlightBusiestTravelingPeriod_Handler,
+		},
+		{
+			MethodName: "AirportNearestRelevant",
+			Handler:    _AmadeusService_AirportNearestRelevant_Handler,
+		},
+		{
+			MethodName: "AirportAndCitySearch",
+			Handler:    _AmadeusService_AirportAndCitySearch_Handler,
+		},
+		{
+			MethodName: "AirlineCodeLookup",
+			Handler:    _AmadeusService_AirlineCodeLookup_Handler,
+		},
+		{
+			MethodName: "FlightOffersSearch",
+			Handler:    _AmadeusService_FlightOffersSearch_Handler,
+		},
+		{
+			MethodName: "FlightOffersPrice",
+			Handler:    _AmadeusService_FlightOffersPrice_Handler,
+		},
+		{
+			MethodName: "CreateFlightOrder",
+			Handler:    _AmadeusService_CreateFlightOrder_Handler,
+		},
+		{
+			MethodName: "GetFlightOrder",
+			Handler:    _AmadeusService_GetFlightOrder_Handler,
+		},
+		{
+			MethodName: "CancelFlightOrder",
+			Handler:    _AmadeusService_CancelFlightOrder_Handler,
+		},
+		{
+			MethodName: "SeatMapDisplay",
+			Handler:    _AmadeusService_SeatMapDisplay_Handler,
+		},
+		{
+			MethodName: "BrandedFaresUpsell",
+			Handler:    _AmadeusService_BrandedFaresUpsell_Handler,
+		},
+		{
+			MethodName: "FlightAvailabilitiesSearch",
+			Handler:    _AmadeusService_FlightAvailabilitiesSearch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "amadeus-go/api/amadeus/func/amadeus.func.proto",
+}
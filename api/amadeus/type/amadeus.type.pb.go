@@ -0,0 +1,8403 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: amadeus-go/api/amadeus/type/amadeus.type.proto
+
+package amadeus_type
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data         []*Data         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Dictionaries *Dictionaries   `protobuf:"bytes,2,opt,name=dictionaries,proto3" json:"dictionaries,omitempty"`
+	Meta         *Meta           `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	Warnings     []*ErrorWarning `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Errors       []*ErrorWarning `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Response) GetData() []*Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Response) GetDictionaries() *Dictionaries {
+	if x != nil {
+		return x.Dictionaries
+	}
+	return nil
+}
+
+func (x *Response) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *Response) GetWarnings() []*ErrorWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *Response) GetErrors() []*ErrorWarning {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           string                  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	OfferItems     []*OfferItem            `protobuf:"bytes,3,rep,name=offerItems,proto3" json:"offerItems,omitempty"`
+	Destination    string                  `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	SubType        string                  `protobuf:"bytes,5,opt,name=subType,proto3" json:"subType,omitempty"`
+	Analytics      *Analytics              `protobuf:"bytes,6,opt,name=analytics,proto3" json:"analytics,omitempty"`
+	Period         string                  `protobuf:"bytes,7,opt,name=period,proto3" json:"period,omitempty"`
+	Name           string                  `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	DetailedName   string                  `protobuf:"bytes,9,opt,name=detailedName,proto3" json:"detailedName,omitempty"`
+	TimeZoneOffset string                  `protobuf:"bytes,10,opt,name=timeZoneOffset,proto3" json:"timeZoneOffset,omitempty"`
+	IataCode       string                  `protobuf:"bytes,11,opt,name=iataCode,proto3" json:"iataCode,omitempty"`
+	GeoCode        *GeoCode                `protobuf:"bytes,12,opt,name=geoCode,proto3" json:"geoCode,omitempty"`
+	Address        *Address                `protobuf:"bytes,13,opt,name=address,proto3" json:"address,omitempty"`
+	Distance       *Distance               `protobuf:"bytes,14,opt,name=distance,proto3" json:"distance,omitempty"`
+	Relevance      float32                 `protobuf:"fixed32,15,opt,name=relevance,proto3" json:"relevance,omitempty"`
+	Origin         string                  `protobuf:"bytes,16,opt,name=origin,proto3" json:"origin,omitempty"`
+	DepartureDate  string                  `protobuf:"bytes,17,opt,name=departureDate,proto3" json:"departureDate,omitempty"`
+	ReturnDate     string                  `protobuf:"bytes,18,opt,name=returnDate,proto3" json:"returnDate,omitempty"`
+	Price          *Price                  `protobuf:"bytes,19,opt,name=price,proto3" json:"price,omitempty"`
+	Links          *Links                  `protobuf:"bytes,20,opt,name=links,proto3" json:"links,omitempty"`
+	Self           *Self                   `protobuf:"bytes,21,opt,name=self,proto3" json:"self,omitempty"`
+	Href           string                  `protobuf:"bytes,22,opt,name=href,proto3" json:"href,omitempty"`
+	Channel        string                  `protobuf:"bytes,23,opt,name=channel,proto3" json:"channel,omitempty"`
+	Parameters     map[string]*ParamDetail `protobuf:"bytes,24,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	IcaoCode       string                  `protobuf:"bytes,25,opt,name=icaoCode,proto3" json:"icaoCode,omitempty"`
+	BusinessName   string                  `protobuf:"bytes,26,opt,name=businessName,proto3" json:"businessName,omitempty"`
+	CommonName     string                  `protobuf:"bytes,27,opt,name=commonName,proto3" json:"commonName,omitempty"`
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Data) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Data) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Data) GetOfferItems() []*OfferItem {
+	if x != nil {
+		return x.OfferItems
+	}
+	return nil
+}
+
+func (x *Data) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Data) GetSubType() string {
+	if x != nil {
+		return x.SubType
+	}
+	return ""
+}
+
+func (x *Data) GetAnalytics() *Analytics {
+	if x != nil {
+		return x.Analytics
+	}
+	return nil
+}
+
+func (x *Data) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Data) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Data) GetDetailedName() string {
+	if x != nil {
+		return x.DetailedName
+	}
+	return ""
+}
+
+func (x *Data) GetTimeZoneOffset() string {
+	if x != nil {
+		return x.TimeZoneOffset
+	}
+	return ""
+}
+
+func (x *Data) GetIataCode() string {
+	if x != nil {
+		return x.IataCode
+	}
+	return ""
+}
+
+func (x *Data) GetGeoCode() *GeoCode {
+	if x != nil {
+		return x.GeoCode
+	}
+	return nil
+}
+
+func (x *Data) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Data) GetDistance() *Distance {
+	if x != nil {
+		return x.Distance
+	}
+	return nil
+}
+
+func (x *Data) GetRelevance() float32 {
+	if x != nil {
+		return x.Relevance
+	}
+	return 0
+}
+
+func (x *Data) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *Data) GetDepartureDate() string {
+	if x != nil {
+		return x.DepartureDate
+	}
+	return ""
+}
+
+func (x *Data) GetReturnDate() string {
+	if x != nil {
+		return x.ReturnDate
+	}
+	return ""
+}
+
+func (x *Data) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Data) GetLinks() *Links {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *Data) GetSelf() *Self {
+	if x != nil {
+		return x.Self
+	}
+	return nil
+}
+
+func (x *Data) GetHref() string {
+	if x != nil {
+		return x.Href
+	}
+	return ""
+}
+
+func (x *Data) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Data) GetParameters() map[string]*ParamDetail {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *Data) GetIcaoCode() string {
+	if x != nil {
+		return x.IcaoCode
+	}
+	return ""
+}
+
+func (x *Data) GetBusinessName() string {
+	if x != nil {
+		return x.BusinessName
+	}
+	return ""
+}
+
+func (x *Data) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+type OfferItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Services      []*Service `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	Price         *Price     `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	PricePerAdult *Price     `protobuf:"bytes,3,opt,name=pricePerAdult,proto3" json:"pricePerAdult,omitempty"`
+}
+
+func (x *OfferItem) Reset() {
+	*x = OfferItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferItem) ProtoMessage() {}
+
+func (x *OfferItem) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferItem.ProtoReflect.Descriptor instead.
+func (*OfferItem) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OfferItem) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *OfferItem) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *OfferItem) GetPricePerAdult() *Price {
+	if x != nil {
+		return x.PricePerAdult
+	}
+	return nil
+}
+
+type Service struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Segments []*Segment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Service) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type Segment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlightSegment         *FlightSegment         `protobuf:"bytes,1,opt,name=flightSegment,proto3" json:"flightSegment,omitempty"`
+	PricingDetailPerAdult *PricingDetailPerAdult `protobuf:"bytes,2,opt,name=pricingDetailPerAdult,proto3" json:"pricingDetailPerAdult,omitempty"`
+}
+
+func (x *Segment) Reset() {
+	*x = Segment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Segment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Segment) ProtoMessage() {}
+
+func (x *Segment) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Segment.ProtoReflect.Descriptor instead.
+func (*Segment) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Segment) GetFlightSegment() *FlightSegment {
+	if x != nil {
+		return x.FlightSegment
+	}
+	return nil
+}
+
+func (x *Segment) GetPricingDetailPerAdult() *PricingDetailPerAdult {
+	if x != nil {
+		return x.PricingDetailPerAdult
+	}
+	return nil
+}
+
+type FlightSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Departure   *DepartureArrival `protobuf:"bytes,1,opt,name=departure,proto3" json:"departure,omitempty"`
+	Arrival     *DepartureArrival `protobuf:"bytes,2,opt,name=arrival,proto3" json:"arrival,omitempty"`
+	CarrierCode string            `protobuf:"bytes,3,opt,name=carrierCode,proto3" json:"carrierCode,omitempty"`
+	Number      string            `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
+	Aircraft    *Aircraft         `protobuf:"bytes,5,opt,name=aircraft,proto3" json:"aircraft,omitempty"`
+	Operating   *Operating        `protobuf:"bytes,6,opt,name=operating,proto3" json:"operating,omitempty"`
+	Duration    string            `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *FlightSegment) Reset() {
+	*x = FlightSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightSegment) ProtoMessage() {}
+
+func (x *FlightSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightSegment.ProtoReflect.Descriptor instead.
+func (*FlightSegment) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FlightSegment) GetDeparture() *DepartureArrival {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *FlightSegment) GetArrival() *DepartureArrival {
+	if x != nil {
+		return x.Arrival
+	}
+	return nil
+}
+
+func (x *FlightSegment) GetCarrierCode() string {
+	if x != nil {
+		return x.CarrierCode
+	}
+	return ""
+}
+
+func (x *FlightSegment) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *FlightSegment) GetAircraft() *Aircraft {
+	if x != nil {
+		return x.Aircraft
+	}
+	return nil
+}
+
+func (x *FlightSegment) GetOperating() *Operating {
+	if x != nil {
+		return x.Operating
+	}
+	return nil
+}
+
+func (x *FlightSegment) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+type DepartureArrival struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IataCode string `protobuf:"bytes,1,opt,name=iataCode,proto3" json:"iataCode,omitempty"`
+	Terminal string `protobuf:"bytes,2,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	At       string `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *DepartureArrival) Reset() {
+	*x = DepartureArrival{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepartureArrival) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepartureArrival) ProtoMessage() {}
+
+func (x *DepartureArrival) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepartureArrival.ProtoReflect.Descriptor instead.
+func (*DepartureArrival) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DepartureArrival) GetIataCode() string {
+	if x != nil {
+		return x.IataCode
+	}
+	return ""
+}
+
+func (x *DepartureArrival) GetTerminal() string {
+	if x != nil {
+		return x.Terminal
+	}
+	return ""
+}
+
+func (x *DepartureArrival) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+type Aircraft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *Aircraft) Reset() {
+	*x = Aircraft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aircraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aircraft) ProtoMessage() {}
+
+func (x *Aircraft) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aircraft.ProtoReflect.Descriptor instead.
+func (*Aircraft) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Aircraft) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type Operating struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CarrierCode string `protobuf:"bytes,3,opt,name=carrierCode,proto3" json:"carrierCode,omitempty"`
+	Number      string `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *Operating) Reset() {
+	*x = Operating{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operating) ProtoMessage() {}
+
+func (x *Operating) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operating.ProtoReflect.Descriptor instead.
+func (*Operating) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Operating) GetCarrierCode() string {
+	if x != nil {
+		return x.CarrierCode
+	}
+	return ""
+}
+
+func (x *Operating) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type PricingDetailPerAdult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TravelClass  string `protobuf:"bytes,1,opt,name=travelClass,proto3" json:"travelClass,omitempty"`
+	FareClass    string `protobuf:"bytes,2,opt,name=fareClass,proto3" json:"fareClass,omitempty"`
+	Availability int32  `protobuf:"varint,3,opt,name=availability,proto3" json:"availability,omitempty"`
+	FareBasis    string `protobuf:"bytes,4,opt,name=fareBasis,proto3" json:"fareBasis,omitempty"`
+}
+
+func (x *PricingDetailPerAdult) Reset() {
+	*x = PricingDetailPerAdult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PricingDetailPerAdult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingDetailPerAdult) ProtoMessage() {}
+
+func (x *PricingDetailPerAdult) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingDetailPerAdult.ProtoReflect.Descriptor instead.
+func (*PricingDetailPerAdult) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PricingDetailPerAdult) GetTravelClass() string {
+	if x != nil {
+		return x.TravelClass
+	}
+	return ""
+}
+
+func (x *PricingDetailPerAdult) GetFareClass() string {
+	if x != nil {
+		return x.FareClass
+	}
+	return ""
+}
+
+func (x *PricingDetailPerAdult) GetAvailability() int32 {
+	if x != nil {
+		return x.Availability
+	}
+	return 0
+}
+
+func (x *PricingDetailPerAdult) GetFareBasis() string {
+	if x != nil {
+		return x.FareBasis
+	}
+	return ""
+}
+
+type Price struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total      string `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	TotalTaxes string `protobuf:"bytes,2,opt,name=totalTaxes,proto3" json:"totalTaxes,omitempty"`
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Price) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *Price) GetTotalTaxes() string {
+	if x != nil {
+		return x.TotalTaxes
+	}
+	return ""
+}
+
+type Dictionaries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Carriers   map[string]string          `protobuf:"bytes,1,rep,name=carriers,proto3" json:"carriers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Currencies map[string]string          `protobuf:"bytes,2,rep,name=currencies,proto3" json:"currencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Aircrafts  map[string]string          `protobuf:"bytes,3,rep,name=aircrafts,proto3" json:"aircrafts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Locations  map[string]*LocationDetail `protobuf:"bytes,4,rep,name=locations,proto3" json:"locations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Dictionaries) Reset() {
+	*x = Dictionaries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Dictionaries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dictionaries) ProtoMessage() {}
+
+func (x *Dictionaries) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dictionaries.ProtoReflect.Descriptor instead.
+func (*Dictionaries) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Dictionaries) GetCarriers() map[string]string {
+	if x != nil {
+		return x.Carriers
+	}
+	return nil
+}
+
+func (x *Dictionaries) GetCurrencies() map[string]string {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *Dictionaries) GetAircrafts() map[string]string {
+	if x != nil {
+		return x.Aircrafts
+	}
+	return nil
+}
+
+func (x *Dictionaries) GetLocations() map[string]*LocationDetail {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type Carrier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Carrier map[string]string `protobuf:"bytes,1,rep,name=carrier,proto3" json:"carrier,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Carrier) Reset() {
+	*x = Carrier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Carrier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Carrier) ProtoMessage() {}
+
+func (x *Carrier) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Carrier.ProtoReflect.Descriptor instead.
+func (*Carrier) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Carrier) GetCarrier() map[string]string {
+	if x != nil {
+		return x.Carrier
+	}
+	return nil
+}
+
+type Currency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency map[string]string `protobuf:"bytes,1,rep,name=currency,proto3" json:"currency,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Currency) Reset() {
+	*x = Currency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Currency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Currency) ProtoMessage() {}
+
+func (x *Currency) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
+func (*Currency) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Currency) GetCurrency() map[string]string {
+	if x != nil {
+		return x.Currency
+	}
+	return nil
+}
+
+type DictionariesAircraft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aircraft map[string]string `protobuf:"bytes,1,rep,name=aircraft,proto3" json:"aircraft,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DictionariesAircraft) Reset() {
+	*x = DictionariesAircraft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DictionariesAircraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionariesAircraft) ProtoMessage() {}
+
+func (x *DictionariesAircraft) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionariesAircraft.ProtoReflect.Descriptor instead.
+func (*DictionariesAircraft) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DictionariesAircraft) GetAircraft() map[string]string {
+	if x != nil {
+		return x.Aircraft
+	}
+	return nil
+}
+
+type Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location map[string]*LocationDetail `protobuf:"bytes,1,rep,name=location,proto3" json:"location,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Location) GetLocation() map[string]*LocationDetail {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type LocationDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Detail map[string]string `protobuf:"bytes,1,rep,name=detail,proto3" json:"detail,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *LocationDetail) Reset() {
+	*x = LocationDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationDetail) ProtoMessage() {}
+
+func (x *LocationDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationDetail.ProtoReflect.Descriptor instead.
+func (*LocationDetail) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LocationDetail) GetDetail() map[string]string {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+type Meta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Links    *Links    `protobuf:"bytes,1,opt,name=links,proto3" json:"links,omitempty"`
+	Currency string    `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Defaults *Defaults `protobuf:"bytes,3,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	Count    int32     `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Meta) Reset() {
+	*x = Meta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Meta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meta) ProtoMessage() {}
+
+func (x *Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
+func (*Meta) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Meta) GetLinks() *Links {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *Meta) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Meta) GetDefaults() *Defaults {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+func (x *Meta) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Links struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Self               string `protobuf:"bytes,1,opt,name=self,proto3" json:"self,omitempty"`
+	Next               string `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	Last               string `protobuf:"bytes,3,opt,name=last,proto3" json:"last,omitempty"`
+	FlightDates        string `protobuf:"bytes,4,opt,name=flightDates,proto3" json:"flightDates,omitempty"`
+	FlightOffers       string `protobuf:"bytes,5,opt,name=flightOffers,proto3" json:"flightOffers,omitempty"`
+	FlightDestinations string `protobuf:"bytes,6,opt,name=flightDestinations,proto3" json:"flightDestinations,omitempty"`
+}
+
+func (x *Links) Reset() {
+	*x = Links{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Links) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Links) ProtoMessage() {}
+
+func (x *Links) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Links.ProtoReflect.Descriptor instead.
+func (*Links) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Links) GetSelf() string {
+	if x != nil {
+		return x.Self
+	}
+	return ""
+}
+
+func (x *Links) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *Links) GetLast() string {
+	if x != nil {
+		return x.Last
+	}
+	return ""
+}
+
+func (x *Links) GetFlightDates() string {
+	if x != nil {
+		return x.FlightDates
+	}
+	return ""
+}
+
+func (x *Links) GetFlightOffers() string {
+	if x != nil {
+		return x.FlightOffers
+	}
+	return ""
+}
+
+func (x *Links) GetFlightDestinations() string {
+	if x != nil {
+		return x.FlightDestinations
+	}
+	return ""
+}
+
+type Defaults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NonStop       bool   `protobuf:"varint,1,opt,name=nonStop,proto3" json:"nonStop,omitempty"`
+	Adults        int32  `protobuf:"varint,2,opt,name=adults,proto3" json:"adults,omitempty"`
+	DepartureDate string `protobuf:"bytes,3,opt,name=departureDate,proto3" json:"departureDate,omitempty"`
+	OneWay        bool   `protobuf:"varint,4,opt,name=oneWay,proto3" json:"oneWay,omitempty"`
+	Duration      string `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	ViewBy        string `protobuf:"bytes,6,opt,name=viewBy,proto3" json:"viewBy,omitempty"`
+}
+
+func (x *Defaults) Reset() {
+	*x = Defaults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Defaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Defaults) ProtoMessage() {}
+
+func (x *Defaults) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Defaults.ProtoReflect.Descriptor instead.
+func (*Defaults) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Defaults) GetNonStop() bool {
+	if x != nil {
+		return x.NonStop
+	}
+	return false
+}
+
+func (x *Defaults) GetAdults() int32 {
+	if x != nil {
+		return x.Adults
+	}
+	return 0
+}
+
+func (x *Defaults) GetDepartureDate() string {
+	if x != nil {
+		return x.DepartureDate
+	}
+	return ""
+}
+
+func (x *Defaults) GetOneWay() bool {
+	if x != nil {
+		return x.OneWay
+	}
+	return false
+}
+
+func (x *Defaults) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *Defaults) GetViewBy() string {
+	if x != nil {
+		return x.ViewBy
+	}
+	return ""
+}
+
+type Analytics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flights   *Score `protobuf:"bytes,1,opt,name=flights,proto3" json:"flights,omitempty"`
+	Travelers *Score `protobuf:"bytes,2,opt,name=travelers,proto3" json:"travelers,omitempty"`
+	Searches  *Score `protobuf:"bytes,3,opt,name=searches,proto3" json:"searches,omitempty"`
+}
+
+func (x *Analytics) Reset() {
+	*x = Analytics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Analytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Analytics) ProtoMessage() {}
+
+func (x *Analytics) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Analytics.ProtoReflect.Descriptor instead.
+func (*Analytics) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Analytics) GetFlights() *Score {
+	if x != nil {
+		return x.Flights
+	}
+	return nil
+}
+
+func (x *Analytics) GetTravelers() *Score {
+	if x != nil {
+		return x.Travelers
+	}
+	return nil
+}
+
+func (x *Analytics) GetSearches() *Score {
+	if x != nil {
+		return x.Searches
+	}
+	return nil
+}
+
+type Score struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Score            int32             `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	NumberOfSearches *NumberOfSearches `protobuf:"bytes,2,opt,name=numberOfSearches,proto3" json:"numberOfSearches,omitempty"`
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Score) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Score) GetNumberOfSearches() *NumberOfSearches {
+	if x != nil {
+		return x.NumberOfSearches
+	}
+	return nil
+}
+
+type GeoCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float32 `protobuf:"fixed32,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float32 `protobuf:"fixed32,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *GeoCode) Reset() {
+	*x = GeoCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoCode) ProtoMessage() {}
+
+func (x *GeoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoCode.ProtoReflect.Descriptor instead.
+func (*GeoCode) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GeoCode) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoCode) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type Address struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityName    string `protobuf:"bytes,1,opt,name=cityName,proto3" json:"cityName,omitempty"`
+	CityCode    string `protobuf:"bytes,2,opt,name=cityCode,proto3" json:"cityCode,omitempty"`
+	CountryName string `protobuf:"bytes,3,opt,name=countryName,proto3" json:"countryName,omitempty"`
+	CountryCode string `protobuf:"bytes,4,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+	StateCode   string `protobuf:"bytes,5,opt,name=stateCode,proto3" json:"stateCode,omitempty"`
+	RegionCode  string `protobuf:"bytes,6,opt,name=regionCode,proto3" json:"regionCode,omitempty"`
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Address) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *Address) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountryName() string {
+	if x != nil {
+		return x.CountryName
+	}
+	return ""
+}
+
+func (x *Address) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *Address) GetStateCode() string {
+	if x != nil {
+		return x.StateCode
+	}
+	return ""
+}
+
+func (x *Address) GetRegionCode() string {
+	if x != nil {
+		return x.RegionCode
+	}
+	return ""
+}
+
+type Distance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value int32  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit  string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (x *Distance) Reset() {
+	*x = Distance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Distance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Distance) ProtoMessage() {}
+
+func (x *Distance) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Distance.ProtoReflect.Descriptor instead.
+func (*Distance) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Distance) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Distance) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type Self struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Href    string   `protobuf:"bytes,1,opt,name=href,proto3" json:"href,omitempty"`
+	Methods []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (x *Self) Reset() {
+	*x = Self{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Self) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Self) ProtoMessage() {}
+
+func (x *Self) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Self.ProtoReflect.Descriptor instead.
+func (*Self) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Self) GetHref() string {
+	if x != nil {
+		return x.Href
+	}
+	return ""
+}
+
+func (x *Self) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type ErrorWarning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Code   int32   `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Title  string  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Detail string  `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	Source *Source `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *ErrorWarning) Reset() {
+	*x = ErrorWarning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorWarning) ProtoMessage() {}
+
+func (x *ErrorWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorWarning.ProtoReflect.Descriptor instead.
+func (*ErrorWarning) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ErrorWarning) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ErrorWarning) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ErrorWarning) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ErrorWarning) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ErrorWarning) GetSource() *Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+type Source struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pointer   string `protobuf:"bytes,1,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	Parameter string `protobuf:"bytes,2,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	Example   string `protobuf:"bytes,3,opt,name=example,proto3" json:"example,omitempty"`
+}
+
+func (x *Source) Reset() {
+	*x = Source{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Source) ProtoMessage() {}
+
+func (x *Source) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Source.ProtoReflect.Descriptor instead.
+func (*Source) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Source) GetPointer() string {
+	if x != nil {
+		return x.Pointer
+	}
+	return ""
+}
+
+func (x *Source) GetParameter() string {
+	if x != nil {
+		return x.Parameter
+	}
+	return ""
+}
+
+func (x *Source) GetExample() string {
+	if x != nil {
+		return x.Example
+	}
+	return ""
+}
+
+type NumberOfSearches struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PerTripDuration  map[string]string `protobuf:"bytes,1,rep,name=perTripDuration,proto3" json:"perTripDuration,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PerDaysInAdvance map[string]string `protobuf:"bytes,2,rep,name=perDaysInAdvance,proto3" json:"perDaysInAdvance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NumberOfSearches) Reset() {
+	*x = NumberOfSearches{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumberOfSearches) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberOfSearches) ProtoMessage() {}
+
+func (x *NumberOfSearches) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberOfSearches.ProtoReflect.Descriptor instead.
+func (*NumberOfSearches) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NumberOfSearches) GetPerTripDuration() map[string]string {
+	if x != nil {
+		return x.PerTripDuration
+	}
+	return nil
+}
+
+func (x *NumberOfSearches) GetPerDaysInAdvance() map[string]string {
+	if x != nil {
+		return x.PerDaysInAdvance
+	}
+	return nil
+}
+
+type ParamDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Format      string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *ParamDetail) Reset() {
+	*x = ParamDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParamDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParamDetail) ProtoMessage() {}
+
+func (x *ParamDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParamDetail.ProtoReflect.Descriptor instead.
+func (*ParamDetail) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ParamDetail) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ParamDetail) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ParamDetail) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type FlightOffersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data         []*FlightOffer     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Dictionaries *OfferDictionaries `protobuf:"bytes,2,opt,name=dictionaries,proto3" json:"dictionaries,omitempty"`
+	Meta         *Meta              `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	Warnings     []*ErrorWarning    `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Errors       []*ErrorWarning    `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *FlightOffersResponse) Reset() {
+	*x = FlightOffersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightOffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightOffersResponse) ProtoMessage() {}
+
+func (x *FlightOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightOffersResponse.ProtoReflect.Descriptor instead.
+func (*FlightOffersResponse) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *FlightOffersResponse) GetData() []*FlightOffer {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FlightOffersResponse) GetDictionaries() *OfferDictionaries {
+	if x != nil {
+		return x.Dictionaries
+	}
+	return nil
+}
+
+func (x *FlightOffersResponse) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *FlightOffersResponse) GetWarnings() []*ErrorWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *FlightOffersResponse) GetErrors() []*ErrorWarning {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type FlightOffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type                     string             `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                       string             `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Source                   string             `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	InstantTicketingRequired bool               `protobuf:"varint,4,opt,name=instantTicketingRequired,proto3" json:"instantTicketingRequired,omitempty"`
+	NonHomogeneous           bool               `protobuf:"varint,5,opt,name=nonHomogeneous,proto3" json:"nonHomogeneous,omitempty"`
+	OneWay                   bool               `protobuf:"varint,6,opt,name=oneWay,proto3" json:"oneWay,omitempty"`
+	LastTicketingDate        string             `protobuf:"bytes,7,opt,name=lastTicketingDate,proto3" json:"lastTicketingDate,omitempty"`
+	NumberOfBookableSeats    int32              `protobuf:"varint,8,opt,name=numberOfBookableSeats,proto3" json:"numberOfBookableSeats,omitempty"`
+	Itineraries              []*Itinerary       `protobuf:"bytes,9,rep,name=itineraries,proto3" json:"itineraries,omitempty"`
+	Price                    *OfferPrice        `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	PricingOptions           *PricingOptions    `protobuf:"bytes,11,opt,name=pricingOptions,proto3" json:"pricingOptions,omitempty"`
+	ValidatingAirlineCodes   []string           `protobuf:"bytes,12,rep,name=validatingAirlineCodes,proto3" json:"validatingAirlineCodes,omitempty"`
+	TravelerPricings         []*TravelerPricing `protobuf:"bytes,13,rep,name=travelerPricings,proto3" json:"travelerPricings,omitempty"`
+}
+
+func (x *FlightOffer) Reset() {
+	*x = FlightOffer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightOffer) ProtoMessage() {}
+
+func (x *FlightOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightOffer.ProtoReflect.Descriptor instead.
+func (*FlightOffer) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FlightOffer) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FlightOffer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FlightOffer) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FlightOffer) GetInstantTicketingRequired() bool {
+	if x != nil {
+		return x.InstantTicketingRequired
+	}
+	return false
+}
+
+func (x *FlightOffer) GetNonHomogeneous() bool {
+	if x != nil {
+		return x.NonHomogeneous
+	}
+	return false
+}
+
+func (x *FlightOffer) GetOneWay() bool {
+	if x != nil {
+		return x.OneWay
+	}
+	return false
+}
+
+func (x *FlightOffer) GetLastTicketingDate() string {
+	if x != nil {
+		return x.LastTicketingDate
+	}
+	return ""
+}
+
+func (x *FlightOffer) GetNumberOfBookableSeats() int32 {
+	if x != nil {
+		return x.NumberOfBookableSeats
+	}
+	return 0
+}
+
+func (x *FlightOffer) GetItineraries() []*Itinerary {
+	if x != nil {
+		return x.Itineraries
+	}
+	return nil
+}
+
+func (x *FlightOffer) GetPrice() *OfferPrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *FlightOffer) GetPricingOptions() *PricingOptions {
+	if x != nil {
+		return x.PricingOptions
+	}
+	return nil
+}
+
+func (x *FlightOffer) GetValidatingAirlineCodes() []string {
+	if x != nil {
+		return x.ValidatingAirlineCodes
+	}
+	return nil
+}
+
+func (x *FlightOffer) GetTravelerPricings() []*TravelerPricing {
+	if x != nil {
+		return x.TravelerPricings
+	}
+	return nil
+}
+
+type Itinerary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duration string          `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	Segments []*OfferSegment `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *Itinerary) Reset() {
+	*x = Itinerary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Itinerary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Itinerary) ProtoMessage() {}
+
+func (x *Itinerary) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Itinerary.ProtoReflect.Descriptor instead.
+func (*Itinerary) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Itinerary) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *Itinerary) GetSegments() []*OfferSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type OfferSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Departure       *FlightEndPoint `protobuf:"bytes,1,opt,name=departure,proto3" json:"departure,omitempty"`
+	Arrival         *FlightEndPoint `protobuf:"bytes,2,opt,name=arrival,proto3" json:"arrival,omitempty"`
+	CarrierCode     string          `protobuf:"bytes,3,opt,name=carrierCode,proto3" json:"carrierCode,omitempty"`
+	Number          string          `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
+	Aircraft        *Aircraft       `protobuf:"bytes,5,opt,name=aircraft,proto3" json:"aircraft,omitempty"`
+	Operating       *Operating      `protobuf:"bytes,6,opt,name=operating,proto3" json:"operating,omitempty"`
+	Duration        string          `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	Id              string          `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
+	NumberOfStops   int32           `protobuf:"varint,9,opt,name=numberOfStops,proto3" json:"numberOfStops,omitempty"`
+	BlacklistedInEU bool            `protobuf:"varint,10,opt,name=blacklistedInEU,proto3" json:"blacklistedInEU,omitempty"`
+}
+
+func (x *OfferSegment) Reset() {
+	*x = OfferSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferSegment) ProtoMessage() {}
+
+func (x *OfferSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferSegment.ProtoReflect.Descriptor instead.
+func (*OfferSegment) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *OfferSegment) GetDeparture() *FlightEndPoint {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *OfferSegment) GetArrival() *FlightEndPoint {
+	if x != nil {
+		return x.Arrival
+	}
+	return nil
+}
+
+func (x *OfferSegment) GetCarrierCode() string {
+	if x != nil {
+		return x.CarrierCode
+	}
+	return ""
+}
+
+func (x *OfferSegment) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *OfferSegment) GetAircraft() *Aircraft {
+	if x != nil {
+		return x.Aircraft
+	}
+	return nil
+}
+
+func (x *OfferSegment) GetOperating() *Operating {
+	if x != nil {
+		return x.Operating
+	}
+	return nil
+}
+
+func (x *OfferSegment) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *OfferSegment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OfferSegment) GetNumberOfStops() int32 {
+	if x != nil {
+		return x.NumberOfStops
+	}
+	return 0
+}
+
+func (x *OfferSegment) GetBlacklistedInEU() bool {
+	if x != nil {
+		return x.BlacklistedInEU
+	}
+	return false
+}
+
+type FlightEndPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IataCode string `protobuf:"bytes,1,opt,name=iataCode,proto3" json:"iataCode,omitempty"`
+	Terminal string `protobuf:"bytes,2,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	At       string `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+}
+
+func (x *FlightEndPoint) Reset() {
+	*x = FlightEndPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightEndPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightEndPoint) ProtoMessage() {}
+
+func (x *FlightEndPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightEndPoint.ProtoReflect.Descriptor instead.
+func (*FlightEndPoint) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FlightEndPoint) GetIataCode() string {
+	if x != nil {
+		return x.IataCode
+	}
+	return ""
+}
+
+func (x *FlightEndPoint) GetTerminal() string {
+	if x != nil {
+		return x.Terminal
+	}
+	return ""
+}
+
+func (x *FlightEndPoint) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+type OfferPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency           string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Total              string `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	Base               string `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	Fees               []*Fee `protobuf:"bytes,4,rep,name=fees,proto3" json:"fees,omitempty"`
+	GrandTotal         string `protobuf:"bytes,5,opt,name=grandTotal,proto3" json:"grandTotal,omitempty"`
+	Taxes              []*Tax `protobuf:"bytes,6,rep,name=taxes,proto3" json:"taxes,omitempty"`
+	RefundableTaxes    string `protobuf:"bytes,7,opt,name=refundableTaxes,proto3" json:"refundableTaxes,omitempty"`
+	AdditionalServices []*Fee `protobuf:"bytes,8,rep,name=additionalServices,proto3" json:"additionalServices,omitempty"`
+}
+
+func (x *OfferPrice) Reset() {
+	*x = OfferPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferPrice) ProtoMessage() {}
+
+func (x *OfferPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferPrice.ProtoReflect.Descriptor instead.
+func (*OfferPrice) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *OfferPrice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *OfferPrice) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *OfferPrice) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *OfferPrice) GetFees() []*Fee {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *OfferPrice) GetGrandTotal() string {
+	if x != nil {
+		return x.GrandTotal
+	}
+	return ""
+}
+
+func (x *OfferPrice) GetTaxes() []*Tax {
+	if x != nil {
+		return x.Taxes
+	}
+	return nil
+}
+
+func (x *OfferPrice) GetRefundableTaxes() string {
+	if x != nil {
+		return x.RefundableTaxes
+	}
+	return ""
+}
+
+func (x *OfferPrice) GetAdditionalServices() []*Fee {
+	if x != nil {
+		return x.AdditionalServices
+	}
+	return nil
+}
+
+type Fee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *Fee) Reset() {
+	*x = Fee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fee) ProtoMessage() {}
+
+func (x *Fee) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fee.ProtoReflect.Descriptor instead.
+func (*Fee) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Fee) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Fee) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type PricingOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FareType                []string `protobuf:"bytes,1,rep,name=fareType,proto3" json:"fareType,omitempty"`
+	IncludedCheckedBagsOnly bool     `protobuf:"varint,2,opt,name=includedCheckedBagsOnly,proto3" json:"includedCheckedBagsOnly,omitempty"`
+}
+
+func (x *PricingOptions) Reset() {
+	*x = PricingOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PricingOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingOptions) ProtoMessage() {}
+
+func (x *PricingOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingOptions.ProtoReflect.Descriptor instead.
+func (*PricingOptions) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PricingOptions) GetFareType() []string {
+	if x != nil {
+		return x.FareType
+	}
+	return nil
+}
+
+func (x *PricingOptions) GetIncludedCheckedBagsOnly() bool {
+	if x != nil {
+		return x.IncludedCheckedBagsOnly
+	}
+	return false
+}
+
+type TravelerPricing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TravelerId           string                  `protobuf:"bytes,1,opt,name=travelerId,proto3" json:"travelerId,omitempty"`
+	FareOption           string                  `protobuf:"bytes,2,opt,name=fareOption,proto3" json:"fareOption,omitempty"`
+	TravelerType         string                  `protobuf:"bytes,3,opt,name=travelerType,proto3" json:"travelerType,omitempty"`
+	AssociatedAdultId    string                  `protobuf:"bytes,4,opt,name=associatedAdultId,proto3" json:"associatedAdultId,omitempty"`
+	Price                *OfferPrice             `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	FareDetailsBySegment []*FareDetailsBySegment `protobuf:"bytes,6,rep,name=fareDetailsBySegment,proto3" json:"fareDetailsBySegment,omitempty"`
+}
+
+func (x *TravelerPricing) Reset() {
+	*x = TravelerPricing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TravelerPricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelerPricing) ProtoMessage() {}
+
+func (x *TravelerPricing) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelerPricing.ProtoReflect.Descriptor instead.
+func (*TravelerPricing) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TravelerPricing) GetTravelerId() string {
+	if x != nil {
+		return x.TravelerId
+	}
+	return ""
+}
+
+func (x *TravelerPricing) GetFareOption() string {
+	if x != nil {
+		return x.FareOption
+	}
+	return ""
+}
+
+func (x *TravelerPricing) GetTravelerType() string {
+	if x != nil {
+		return x.TravelerType
+	}
+	return ""
+}
+
+func (x *TravelerPricing) GetAssociatedAdultId() string {
+	if x != nil {
+		return x.AssociatedAdultId
+	}
+	return ""
+}
+
+func (x *TravelerPricing) GetPrice() *OfferPrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *TravelerPricing) GetFareDetailsBySegment() []*FareDetailsBySegment {
+	if x != nil {
+		return x.FareDetailsBySegment
+	}
+	return nil
+}
+
+type FareDetailsBySegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SegmentId           string            `protobuf:"bytes,1,opt,name=segmentId,proto3" json:"segmentId,omitempty"`
+	Cabin               string            `protobuf:"bytes,2,opt,name=cabin,proto3" json:"cabin,omitempty"`
+	FareBasis           string            `protobuf:"bytes,3,opt,name=fareBasis,proto3" json:"fareBasis,omitempty"`
+	BrandedFare         string            `protobuf:"bytes,4,opt,name=brandedFare,proto3" json:"brandedFare,omitempty"`
+	Class               string            `protobuf:"bytes,5,opt,name=class,proto3" json:"class,omitempty"`
+	IncludedCheckedBags *BaggageAllowance `protobuf:"bytes,6,opt,name=includedCheckedBags,proto3" json:"includedCheckedBags,omitempty"`
+	BrandedFareLabel    string            `protobuf:"bytes,7,opt,name=brandedFareLabel,proto3" json:"brandedFareLabel,omitempty"`
+	Amenities           []*Amenity        `protobuf:"bytes,8,rep,name=amenities,proto3" json:"amenities,omitempty"`
+}
+
+func (x *FareDetailsBySegment) Reset() {
+	*x = FareDetailsBySegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FareDetailsBySegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FareDetailsBySegment) ProtoMessage() {}
+
+func (x *FareDetailsBySegment) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FareDetailsBySegment.ProtoReflect.Descriptor instead.
+func (*FareDetailsBySegment) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FareDetailsBySegment) GetSegmentId() string {
+	if x != nil {
+		return x.SegmentId
+	}
+	return ""
+}
+
+func (x *FareDetailsBySegment) GetCabin() string {
+	if x != nil {
+		return x.Cabin
+	}
+	return ""
+}
+
+func (x *FareDetailsBySegment) GetFareBasis() string {
+	if x != nil {
+		return x.FareBasis
+	}
+	return ""
+}
+
+func (x *FareDetailsBySegment) GetBrandedFare() string {
+	if x != nil {
+		return x.BrandedFare
+	}
+	return ""
+}
+
+func (x *FareDetailsBySegment) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *FareDetailsBySegment) GetIncludedCheckedBags() *BaggageAllowance {
+	if x != nil {
+		return x.IncludedCheckedBags
+	}
+	return nil
+}
+
+func (x *FareDetailsBySegment) GetBrandedFareLabel() string {
+	if x != nil {
+		return x.BrandedFareLabel
+	}
+	return ""
+}
+
+func (x *FareDetailsBySegment) GetAmenities() []*Amenity {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+type BaggageAllowance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quantity   int32  `protobuf:"varint,1,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Weight     int32  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	WeightUnit string `protobuf:"bytes,3,opt,name=weightUnit,proto3" json:"weightUnit,omitempty"`
+}
+
+func (x *BaggageAllowance) Reset() {
+	*x = BaggageAllowance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaggageAllowance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaggageAllowance) ProtoMessage() {}
+
+func (x *BaggageAllowance) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaggageAllowance.ProtoReflect.Descriptor instead.
+func (*BaggageAllowance) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BaggageAllowance) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BaggageAllowance) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *BaggageAllowance) GetWeightUnit() string {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return ""
+}
+
+type OfferDictionaries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locations  map[string]*LocationValue `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Aircraft   map[string]string         `protobuf:"bytes,2,rep,name=aircraft,proto3" json:"aircraft,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Currencies map[string]string         `protobuf:"bytes,3,rep,name=currencies,proto3" json:"currencies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Carriers   map[string]string         `protobuf:"bytes,4,rep,name=carriers,proto3" json:"carriers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *OfferDictionaries) Reset() {
+	*x = OfferDictionaries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferDictionaries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferDictionaries) ProtoMessage() {}
+
+func (x *OfferDictionaries) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferDictionaries.ProtoReflect.Descriptor instead.
+func (*OfferDictionaries) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *OfferDictionaries) GetLocations() map[string]*LocationValue {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *OfferDictionaries) GetAircraft() map[string]string {
+	if x != nil {
+		return x.Aircraft
+	}
+	return nil
+}
+
+func (x *OfferDictionaries) GetCurrencies() map[string]string {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *OfferDictionaries) GetCarriers() map[string]string {
+	if x != nil {
+		return x.Carriers
+	}
+	return nil
+}
+
+type LocationValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CityCode    string `protobuf:"bytes,1,opt,name=cityCode,proto3" json:"cityCode,omitempty"`
+	CountryCode string `protobuf:"bytes,2,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+}
+
+func (x *LocationValue) Reset() {
+	*x = LocationValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationValue) ProtoMessage() {}
+
+func (x *LocationValue) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationValue.ProtoReflect.Descriptor instead.
+func (*LocationValue) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LocationValue) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *LocationValue) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+type Tax struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *Tax) Reset() {
+	*x = Tax{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tax) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tax) ProtoMessage() {}
+
+func (x *Tax) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tax.ProtoReflect.Descriptor instead.
+func (*Tax) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Tax) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Tax) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type FlightOffersPriceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data         *FlightOffersPricing `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Included     *PricingIncluded     `protobuf:"bytes,2,opt,name=included,proto3" json:"included,omitempty"`
+	Dictionaries *OfferDictionaries   `protobuf:"bytes,3,opt,name=dictionaries,proto3" json:"dictionaries,omitempty"`
+	Warnings     []*ErrorWarning      `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Errors       []*ErrorWarning      `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *FlightOffersPriceResponse) Reset() {
+	*x = FlightOffersPriceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightOffersPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightOffersPriceResponse) ProtoMessage() {}
+
+func (x *FlightOffersPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightOffersPriceResponse.ProtoReflect.Descriptor instead.
+func (*FlightOffersPriceResponse) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FlightOffersPriceResponse) GetData() *FlightOffersPricing {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FlightOffersPriceResponse) GetIncluded() *PricingIncluded {
+	if x != nil {
+		return x.Included
+	}
+	return nil
+}
+
+func (x *FlightOffersPriceResponse) GetDictionaries() *OfferDictionaries {
+	if x != nil {
+		return x.Dictionaries
+	}
+	return nil
+}
+
+func (x *FlightOffersPriceResponse) GetWarnings() []*ErrorWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *FlightOffersPriceResponse) GetErrors() []*ErrorWarning {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type FlightOffersPricing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type                string               `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	FlightOffers        []*FlightOffer       `protobuf:"bytes,2,rep,name=flightOffers,proto3" json:"flightOffers,omitempty"`
+	BookingRequirements *BookingRequirements `protobuf:"bytes,3,opt,name=bookingRequirements,proto3" json:"bookingRequirements,omitempty"`
+}
+
+func (x *FlightOffersPricing) Reset() {
+	*x = FlightOffersPricing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightOffersPricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightOffersPricing) ProtoMessage() {}
+
+func (x *FlightOffersPricing) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightOffersPricing.ProtoReflect.Descriptor instead.
+func (*FlightOffersPricing) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FlightOffersPricing) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FlightOffersPricing) GetFlightOffers() []*FlightOffer {
+	if x != nil {
+		return x.FlightOffers
+	}
+	return nil
+}
+
+func (x *FlightOffersPricing) GetBookingRequirements() *BookingRequirements {
+	if x != nil {
+		return x.BookingRequirements
+	}
+	return nil
+}
+
+type BookingRequirements struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmailAddressRequired      bool                    `protobuf:"varint,1,opt,name=emailAddressRequired,proto3" json:"emailAddressRequired,omitempty"`
+	MobilePhoneNumberRequired bool                    `protobuf:"varint,2,opt,name=mobilePhoneNumberRequired,proto3" json:"mobilePhoneNumberRequired,omitempty"`
+	InvoiceAddressRequired    bool                    `protobuf:"varint,3,opt,name=invoiceAddressRequired,proto3" json:"invoiceAddressRequired,omitempty"`
+	MailingAddressRequired    bool                    `protobuf:"varint,4,opt,name=mailingAddressRequired,proto3" json:"mailingAddressRequired,omitempty"`
+	TravelerRequirements      []*TravelerRequirements `protobuf:"bytes,5,rep,name=travelerRequirements,proto3" json:"travelerRequirements,omitempty"`
+}
+
+func (x *BookingRequirements) Reset() {
+	*x = BookingRequirements{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookingRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingRequirements) ProtoMessage() {}
+
+func (x *BookingRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingRequirements.ProtoReflect.Descriptor instead.
+func (*BookingRequirements) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BookingRequirements) GetEmailAddressRequired() bool {
+	if x != nil {
+		return x.EmailAddressRequired
+	}
+	return false
+}
+
+func (x *BookingRequirements) GetMobilePhoneNumberRequired() bool {
+	if x != nil {
+		return x.MobilePhoneNumberRequired
+	}
+	return false
+}
+
+func (x *BookingRequirements) GetInvoiceAddressRequired() bool {
+	if x != nil {
+		return x.InvoiceAddressRequired
+	}
+	return false
+}
+
+func (x *BookingRequirements) GetMailingAddressRequired() bool {
+	if x != nil {
+		return x.MailingAddressRequired
+	}
+	return false
+}
+
+func (x *BookingRequirements) GetTravelerRequirements() []*TravelerRequirements {
+	if x != nil {
+		return x.TravelerRequirements
+	}
+	return nil
+}
+
+type TravelerRequirements struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TravelerId                   string `protobuf:"bytes,1,opt,name=travelerId,proto3" json:"travelerId,omitempty"`
+	GenderRequired               bool   `protobuf:"varint,2,opt,name=genderRequired,proto3" json:"genderRequired,omitempty"`
+	DocumentRequired             bool   `protobuf:"varint,3,opt,name=documentRequired,proto3" json:"documentRequired,omitempty"`
+	DocumentIssuanceCityRequired bool   `protobuf:"varint,4,opt,name=documentIssuanceCityRequired,proto3" json:"documentIssuanceCityRequired,omitempty"`
+	DateOfBirthRequired          bool   `protobuf:"varint,5,opt,name=dateOfBirthRequired,proto3" json:"dateOfBirthRequired,omitempty"`
+	RedressRequiredIfAny         bool   `protobuf:"varint,6,opt,name=redressRequiredIfAny,proto3" json:"redressRequiredIfAny,omitempty"`
+	ResidenceRequired            bool   `protobuf:"varint,7,opt,name=residenceRequired,proto3" json:"residenceRequired,omitempty"`
+}
+
+func (x *TravelerRequirements) Reset() {
+	*x = TravelerRequirements{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TravelerRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelerRequirements) ProtoMessage() {}
+
+func (x *TravelerRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelerRequirements.ProtoReflect.Descriptor instead.
+func (*TravelerRequirements) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TravelerRequirements) GetTravelerId() string {
+	if x != nil {
+		return x.TravelerId
+	}
+	return ""
+}
+
+func (x *TravelerRequirements) GetGenderRequired() bool {
+	if x != nil {
+		return x.GenderRequired
+	}
+	return false
+}
+
+func (x *TravelerRequirements) GetDocumentRequired() bool {
+	if x != nil {
+		return x.DocumentRequired
+	}
+	return false
+}
+
+func (x *TravelerRequirements) GetDocumentIssuanceCityRequired() bool {
+	if x != nil {
+		return x.DocumentIssuanceCityRequired
+	}
+	return false
+}
+
+func (x *TravelerRequirements) GetDateOfBirthRequired() bool {
+	if x != nil {
+		return x.DateOfBirthRequired
+	}
+	return false
+}
+
+func (x *TravelerRequirements) GetRedressRequiredIfAny() bool {
+	if x != nil {
+		return x.RedressRequiredIfAny
+	}
+	return false
+}
+
+func (x *TravelerRequirements) GetResidenceRequired() bool {
+	if x != nil {
+		return x.ResidenceRequired
+	}
+	return false
+}
+
+type PricingIncluded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreditCardFees    map[string]*CreditCardFee     `protobuf:"bytes,1,rep,name=creditCardFees,proto3" json:"creditCardFees,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Bags              map[string]*BagOffer          `protobuf:"bytes,2,rep,name=bags,proto3" json:"bags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DetailedFareRules map[string]*DetailedFareRules `protobuf:"bytes,3,rep,name=detailedFareRules,proto3" json:"detailedFareRules,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PricingIncluded) Reset() {
+	*x = PricingIncluded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PricingIncluded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingIncluded) ProtoMessage() {}
+
+func (x *PricingIncluded) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingIncluded.ProtoReflect.Descriptor instead.
+func (*PricingIncluded) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PricingIncluded) GetCreditCardFees() map[string]*CreditCardFee {
+	if x != nil {
+		return x.CreditCardFees
+	}
+	return nil
+}
+
+func (x *PricingIncluded) GetBags() map[string]*BagOffer {
+	if x != nil {
+		return x.Bags
+	}
+	return nil
+}
+
+func (x *PricingIncluded) GetDetailedFareRules() map[string]*DetailedFareRules {
+	if x != nil {
+		return x.DetailedFareRules
+	}
+	return nil
+}
+
+type CreditCardFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Brand         string `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	FlightOfferId string `protobuf:"bytes,4,opt,name=flightOfferId,proto3" json:"flightOfferId,omitempty"`
+}
+
+func (x *CreditCardFee) Reset() {
+	*x = CreditCardFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreditCardFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditCardFee) ProtoMessage() {}
+
+func (x *CreditCardFee) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditCardFee.ProtoReflect.Descriptor instead.
+func (*CreditCardFee) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreditCardFee) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *CreditCardFee) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CreditCardFee) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreditCardFee) GetFlightOfferId() string {
+	if x != nil {
+		return x.FlightOfferId
+	}
+	return ""
+}
+
+type BagOffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quantity            int32     `protobuf:"varint,1,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Weight              int32     `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	WeightUnit          string    `protobuf:"bytes,3,opt,name=weightUnit,proto3" json:"weightUnit,omitempty"`
+	Name                string    `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Price               *BagPrice `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	BookableByItinerary bool      `protobuf:"varint,6,opt,name=bookableByItinerary,proto3" json:"bookableByItinerary,omitempty"`
+	SegmentIds          []string  `protobuf:"bytes,7,rep,name=segmentIds,proto3" json:"segmentIds,omitempty"`
+	TravelerIds         []string  `protobuf:"bytes,8,rep,name=travelerIds,proto3" json:"travelerIds,omitempty"`
+}
+
+func (x *BagOffer) Reset() {
+	*x = BagOffer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BagOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BagOffer) ProtoMessage() {}
+
+func (x *BagOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BagOffer.ProtoReflect.Descriptor instead.
+func (*BagOffer) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BagOffer) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BagOffer) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *BagOffer) GetWeightUnit() string {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return ""
+}
+
+func (x *BagOffer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BagOffer) GetPrice() *BagPrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *BagOffer) GetBookableByItinerary() bool {
+	if x != nil {
+		return x.BookableByItinerary
+	}
+	return false
+}
+
+func (x *BagOffer) GetSegmentIds() []string {
+	if x != nil {
+		return x.SegmentIds
+	}
+	return nil
+}
+
+func (x *BagOffer) GetTravelerIds() []string {
+	if x != nil {
+		return x.TravelerIds
+	}
+	return nil
+}
+
+type BagPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount       string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	CurrencyCode string `protobuf:"bytes,2,opt,name=currencyCode,proto3" json:"currencyCode,omitempty"`
+}
+
+func (x *BagPrice) Reset() {
+	*x = BagPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BagPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BagPrice) ProtoMessage() {}
+
+func (x *BagPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BagPrice.ProtoReflect.Descriptor instead.
+func (*BagPrice) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BagPrice) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *BagPrice) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type DetailedFareRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FareBasis string     `protobuf:"bytes,1,opt,name=fareBasis,proto3" json:"fareBasis,omitempty"`
+	Name      string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SegmentId string     `protobuf:"bytes,3,opt,name=segmentId,proto3" json:"segmentId,omitempty"`
+	FareNotes *FareNotes `protobuf:"bytes,4,opt,name=fareNotes,proto3" json:"fareNotes,omitempty"`
+}
+
+func (x *DetailedFareRules) Reset() {
+	*x = DetailedFareRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetailedFareRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailedFareRules) ProtoMessage() {}
+
+func (x *DetailedFareRules) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailedFareRules.ProtoReflect.Descriptor instead.
+func (*DetailedFareRules) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DetailedFareRules) GetFareBasis() string {
+	if x != nil {
+		return x.FareBasis
+	}
+	return ""
+}
+
+func (x *DetailedFareRules) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetailedFareRules) GetSegmentId() string {
+	if x != nil {
+		return x.SegmentId
+	}
+	return ""
+}
+
+func (x *DetailedFareRules) GetFareNotes() *FareNotes {
+	if x != nil {
+		return x.FareNotes
+	}
+	return nil
+}
+
+type FareNotes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Descriptions []*FareDescription `protobuf:"bytes,1,rep,name=descriptions,proto3" json:"descriptions,omitempty"`
+}
+
+func (x *FareNotes) Reset() {
+	*x = FareNotes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FareNotes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FareNotes) ProtoMessage() {}
+
+func (x *FareNotes) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FareNotes.ProtoReflect.Descriptor instead.
+func (*FareNotes) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FareNotes) GetDescriptions() []*FareDescription {
+	if x != nil {
+		return x.Descriptions
+	}
+	return nil
+}
+
+type FareDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DescriptionType string `protobuf:"bytes,1,opt,name=descriptionType,proto3" json:"descriptionType,omitempty"`
+	Text            string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *FareDescription) Reset() {
+	*x = FareDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FareDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FareDescription) ProtoMessage() {}
+
+func (x *FareDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FareDescription.ProtoReflect.Descriptor instead.
+func (*FareDescription) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FareDescription) GetDescriptionType() string {
+	if x != nil {
+		return x.DescriptionType
+	}
+	return ""
+}
+
+func (x *FareDescription) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type FlightOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data         *FlightOrder       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Dictionaries *OfferDictionaries `protobuf:"bytes,2,opt,name=dictionaries,proto3" json:"dictionaries,omitempty"`
+	Warnings     []*ErrorWarning    `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Errors       []*ErrorWarning    `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *FlightOrderResponse) Reset() {
+	*x = FlightOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightOrderResponse) ProtoMessage() {}
+
+func (x *FlightOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightOrderResponse.ProtoReflect.Descriptor instead.
+func (*FlightOrderResponse) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FlightOrderResponse) GetData() *FlightOrder {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FlightOrderResponse) GetDictionaries() *OfferDictionaries {
+	if x != nil {
+		return x.Dictionaries
+	}
+	return nil
+}
+
+func (x *FlightOrderResponse) GetWarnings() []*ErrorWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *FlightOrderResponse) GetErrors() []*ErrorWarning {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type FlightOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type              string              `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                string              `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	QueuingOfficeId   string              `protobuf:"bytes,3,opt,name=queuingOfficeId,proto3" json:"queuingOfficeId,omitempty"`
+	AssociatedRecords []*AssociatedRecord `protobuf:"bytes,4,rep,name=associatedRecords,proto3" json:"associatedRecords,omitempty"`
+	FlightOffers      []*FlightOffer      `protobuf:"bytes,5,rep,name=flightOffers,proto3" json:"flightOffers,omitempty"`
+	Travelers         []*Traveler         `protobuf:"bytes,6,rep,name=travelers,proto3" json:"travelers,omitempty"`
+	Contacts          []*Contact          `protobuf:"bytes,7,rep,name=contacts,proto3" json:"contacts,omitempty"`
+}
+
+func (x *FlightOrder) Reset() {
+	*x = FlightOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightOrder) ProtoMessage() {}
+
+func (x *FlightOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightOrder.ProtoReflect.Descriptor instead.
+func (*FlightOrder) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FlightOrder) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FlightOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FlightOrder) GetQueuingOfficeId() string {
+	if x != nil {
+		return x.QueuingOfficeId
+	}
+	return ""
+}
+
+func (x *FlightOrder) GetAssociatedRecords() []*AssociatedRecord {
+	if x != nil {
+		return x.AssociatedRecords
+	}
+	return nil
+}
+
+func (x *FlightOrder) GetFlightOffers() []*FlightOffer {
+	if x != nil {
+		return x.FlightOffers
+	}
+	return nil
+}
+
+func (x *FlightOrder) GetTravelers() []*Traveler {
+	if x != nil {
+		return x.Travelers
+	}
+	return nil
+}
+
+func (x *FlightOrder) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+type AssociatedRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference        string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	CreationDate     string `protobuf:"bytes,2,opt,name=creationDate,proto3" json:"creationDate,omitempty"`
+	OriginSystemCode string `protobuf:"bytes,3,opt,name=originSystemCode,proto3" json:"originSystemCode,omitempty"`
+	FlightOfferId    string `protobuf:"bytes,4,opt,name=flightOfferId,proto3" json:"flightOfferId,omitempty"`
+}
+
+func (x *AssociatedRecord) Reset() {
+	*x = AssociatedRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssociatedRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssociatedRecord) ProtoMessage() {}
+
+func (x *AssociatedRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssociatedRecord.ProtoReflect.Descriptor instead.
+func (*AssociatedRecord) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AssociatedRecord) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *AssociatedRecord) GetCreationDate() string {
+	if x != nil {
+		return x.CreationDate
+	}
+	return ""
+}
+
+func (x *AssociatedRecord) GetOriginSystemCode() string {
+	if x != nil {
+		return x.OriginSystemCode
+	}
+	return ""
+}
+
+func (x *AssociatedRecord) GetFlightOfferId() string {
+	if x != nil {
+		return x.FlightOfferId
+	}
+	return ""
+}
+
+type Traveler struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateOfBirth string              `protobuf:"bytes,2,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	Gender      string              `protobuf:"bytes,3,opt,name=gender,proto3" json:"gender,omitempty"`
+	Name        *TravelerName       `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Contact     *Contact            `protobuf:"bytes,5,opt,name=contact,proto3" json:"contact,omitempty"`
+	Documents   []*TravelerDocument `protobuf:"bytes,6,rep,name=documents,proto3" json:"documents,omitempty"`
+}
+
+func (x *Traveler) Reset() {
+	*x = Traveler{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Traveler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Traveler) ProtoMessage() {}
+
+func (x *Traveler) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Traveler.ProtoReflect.Descriptor instead.
+func (*Traveler) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Traveler) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Traveler) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *Traveler) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *Traveler) GetName() *TravelerName {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *Traveler) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Traveler) GetDocuments() []*TravelerDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type TravelerName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName string `protobuf:"bytes,1,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	LastName  string `protobuf:"bytes,2,opt,name=lastName,proto3" json:"lastName,omitempty"`
+}
+
+func (x *TravelerName) Reset() {
+	*x = TravelerName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TravelerName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelerName) ProtoMessage() {}
+
+func (x *TravelerName) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelerName.ProtoReflect.Descriptor instead.
+func (*TravelerName) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TravelerName) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *TravelerName) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+type Contact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddresseeName *TravelerName  `protobuf:"bytes,1,opt,name=addresseeName,proto3" json:"addresseeName,omitempty"`
+	CompanyName   string         `protobuf:"bytes,2,opt,name=companyName,proto3" json:"companyName,omitempty"`
+	Purpose       string         `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Phones        []*Phone       `protobuf:"bytes,4,rep,name=phones,proto3" json:"phones,omitempty"`
+	EmailAddress  string         `protobuf:"bytes,5,opt,name=emailAddress,proto3" json:"emailAddress,omitempty"`
+	Address       *PostalAddress `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Contact) GetAddresseeName() *TravelerName {
+	if x != nil {
+		return x.AddresseeName
+	}
+	return nil
+}
+
+func (x *Contact) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *Contact) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *Contact) GetPhones() []*Phone {
+	if x != nil {
+		return x.Phones
+	}
+	return nil
+}
+
+func (x *Contact) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *Contact) GetAddress() *PostalAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type Phone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceType         string `protobuf:"bytes,1,opt,name=deviceType,proto3" json:"deviceType,omitempty"`
+	CountryCallingCode string `protobuf:"bytes,2,opt,name=countryCallingCode,proto3" json:"countryCallingCode,omitempty"`
+	Number             string `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *Phone) Reset() {
+	*x = Phone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Phone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Phone) ProtoMessage() {}
+
+func (x *Phone) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Phone.ProtoReflect.Descriptor instead.
+func (*Phone) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Phone) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *Phone) GetCountryCallingCode() string {
+	if x != nil {
+		return x.CountryCallingCode
+	}
+	return ""
+}
+
+func (x *Phone) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type TravelerDocument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentType     string `protobuf:"bytes,1,opt,name=documentType,proto3" json:"documentType,omitempty"`
+	Number           string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	ExpiryDate       string `protobuf:"bytes,3,opt,name=expiryDate,proto3" json:"expiryDate,omitempty"`
+	IssuanceCountry  string `protobuf:"bytes,4,opt,name=issuanceCountry,proto3" json:"issuanceCountry,omitempty"`
+	IssuanceDate     string `protobuf:"bytes,5,opt,name=issuanceDate,proto3" json:"issuanceDate,omitempty"`
+	IssuanceLocation string `protobuf:"bytes,6,opt,name=issuanceLocation,proto3" json:"issuanceLocation,omitempty"`
+	Nationality      string `protobuf:"bytes,7,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	BirthPlace       string `protobuf:"bytes,8,opt,name=birthPlace,proto3" json:"birthPlace,omitempty"`
+	ValidityCountry  string `protobuf:"bytes,9,opt,name=validityCountry,proto3" json:"validityCountry,omitempty"`
+	Holder           bool   `protobuf:"varint,10,opt,name=holder,proto3" json:"holder,omitempty"`
+}
+
+func (x *TravelerDocument) Reset() {
+	*x = TravelerDocument{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TravelerDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelerDocument) ProtoMessage() {}
+
+func (x *TravelerDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelerDocument.ProtoReflect.Descriptor instead.
+func (*TravelerDocument) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *TravelerDocument) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *TravelerDocument) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *TravelerDocument) GetExpiryDate() string {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *TravelerDocument) GetIssuanceCountry() string {
+	if x != nil {
+		return x.IssuanceCountry
+	}
+	return ""
+}
+
+func (x *TravelerDocument) GetIssuanceDate() string {
+	if x != nil {
+		return x.IssuanceDate
+	}
+	return ""
+}
+
+func (x *TravelerDocument) GetIssuanceLocation() string {
+	if x != nil {
+		return x.IssuanceLocation
+	}
+	return ""
+}
+
+func (x *TravelerDocument) GetNationality() string {
+	if x != nil {
+		return x.Nationality
+	}
+	return ""
+}
+
+func (x *TravelerDocument) GetBirthPlace() string {
+	if x != nil {
+		return x.BirthPlace
+	}
+	return ""
+}
+
+func (x *TravelerDocument) GetValidityCountry() string {
+	if x != nil {
+		return x.ValidityCountry
+	}
+	return ""
+}
+
+func (x *TravelerDocument) GetHolder() bool {
+	if x != nil {
+		return x.Holder
+	}
+	return false
+}
+
+type PostalAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lines       []string `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	PostalCode  string   `protobuf:"bytes,2,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+	CityName    string   `protobuf:"bytes,3,opt,name=cityName,proto3" json:"cityName,omitempty"`
+	CountryCode string   `protobuf:"bytes,4,opt,name=countryCode,proto3" json:"countryCode,omitempty"`
+}
+
+func (x *PostalAddress) Reset() {
+	*x = PostalAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostalAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostalAddress) ProtoMessage() {}
+
+func (x *PostalAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostalAddress.ProtoReflect.Descriptor instead.
+func (*PostalAddress) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PostalAddress) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PostalAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+type CancelFlightOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlightOrderId string `protobuf:"bytes,1,opt,name=flightOrderId,proto3" json:"flightOrderId,omitempty"`
+}
+
+func (x *CancelFlightOrderResponse) Reset() {
+	*x = CancelFlightOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelFlightOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFlightOrderResponse) ProtoMessage() {}
+
+func (x *CancelFlightOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFlightOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelFlightOrderResponse) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CancelFlightOrderResponse) GetFlightOrderId() string {
+	if x != nil {
+		return x.FlightOrderId
+	}
+	return ""
+}
+
+type SeatMapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data         []*SeatMap           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Dictionaries *SeatMapDictionaries `protobuf:"bytes,2,opt,name=dictionaries,proto3" json:"dictionaries,omitempty"`
+	Meta         *Meta                `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	Warnings     []*ErrorWarning      `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Errors       []*ErrorWarning      `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *SeatMapResponse) Reset() {
+	*x = SeatMapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMapResponse) ProtoMessage() {}
+
+func (x *SeatMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMapResponse.ProtoReflect.Descriptor instead.
+func (*SeatMapResponse) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SeatMapResponse) GetData() []*SeatMap {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SeatMapResponse) GetDictionaries() *SeatMapDictionaries {
+	if x != nil {
+		return x.Dictionaries
+	}
+	return nil
+}
+
+func (x *SeatMapResponse) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *SeatMapResponse) GetWarnings() []*ErrorWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *SeatMapResponse) GetErrors() []*ErrorWarning {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type SeatMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type                   string                   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                     string                   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Departure              *FlightEndPoint          `protobuf:"bytes,3,opt,name=departure,proto3" json:"departure,omitempty"`
+	Arrival                *FlightEndPoint          `protobuf:"bytes,4,opt,name=arrival,proto3" json:"arrival,omitempty"`
+	CarrierCode            string                   `protobuf:"bytes,5,opt,name=carrierCode,proto3" json:"carrierCode,omitempty"`
+	Number                 string                   `protobuf:"bytes,6,opt,name=number,proto3" json:"number,omitempty"`
+	Operating              *Operating               `protobuf:"bytes,7,opt,name=operating,proto3" json:"operating,omitempty"`
+	Aircraft               *Aircraft                `protobuf:"bytes,8,opt,name=aircraft,proto3" json:"aircraft,omitempty"`
+	Class                  string                   `protobuf:"bytes,9,opt,name=class,proto3" json:"class,omitempty"`
+	FlightOfferId          string                   `protobuf:"bytes,10,opt,name=flightOfferId,proto3" json:"flightOfferId,omitempty"`
+	SegmentId              string                   `protobuf:"bytes,11,opt,name=segmentId,proto3" json:"segmentId,omitempty"`
+	Decks                  []*Deck                  `protobuf:"bytes,12,rep,name=decks,proto3" json:"decks,omitempty"`
+	AvailableSeatsCounters []*AvailableSeatsCounter `protobuf:"bytes,13,rep,name=availableSeatsCounters,proto3" json:"availableSeatsCounters,omitempty"`
+}
+
+func (x *SeatMap) Reset() {
+	*x = SeatMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMap) ProtoMessage() {}
+
+func (x *SeatMap) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMap.ProtoReflect.Descriptor instead.
+func (*SeatMap) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SeatMap) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SeatMap) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SeatMap) GetDeparture() *FlightEndPoint {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *SeatMap) GetArrival() *FlightEndPoint {
+	if x != nil {
+		return x.Arrival
+	}
+	return nil
+}
+
+func (x *SeatMap) GetCarrierCode() string {
+	if x != nil {
+		return x.CarrierCode
+	}
+	return ""
+}
+
+func (x *SeatMap) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *SeatMap) GetOperating() *Operating {
+	if x != nil {
+		return x.Operating
+	}
+	return nil
+}
+
+func (x *SeatMap) GetAircraft() *Aircraft {
+	if x != nil {
+		return x.Aircraft
+	}
+	return nil
+}
+
+func (x *SeatMap) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *SeatMap) GetFlightOfferId() string {
+	if x != nil {
+		return x.FlightOfferId
+	}
+	return ""
+}
+
+func (x *SeatMap) GetSegmentId() string {
+	if x != nil {
+		return x.SegmentId
+	}
+	return ""
+}
+
+func (x *SeatMap) GetDecks() []*Deck {
+	if x != nil {
+		return x.Decks
+	}
+	return nil
+}
+
+func (x *SeatMap) GetAvailableSeatsCounters() []*AvailableSeatsCounter {
+	if x != nil {
+		return x.AvailableSeatsCounters
+	}
+	return nil
+}
+
+type Deck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeckType          string             `protobuf:"bytes,1,opt,name=deckType,proto3" json:"deckType,omitempty"`
+	DeckConfiguration *DeckConfiguration `protobuf:"bytes,2,opt,name=deckConfiguration,proto3" json:"deckConfiguration,omitempty"`
+	Facilities        []*Facility        `protobuf:"bytes,3,rep,name=facilities,proto3" json:"facilities,omitempty"`
+	Seats             []*Seat            `protobuf:"bytes,4,rep,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (x *Deck) Reset() {
+	*x = Deck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deck) ProtoMessage() {}
+
+func (x *Deck) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deck.ProtoReflect.Descriptor instead.
+func (*Deck) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Deck) GetDeckType() string {
+	if x != nil {
+		return x.DeckType
+	}
+	return ""
+}
+
+func (x *Deck) GetDeckConfiguration() *DeckConfiguration {
+	if x != nil {
+		return x.DeckConfiguration
+	}
+	return nil
+}
+
+func (x *Deck) GetFacilities() []*Facility {
+	if x != nil {
+		return x.Facilities
+	}
+	return nil
+}
+
+func (x *Deck) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type DeckConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width         int32   `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Length        int32   `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	StartSeatRow  int32   `protobuf:"varint,3,opt,name=startSeatRow,proto3" json:"startSeatRow,omitempty"`
+	EndSeatRow    int32   `protobuf:"varint,4,opt,name=endSeatRow,proto3" json:"endSeatRow,omitempty"`
+	StartWingsX   int32   `protobuf:"varint,5,opt,name=startWingsX,proto3" json:"startWingsX,omitempty"`
+	EndWingsX     int32   `protobuf:"varint,6,opt,name=endWingsX,proto3" json:"endWingsX,omitempty"`
+	StartWingsRow int32   `protobuf:"varint,7,opt,name=startWingsRow,proto3" json:"startWingsRow,omitempty"`
+	EndWingsRow   int32   `protobuf:"varint,8,opt,name=endWingsRow,proto3" json:"endWingsRow,omitempty"`
+	ExitRowsX     []int32 `protobuf:"varint,9,rep,packed,name=exitRowsX,proto3" json:"exitRowsX,omitempty"`
+}
+
+func (x *DeckConfiguration) Reset() {
+	*x = DeckConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeckConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeckConfiguration) ProtoMessage() {}
+
+func (x *DeckConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeckConfiguration.ProtoReflect.Descriptor instead.
+func (*DeckConfiguration) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeckConfiguration) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *DeckConfiguration) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *DeckConfiguration) GetStartSeatRow() int32 {
+	if x != nil {
+		return x.StartSeatRow
+	}
+	return 0
+}
+
+func (x *DeckConfiguration) GetEndSeatRow() int32 {
+	if x != nil {
+		return x.EndSeatRow
+	}
+	return 0
+}
+
+func (x *DeckConfiguration) GetStartWingsX() int32 {
+	if x != nil {
+		return x.StartWingsX
+	}
+	return 0
+}
+
+func (x *DeckConfiguration) GetEndWingsX() int32 {
+	if x != nil {
+		return x.EndWingsX
+	}
+	return 0
+}
+
+func (x *DeckConfiguration) GetStartWingsRow() int32 {
+	if x != nil {
+		return x.StartWingsRow
+	}
+	return 0
+}
+
+func (x *DeckConfiguration) GetEndWingsRow() int32 {
+	if x != nil {
+		return x.EndWingsRow
+	}
+	return 0
+}
+
+func (x *DeckConfiguration) GetExitRowsX() []int32 {
+	if x != nil {
+		return x.ExitRowsX
+	}
+	return nil
+}
+
+type Facility struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code        string       `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Column      string       `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	Row         string       `protobuf:"bytes,3,opt,name=row,proto3" json:"row,omitempty"`
+	Position    string       `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	Coordinates *Coordinates `protobuf:"bytes,5,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+}
+
+func (x *Facility) Reset() {
+	*x = Facility{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Facility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facility) ProtoMessage() {}
+
+func (x *Facility) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facility.ProtoReflect.Descriptor instead.
+func (*Facility) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Facility) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Facility) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *Facility) GetRow() string {
+	if x != nil {
+		return x.Row
+	}
+	return ""
+}
+
+func (x *Facility) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *Facility) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+type Seat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cabin                string                 `protobuf:"bytes,1,opt,name=cabin,proto3" json:"cabin,omitempty"`
+	Number               string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	CharacteristicsCodes []string               `protobuf:"bytes,3,rep,name=characteristicsCodes,proto3" json:"characteristicsCodes,omitempty"`
+	TravelerPricing      []*SeatTravelerPricing `protobuf:"bytes,4,rep,name=travelerPricing,proto3" json:"travelerPricing,omitempty"`
+	Coordinates          *Coordinates           `protobuf:"bytes,5,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
+}
+
+func (x *Seat) Reset() {
+	*x = Seat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Seat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seat) ProtoMessage() {}
+
+func (x *Seat) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seat.ProtoReflect.Descriptor instead.
+func (*Seat) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Seat) GetCabin() string {
+	if x != nil {
+		return x.Cabin
+	}
+	return ""
+}
+
+func (x *Seat) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Seat) GetCharacteristicsCodes() []string {
+	if x != nil {
+		return x.CharacteristicsCodes
+	}
+	return nil
+}
+
+func (x *Seat) GetTravelerPricing() []*SeatTravelerPricing {
+	if x != nil {
+		return x.TravelerPricing
+	}
+	return nil
+}
+
+func (x *Seat) GetCoordinates() *Coordinates {
+	if x != nil {
+		return x.Coordinates
+	}
+	return nil
+}
+
+type SeatTravelerPricing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TravelerId             string      `protobuf:"bytes,1,opt,name=travelerId,proto3" json:"travelerId,omitempty"`
+	SeatAvailabilityStatus string      `protobuf:"bytes,2,opt,name=seatAvailabilityStatus,proto3" json:"seatAvailabilityStatus,omitempty"`
+	Price                  *OfferPrice `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *SeatTravelerPricing) Reset() {
+	*x = SeatTravelerPricing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatTravelerPricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatTravelerPricing) ProtoMessage() {}
+
+func (x *SeatTravelerPricing) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatTravelerPricing.ProtoReflect.Descriptor instead.
+func (*SeatTravelerPricing) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SeatTravelerPricing) GetTravelerId() string {
+	if x != nil {
+		return x.TravelerId
+	}
+	return ""
+}
+
+func (x *SeatTravelerPricing) GetSeatAvailabilityStatus() string {
+	if x != nil {
+		return x.SeatAvailabilityStatus
+	}
+	return ""
+}
+
+func (x *SeatTravelerPricing) GetPrice() *OfferPrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type Coordinates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X int32 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y int32 `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *Coordinates) Reset() {
+	*x = Coordinates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Coordinates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coordinates) ProtoMessage() {}
+
+func (x *Coordinates) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
+func (*Coordinates) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Coordinates) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Coordinates) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type AvailableSeatsCounter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TravelerId string `protobuf:"bytes,1,opt,name=travelerId,proto3" json:"travelerId,omitempty"`
+	Value      int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AvailableSeatsCounter) Reset() {
+	*x = AvailableSeatsCounter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailableSeatsCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableSeatsCounter) ProtoMessage() {}
+
+func (x *AvailableSeatsCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableSeatsCounter.ProtoReflect.Descriptor instead.
+func (*AvailableSeatsCounter) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AvailableSeatsCounter) GetTravelerId() string {
+	if x != nil {
+		return x.TravelerId
+	}
+	return ""
+}
+
+func (x *AvailableSeatsCounter) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SeatMapDictionaries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locations          map[string]*LocationValue `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Facility           map[string]string         `protobuf:"bytes,2,rep,name=facility,proto3" json:"facility,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SeatCharacteristic map[string]string         `protobuf:"bytes,3,rep,name=seatCharacteristic,proto3" json:"seatCharacteristic,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SeatMapDictionaries) Reset() {
+	*x = SeatMapDictionaries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatMapDictionaries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMapDictionaries) ProtoMessage() {}
+
+func (x *SeatMapDictionaries) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMapDictionaries.ProtoReflect.Descriptor instead.
+func (*SeatMapDictionaries) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SeatMapDictionaries) GetLocations() map[string]*LocationValue {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *SeatMapDictionaries) GetFacility() map[string]string {
+	if x != nil {
+		return x.Facility
+	}
+	return nil
+}
+
+func (x *SeatMapDictionaries) GetSeatCharacteristic() map[string]string {
+	if x != nil {
+		return x.SeatCharacteristic
+	}
+	return nil
+}
+
+type Amenity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description     string           `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	IsChargeable    bool             `protobuf:"varint,2,opt,name=isChargeable,proto3" json:"isChargeable,omitempty"`
+	AmenityType     string           `protobuf:"bytes,3,opt,name=amenityType,proto3" json:"amenityType,omitempty"`
+	AmenityProvider *AmenityProvider `protobuf:"bytes,4,opt,name=amenityProvider,proto3" json:"amenityProvider,omitempty"`
+}
+
+func (x *Amenity) Reset() {
+	*x = Amenity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Amenity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Amenity) ProtoMessage() {}
+
+func (x *Amenity) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Amenity.ProtoReflect.Descriptor instead.
+func (*Amenity) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Amenity) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Amenity) GetIsChargeable() bool {
+	if x != nil {
+		return x.IsChargeable
+	}
+	return false
+}
+
+func (x *Amenity) GetAmenityType() string {
+	if x != nil {
+		return x.AmenityType
+	}
+	return ""
+}
+
+func (x *Amenity) GetAmenityProvider() *AmenityProvider {
+	if x != nil {
+		return x.AmenityProvider
+	}
+	return nil
+}
+
+type AmenityProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *AmenityProvider) Reset() {
+	*x = AmenityProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmenityProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmenityProvider) ProtoMessage() {}
+
+func (x *AmenityProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmenityProvider.ProtoReflect.Descriptor instead.
+func (*AmenityProvider) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AmenityProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type FlightAvailabilitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data         []*FlightAvailability `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Dictionaries *OfferDictionaries    `protobuf:"bytes,2,opt,name=dictionaries,proto3" json:"dictionaries,omitempty"`
+	Meta         *Meta                 `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	Warnings     []*ErrorWarning       `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Errors       []*ErrorWarning       `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *FlightAvailabilitiesResponse) Reset() {
+	*x = FlightAvailabilitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightAvailabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightAvailabilitiesResponse) ProtoMessage() {}
+
+func (x *FlightAvailabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightAvailabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*FlightAvailabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *FlightAvailabilitiesResponse) GetData() []*FlightAvailability {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FlightAvailabilitiesResponse) GetDictionaries() *OfferDictionaries {
+	if x != nil {
+		return x.Dictionaries
+	}
+	return nil
+}
+
+func (x *FlightAvailabilitiesResponse) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *FlightAvailabilitiesResponse) GetWarnings() []*ErrorWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *FlightAvailabilitiesResponse) GetErrors() []*ErrorWarning {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type FlightAvailability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type                     string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id                       string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	OriginDestinationId      string                 `protobuf:"bytes,3,opt,name=originDestinationId,proto3" json:"originDestinationId,omitempty"`
+	Source                   string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	InstantTicketingRequired bool                   `protobuf:"varint,5,opt,name=instantTicketingRequired,proto3" json:"instantTicketingRequired,omitempty"`
+	PaymentCardRequired      bool                   `protobuf:"varint,6,opt,name=paymentCardRequired,proto3" json:"paymentCardRequired,omitempty"`
+	Duration                 string                 `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	Segments                 []*AvailabilitySegment `protobuf:"bytes,8,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *FlightAvailability) Reset() {
+	*x = FlightAvailability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlightAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlightAvailability) ProtoMessage() {}
+
+func (x *FlightAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlightAvailability.ProtoReflect.Descriptor instead.
+func (*FlightAvailability) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *FlightAvailability) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FlightAvailability) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FlightAvailability) GetOriginDestinationId() string {
+	if x != nil {
+		return x.OriginDestinationId
+	}
+	return ""
+}
+
+func (x *FlightAvailability) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FlightAvailability) GetInstantTicketingRequired() bool {
+	if x != nil {
+		return x.InstantTicketingRequired
+	}
+	return false
+}
+
+func (x *FlightAvailability) GetPaymentCardRequired() bool {
+	if x != nil {
+		return x.PaymentCardRequired
+	}
+	return false
+}
+
+func (x *FlightAvailability) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *FlightAvailability) GetSegments() []*AvailabilitySegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type AvailabilitySegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NumberOfStops       int32                `protobuf:"varint,2,opt,name=numberOfStops,proto3" json:"numberOfStops,omitempty"`
+	BlacklistedInEU     bool                 `protobuf:"varint,3,opt,name=blacklistedInEU,proto3" json:"blacklistedInEU,omitempty"`
+	Departure           *FlightEndPoint      `protobuf:"bytes,4,opt,name=departure,proto3" json:"departure,omitempty"`
+	Arrival             *FlightEndPoint      `protobuf:"bytes,5,opt,name=arrival,proto3" json:"arrival,omitempty"`
+	CarrierCode         string               `protobuf:"bytes,6,opt,name=carrierCode,proto3" json:"carrierCode,omitempty"`
+	Number              string               `protobuf:"bytes,7,opt,name=number,proto3" json:"number,omitempty"`
+	Aircraft            *Aircraft            `protobuf:"bytes,8,opt,name=aircraft,proto3" json:"aircraft,omitempty"`
+	Operating           *Operating           `protobuf:"bytes,9,opt,name=operating,proto3" json:"operating,omitempty"`
+	AvailabilityClasses []*AvailabilityClass `protobuf:"bytes,10,rep,name=availabilityClasses,proto3" json:"availabilityClasses,omitempty"`
+}
+
+func (x *AvailabilitySegment) Reset() {
+	*x = AvailabilitySegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailabilitySegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilitySegment) ProtoMessage() {}
+
+func (x *AvailabilitySegment) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilitySegment.ProtoReflect.Descriptor instead.
+func (*AvailabilitySegment) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AvailabilitySegment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AvailabilitySegment) GetNumberOfStops() int32 {
+	if x != nil {
+		return x.NumberOfStops
+	}
+	return 0
+}
+
+func (x *AvailabilitySegment) GetBlacklistedInEU() bool {
+	if x != nil {
+		return x.BlacklistedInEU
+	}
+	return false
+}
+
+func (x *AvailabilitySegment) GetDeparture() *FlightEndPoint {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *AvailabilitySegment) GetArrival() *FlightEndPoint {
+	if x != nil {
+		return x.Arrival
+	}
+	return nil
+}
+
+func (x *AvailabilitySegment) GetCarrierCode() string {
+	if x != nil {
+		return x.CarrierCode
+	}
+	return ""
+}
+
+func (x *AvailabilitySegment) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *AvailabilitySegment) GetAircraft() *Aircraft {
+	if x != nil {
+		return x.Aircraft
+	}
+	return nil
+}
+
+func (x *AvailabilitySegment) GetOperating() *Operating {
+	if x != nil {
+		return x.Operating
+	}
+	return nil
+}
+
+func (x *AvailabilitySegment) GetAvailabilityClasses() []*AvailabilityClass {
+	if x != nil {
+		return x.AvailabilityClasses
+	}
+	return nil
+}
+
+type AvailabilityClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumberOfBookableSeats int32  `protobuf:"varint,1,opt,name=numberOfBookableSeats,proto3" json:"numberOfBookableSeats,omitempty"`
+	Class                 string `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
+	ClosedStatus          string `protobuf:"bytes,3,opt,name=closedStatus,proto3" json:"closedStatus,omitempty"`
+}
+
+func (x *AvailabilityClass) Reset() {
+	*x = AvailabilityClass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailabilityClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityClass) ProtoMessage() {}
+
+func (x *AvailabilityClass) ProtoReflect() protoreflect.Message {
+	mi := &file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityClass.ProtoReflect.Descriptor instead.
+func (*AvailabilityClass) Descriptor() ([]byte, []int) {
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AvailabilityClass) GetNumberOfBookableSeats() int32 {
+	if x != nil {
+		return x.NumberOfBookableSeats
+	}
+	return 0
+}
+
+func (x *AvailabilityClass) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *AvailabilityClass) GetClosedStatus() string {
+	if x != nil {
+		return x.ClosedStatus
+	}
+	return ""
+}
+
+var File_amadeus_go_api_amadeus_type_amadeus_type_proto protoreflect.FileDescriptor
+
+var file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0c, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x22, 0x86,
+	0x02, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x0c, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x08, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xa6, 0x08, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x0a, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x09, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x61, 0x74, 0x61,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x61, 0x74, 0x61,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x67, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x47, 0x65, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x67, 0x65,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x72,
+	0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x04,
+	0x73, 0x65, 0x6c, 0x66, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x52, 0x04,
+	0x73, 0x65, 0x6c, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x72, 0x65, 0x66, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x72, 0x65, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x63, 0x61, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x61, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x58, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xa4, 0x01, 0x0a, 0x09, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x31,
+	0x0a, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0d,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50,
+	0x65, 0x72, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x22, 0x3c, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x41, 0x0a, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x59, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x65, 0x72, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x50, 0x65, 0x72, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x52, 0x15, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x65, 0x72, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x22,
+	0xc8, 0x02, 0x0a, 0x0d, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x3c, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x38, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x52, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x52, 0x08, 0x61,
+	0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x10, 0x44, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x61, 0x74, 0x22, 0x1e, 0x0a, 0x08, 0x41, 0x69, 0x72, 0x63, 0x72, 0x61,
+	0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x45, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x99, 0x01,
+	0x0a, 0x15, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50,
+	0x65, 0x72, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65,
+	0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x76, 0x65, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x72,
+	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61,
+	0x72, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x61, 0x72, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x61, 0x72, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x22, 0x3d, 0x0a, 0x05, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x54, 0x61, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x54, 0x61, 0x78, 0x65, 0x73, 0x22, 0xc8, 0x04, 0x0a, 0x0c, 0x44, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x63, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x73, 0x12,
+	0x4a, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x09, 0x61,
+	0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41, 0x69, 0x72, 0x63, 0x72,
+	0x61, 0x66, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x69, 0x72, 0x63, 0x72,
+	0x61, 0x66, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3b, 0x0a,
+	0x0d, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x41, 0x69, 0x72,
+	0x63, 0x72, 0x61, 0x66, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5a, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x83, 0x01, 0x0a, 0x07, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12,
+	0x3c, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x89, 0x01, 0x0a, 0x08, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x40, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x3b, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x41, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x12, 0x4c,
+	0x0a, 0x08, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x41, 0x69, 0x72, 0x63,
+	0x72, 0x61, 0x66, 0x74, 0x2e, 0x41, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x41, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa7, 0x01, 0x0a, 0x08, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x59, 0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x8d, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x40, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x39, 0x0a, 0x0b, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x97, 0x01, 0x0a, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x05,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x32, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x08, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb9, 0x01,
+	0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c,
+	0x61, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x08, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x6e, 0x53, 0x74, 0x6f,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x61, 0x64, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x6e, 0x65, 0x57, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6f, 0x6e, 0x65, 0x57, 0x61, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x76, 0x69, 0x65, 0x77, 0x42, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x09, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x07,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x76, 0x65,
+	0x6c, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52,
+	0x09, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x52, 0x08, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x22, 0x69, 0x0a, 0x05, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x4a, 0x0a, 0x10, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x73, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x07, 0x47, 0x65, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x07,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x34, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0x34, 0x0a, 0x04, 0x53, 0x65, 0x6c, 0x66, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x72, 0x65, 0x66, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x96, 0x01,
+	0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x5a, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x22, 0xdc, 0x02, 0x0a, 0x10, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x54, 0x72,
+	0x69, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73,
+	0x2e, 0x50, 0x65, 0x72, 0x54, 0x72, 0x69, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x54, 0x72, 0x69, 0x70, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x44, 0x61, 0x79,
+	0x73, 0x49, 0x6e, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x73,
+	0x2e, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x49, 0x6e, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x70, 0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x49,
+	0x6e, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x42, 0x0a, 0x14, 0x50, 0x65, 0x72, 0x54,
+	0x72, 0x69, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15,
+	0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x49, 0x6e, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x5b, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x9e,
+	0x02, 0x0a, 0x14, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0c, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x64,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
+	0xdd, 0x04, 0x0a, 0x0b, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x6f, 0x6e, 0x48, 0x6f,
+	0x6d, 0x6f, 0x67, 0x65, 0x6e, 0x65, 0x6f, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x6e, 0x6f, 0x6e, 0x48, 0x6f, 0x6d, 0x6f, 0x67, 0x65, 0x6e, 0x65, 0x6f, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x65, 0x57, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6f, 0x6e, 0x65, 0x57, 0x61, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f,
+	0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x42, 0x6f,
+	0x6f, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x69,
+	0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x49, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x52, 0x0b, 0x69, 0x74, 0x69, 0x6e, 0x65,
+	0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x72,
+	0x69, 0x63, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x70, 0x72,
+	0x69, 0x63, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x16,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72,
+	0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x54, 0x72,
+	0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x74,
+	0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x5f, 0x0a, 0x09, 0x49, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0xa3, 0x03, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x3a, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x36, 0x0a,
+	0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x72,
+	0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x32, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x41, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x52, 0x08, 0x61, 0x69, 0x72, 0x63, 0x72,
+	0x61, 0x66, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x4f, 0x66, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x45, 0x55, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x64, 0x49, 0x6e, 0x45, 0x55, 0x22, 0x58, 0x0a, 0x0e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x45, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x61, 0x74, 0x61,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x61, 0x74, 0x61,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
+	0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74,
+	0x22, 0xaf, 0x02, 0x0a, 0x0a, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x67, 0x72, 0x61, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x05,
+	0x74, 0x61, 0x78, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x54, 0x61, 0x78, 0x52, 0x05,
+	0x74, 0x61, 0x78, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61,
+	0x62, 0x6c, 0x65, 0x54, 0x61, 0x78, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x61, 0x78, 0x65, 0x73, 0x12,
+	0x41, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x12,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x22, 0x31, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x66, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x72, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x72, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xab, 0x02,
+	0x0a, 0x0f, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x61, 0x72, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x72, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x41, 0x64, 0x75, 0x6c,
+	0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x14, 0x66, 0x61, 0x72, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x42, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x46, 0x61, 0x72, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x14, 0x66, 0x61, 0x72, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x42, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xd3, 0x02, 0x0a, 0x14,
+	0x46, 0x61, 0x72, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x62, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x61, 0x62, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x72, 0x65,
+	0x42, 0x61, 0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x72,
+	0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x65,
+	0x64, 0x46, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x72, 0x61,
+	0x6e, 0x64, 0x65, 0x64, 0x46, 0x61, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x50,
+	0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x64, 0x42, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x42, 0x61, 0x67, 0x67, 0x61,
+	0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x61, 0x67, 0x73,
+	0x12, 0x2a, 0x0a, 0x10, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x46, 0x61, 0x72, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x72, 0x61, 0x6e,
+	0x64, 0x65, 0x64, 0x46, 0x61, 0x72, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x33, 0x0a, 0x09,
+	0x61, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x41,
+	0x6d, 0x65, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x09, 0x61, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0x66, 0x0a, 0x10, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x22, 0xdc, 0x04, 0x0a, 0x11, 0x4f, 0x66,
+	0x66, 0x65, 0x72, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x4c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x49, 0x0a,
+	0x08, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x2e, 0x41, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x12, 0x4f, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x08, 0x63, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x73, 0x1a, 0x59, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x3b, 0x0a, 0x0d, 0x41, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x43,
+	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4d, 0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x03, 0x54, 0x61, 0x78, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xbe, 0x02, 0x0a, 0x19, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x39, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
+	0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0c, 0x64, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x0c, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x36, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x57, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x13,
+	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x53, 0x0a, 0x13, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x13, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xcf, 0x02, 0x0a, 0x13,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x14, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x19, 0x6d, 0x6f, 0x62, 0x69, 0x6c,
+	0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x6d, 0x6f, 0x62, 0x69,
+	0x6c, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x36, 0x0a,
+	0x16, 0x6d, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x6d,
+	0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x56, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x14, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xe2, 0x02,
+	0x0a, 0x14, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x76,
+	0x65, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2a,
+	0x0a, 0x10, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x1c, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x1c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x30,
+	0x0a, 0x13, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x32, 0x0a, 0x14, 0x72, 0x65, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x49, 0x66, 0x41, 0x6e, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x72, 0x65, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x49,
+	0x66, 0x41, 0x6e, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x22, 0xa5, 0x04, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x46, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x72,
+	0x69, 0x63, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x2e, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x43, 0x61, 0x72, 0x64, 0x46, 0x65, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x61, 0x72, 0x64, 0x46, 0x65, 0x65,
+	0x73, 0x12, 0x3b, 0x0a, 0x04, 0x62, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x2e, 0x42,
+	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x62, 0x61, 0x67, 0x73, 0x12, 0x62,
+	0x0a, 0x11, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x46, 0x61, 0x72, 0x65, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x46, 0x61, 0x72, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x11, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x46, 0x61, 0x72, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x1a, 0x5e, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x43, 0x61, 0x72, 0x64,
+	0x46, 0x65, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x46, 0x65, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x4f, 0x0a, 0x09, 0x42, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x42, 0x61, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x65, 0x0a, 0x16, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x46,
+	0x61, 0x72, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x46, 0x61, 0x72, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7f, 0x0a, 0x0d, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x43, 0x61, 0x72, 0x64, 0x46, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x72, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x22, 0x94, 0x02, 0x0a, 0x08,
+	0x42, 0x61, 0x67, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x42,
+	0x61, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x30,
+	0x0a, 0x13, 0x62, 0x6f, 0x6f, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x74, 0x69, 0x6e,
+	0x65, 0x72, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x62, 0x6f, 0x6f,
+	0x6b, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x22, 0x46, 0x0a, 0x08, 0x42, 0x61, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x46, 0x61, 0x72, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x72, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x61, 0x72, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x35, 0x0a, 0x09, 0x66, 0x61, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x46, 0x61, 0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x09, 0x66, 0x61,
+	0x72, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x09, 0x46, 0x61, 0x72, 0x65, 0x4e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x61, 0x72, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4f, 0x0a, 0x0f, 0x46, 0x61, 0x72, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0xf5, 0x01, 0x0a, 0x13, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x43, 0x0a, 0x0c, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0xd1, 0x02, 0x0a, 0x0b, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x4f,
+	0x66, 0x66, 0x69, 0x63, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71,
+	0x75, 0x65, 0x75, 0x69, 0x6e, 0x67, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x4c,
+	0x0a, 0x11, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x11, 0x61, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x0c,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x0c, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x74,
+	0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x54, 0x72,
+	0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72,
+	0x73, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf3, 0x01,
+	0x0a, 0x08, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x48, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8f, 0x02,
+	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0d, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x06, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x6f, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x61, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0xec, 0x02, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x73, 0x73, 0x75,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x69,
+	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x73, 0x73, 0x75, 0x61,
+	0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x62, 0x69, 0x72, 0x74, 0x68, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x69, 0x72, 0x74, 0x68, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x22,
+	0x83, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x73,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x69, 0x74, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x41, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x97, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x61,
+	0x74, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x6d, 0x61,
+	0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61,
+	0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x0c, 0x64, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x65, 0x61,
+	0x74, 0x4d, 0x61, 0x70, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x0c, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0xa7, 0x04, 0x0a, 0x07, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x45, 0x6e, 0x64, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x36,
+	0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x61,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x35, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x63, 0x72,
+	0x61, 0x66, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x08, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x64, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x6b, 0x52, 0x05, 0x64, 0x65, 0x63, 0x6b, 0x73, 0x12,
+	0x5b, 0x0a, 0x16, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x52, 0x16, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x65, 0x61, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0xd3, 0x01, 0x0a,
+	0x04, 0x44, 0x65, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x4d, 0x0a, 0x11, 0x64, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x64,
+	0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x0a, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x66, 0x61,
+	0x63, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x05, 0x73, 0x65, 0x61,
+	0x74, 0x73, 0x22, 0xab, 0x02, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53,
+	0x65, 0x61, 0x74, 0x52, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x65, 0x61, 0x74, 0x52, 0x6f, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e,
+	0x64, 0x53, 0x65, 0x61, 0x74, 0x52, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x65, 0x6e, 0x64, 0x53, 0x65, 0x61, 0x74, 0x52, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x57, 0x69, 0x6e, 0x67, 0x73, 0x58, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x57, 0x69, 0x6e, 0x67, 0x73, 0x58, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x6e, 0x64, 0x57, 0x69, 0x6e, 0x67, 0x73, 0x58, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x6e, 0x67, 0x73, 0x58, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x57, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x57, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x6f, 0x77,
+	0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x6f, 0x77, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x77, 0x73, 0x58, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x77, 0x73, 0x58,
+	0x22, 0xa1, 0x01, 0x0a, 0x08, 0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6f, 0x72,
+	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x04, 0x53, 0x65, 0x61, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x61, 0x62, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61,
+	0x62, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x63, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x4b, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x54, 0x72, 0x61, 0x76,
+	0x65, 0x6c, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x74, 0x72, 0x61,
+	0x76, 0x65, 0x6c, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x63, 0x6f,
+	0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x53, 0x65,
+	0x61, 0x74, 0x54, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e,
+	0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x36, 0x0a, 0x16, 0x73, 0x65, 0x61, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x16, 0x73, 0x65, 0x61, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65,
+	0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x29, 0x0a, 0x0b, 0x43, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x79, 0x22, 0x4d, 0x0a, 0x15, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x76, 0x65, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xfc, 0x03, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x44,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x65,
+	0x61, 0x74, 0x4d, 0x61, 0x70, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x08, 0x66,
+	0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x65, 0x61,
+	0x74, 0x4d, 0x61, 0x70, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x2e, 0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x69, 0x0a, 0x12, 0x73, 0x65, 0x61, 0x74,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x4d, 0x61, 0x70, 0x44, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x12, 0x73, 0x65, 0x61, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x1a, 0x59, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x46, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x53,
+	0x65, 0x61, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xba, 0x01, 0x0a, 0x07, 0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6d, 0x65, 0x6e, 0x69,
+	0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x61, 0x6d, 0x65, 0x6e, 0x69, 0x74,
+	0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x41,
+	0x6d, 0x65, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x0f,
+	0x61, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22,
+	0x25, 0x0a, 0x0f, 0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xad, 0x02, 0x0a, 0x1c, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x43, 0x0a,
+	0x0c, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x08, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xcb, 0x02, 0x0a, 0x12, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x30, 0x0a, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0xe1, 0x03, 0x0a, 0x13, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x53, 0x74, 0x6f,
+	0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x49, 0x6e, 0x45, 0x55, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x62, 0x6c, 0x61,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x45, 0x55, 0x12, 0x3a, 0x0a, 0x09,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69,
+	0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x6d, 0x61, 0x64,
+	0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x45,
+	0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x69,
+	0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x69, 0x72, 0x63,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x08, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x12, 0x35,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x34,
+	0x0a, 0x15, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x65, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x2a,
+	0x5a, 0x28, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6d, 0x61, 0x64, 0x65, 0x75, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x3b, 0x61, 0x6d,
+	0x61, 0x64, 0x65, 0x75, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescOnce sync.Once
+	file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescData = file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDesc
+)
+
+func file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescGZIP() []byte {
+	file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescOnce.Do(func() {
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescData = protoimpl.X.CompressGZIP(file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescData)
+	})
+	return file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDescData
+}
+
+var file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
+var file_amadeus_go_api_amadeus_type_amadeus_type_proto_goTypes = []any{
+	(*Response)(nil),                     // 0: amadeus.type.Response
+	(*Data)(nil),                         // 1: amadeus.type.Data
+	(*OfferItem)(nil),                    // 2: amadeus.type.OfferItem
+	(*Service)(nil),                      // 3: amadeus.type.Service
+	(*Segment)(nil),                      // 4: amadeus.type.Segment
+	(*FlightSegment)(nil),                // 5: amadeus.type.FlightSegment
+	(*DepartureArrival)(nil),             // 6: amadeus.type.DepartureArrival
+	(*Aircraft)(nil),                     // 7: amadeus.type.Aircraft
+	(*Operating)(nil),                    // 8: amadeus.type.Operating
+	(*PricingDetailPerAdult)(nil),        // 9: amadeus.type.PricingDetailPerAdult
+	(*Price)(nil),                        // 10: amadeus.type.Price
+	(*Dictionaries)(nil),                 // 11: amadeus.type.Dictionaries
+	(*Carrier)(nil),                      // 12: amadeus.type.Carrier
+	(*Currency)(nil),                     // 13: amadeus.type.Currency
+	(*DictionariesAircraft)(nil),         // 14: amadeus.type.DictionariesAircraft
+	(*Location)(nil),                     // 15: amadeus.type.Location
+	(*LocationDetail)(nil),               // 16: amadeus.type.LocationDetail
+	(*Meta)(nil),                         // 17: amadeus.type.Meta
+	(*Links)(nil),                        // 18: amadeus.type.Links
+	(*Defaults)(nil),                     // 19: amadeus.type.Defaults
+	(*Analytics)(nil),                    // 20: amadeus.type.Analytics
+	(*Score)(nil),                        // 21: amadeus.type.Score
+	(*GeoCode)(nil),                      // 22: amadeus.type.GeoCode
+	(*Address)(nil),                      // 23: amadeus.type.Address
+	(*Distance)(nil),                     // 24: amadeus.type.Distance
+	(*Self)(nil),                         // 25: amadeus.type.Self
+	(*ErrorWarning)(nil),                 // 26: amadeus.type.ErrorWarning
+	(*Source)(nil),                       // 27: amadeus.type.Source
+	(*NumberOfSearches)(nil),             // 28: amadeus.type.NumberOfSearches
+	(*ParamDetail)(nil),                  // 29: amadeus.type.ParamDetail
+	(*FlightOffersResponse)(nil),         // 30: amadeus.type.FlightOffersResponse
+	(*FlightOffer)(nil),                  // 31: amadeus.type.FlightOffer
+	(*Itinerary)(nil),                    // 32: amadeus.type.Itinerary
+	(*OfferSegment)(nil),                 // 33: amadeus.type.OfferSegment
+	(*FlightEndPoint)(nil),               // 34: amadeus.type.FlightEndPoint
+	(*OfferPrice)(nil),                   // 35: amadeus.type.OfferPrice
+	(*Fee)(nil),                          // 36: amadeus.type.Fee
+	(*PricingOptions)(nil),               // 37: amadeus.type.PricingOptions
+	(*TravelerPricing)(nil),              // 38: amadeus.type.TravelerPricing
+	(*FareDetailsBySegment)(nil),         // 39: amadeus.type.FareDetailsBySegment
+	(*BaggageAllowance)(nil),             // 40: amadeus.type.BaggageAllowance
+	(*OfferDictionaries)(nil),            // 41: amadeus.type.OfferDictionaries
+	(*LocationValue)(nil),                // 42: amadeus.type.LocationValue
+	(*Tax)(nil),                          // 43: amadeus.type.Tax
+	(*FlightOffersPriceResponse)(nil),    // 44: amadeus.type.FlightOffersPriceResponse
+	(*FlightOffersPricing)(nil),          // 45: amadeus.type.FlightOffersPricing
+	(*BookingRequirements)(nil),          // 46: amadeus.type.BookingRequirements
+	(*TravelerRequirements)(nil),         // 47: amadeus.type.TravelerRequirements
+	(*PricingIncluded)(nil),              // 48: amadeus.type.PricingIncluded
+	(*CreditCardFee)(nil),                // 49: amadeus.type.CreditCardFee
+	(*BagOffer)(nil),                     // 50: amadeus.type.BagOffer
+	(*BagPrice)(nil),                     // 51: amadeus.type.BagPrice
+	(*DetailedFareRules)(nil),            // 52: amadeus.type.DetailedFareRules
+	(*FareNotes)(nil),                    // 53: amadeus.type.FareNotes
+	(*FareDescription)(nil),              // 54: amadeus.type.FareDescription
+	(*FlightOrderResponse)(nil),          // 55: amadeus.type.FlightOrderResponse
+	(*FlightOrder)(nil),                  // 56: amadeus.type.FlightOrder
+	(*AssociatedRecord)(nil),             // 57: amadeus.type.AssociatedRecord
+	(*Traveler)(nil),                     // 58: amadeus.type.Traveler
+	(*TravelerName)(nil),                 // 59: amadeus.type.TravelerName
+	(*Contact)(nil),                      // 60: amadeus.type.Contact
+	(*Phone)(nil),                        // 61: amadeus.type.Phone
+	(*TravelerDocument)(nil),             // 62: amadeus.type.TravelerDocument
+	(*PostalAddress)(nil),                // 63: amadeus.type.PostalAddress
+	(*CancelFlightOrderResponse)(nil),    // 64: amadeus.type.CancelFlightOrderResponse
+	(*SeatMapResponse)(nil),              // 65: amadeus.type.SeatMapResponse
+	(*SeatMap)(nil),                      // 66: amadeus.type.SeatMap
+	(*Deck)(nil),                         // 67: amadeus.type.Deck
+	(*DeckConfiguration)(nil),            // 68: amadeus.type.DeckConfiguration
+	(*Facility)(nil),                     // 69: amadeus.type.Facility
+	(*Seat)(nil),                         // 70: amadeus.type.Seat
+	(*SeatTravelerPricing)(nil),          // 71: amadeus.type.SeatTravelerPricing
+	(*Coordinates)(nil),                  // 72: amadeus.type.Coordinates
+	(*AvailableSeatsCounter)(nil),        // 73: amadeus.type.AvailableSeatsCounter
+	(*SeatMapDictionaries)(nil),          // 74: amadeus.type.SeatMapDictionaries
+	(*Amenity)(nil),                      // 75: amadeus.type.Amenity
+	(*AmenityProvider)(nil),              // 76: amadeus.type.AmenityProvider
+	(*FlightAvailabilitiesResponse)(nil), // 77: amadeus.type.FlightAvailabilitiesResponse
+	(*FlightAvailability)(nil),           // 78: amadeus.type.FlightAvailability
+	(*AvailabilitySegment)(nil),          // 79: amadeus.type.AvailabilitySegment
+	(*AvailabilityClass)(nil),            // 80: amadeus.type.AvailabilityClass
+	nil,                                  // 81: amadeus.type.Data.ParametersEntry
+	nil,                                  // 82: amadeus.type.Dictionaries.CarriersEntry
+	nil,                                  // 83: amadeus.type.Dictionaries.CurrenciesEntry
+	nil,                                  // 84: amadeus.type.Dictionaries.AircraftsEntry
+	nil,                                  // 85: amadeus.type.Dictionaries.LocationsEntry
+	nil,                                  // 86: amadeus.type.Carrier.CarrierEntry
+	nil,                                  // 87: amadeus.type.Currency.CurrencyEntry
+	nil,                                  // 88: amadeus.type.DictionariesAircraft.AircraftEntry
+	nil,                                  // 89: amadeus.type.Location.LocationEntry
+	nil,                                  // 90: amadeus.type.LocationDetail.DetailEntry
+	nil,                                  // 91: amadeus.type.NumberOfSearches.PerTripDurationEntry
+	nil,                                  // 92: amadeus.type.NumberOfSearches.PerDaysInAdvanceEntry
+	nil,                                  // 93: amadeus.type.OfferDictionaries.LocationsEntry
+	nil,                                  // 94: amadeus.type.OfferDictionaries.AircraftEntry
+	nil,                                  // 95: amadeus.type.OfferDictionaries.CurrenciesEntry
+	nil,                                  // 96: amadeus.type.OfferDictionaries.CarriersEntry
+	nil,                                  // 97: amadeus.type.PricingIncluded.CreditCardFeesEntry
+	nil,                                  // 98: amadeus.type.PricingIncluded.BagsEntry
+	nil,                                  // 99: amadeus.type.PricingIncluded.DetailedFareRulesEntry
+	nil,                                  // 100: amadeus.type.SeatMapDictionaries.LocationsEntry
+	nil,                                  // 101: amadeus.type.SeatMapDictionaries.FacilityEntry
+	nil,                                  // 102: amadeus.type.SeatMapDictionaries.SeatCharacteristicEntry
+}
+var file_amadeus_go_api_amadeus_type_amadeus_type_proto_depIdxs = []int32{
+	1,   // 0: amadeus.type.Response.data:type_name -> amadeus.type.Data
+	11,  // 1: amadeus.type.Response.dictionaries:type_name -> amadeus.type.Dictionaries
+	17,  // 2: amadeus.type.Response.meta:type_name -> amadeus.type.Meta
+	26,  // 3: amadeus.type.Response.warnings:type_name -> amadeus.type.ErrorWarning
+	26,  // 4: amadeus.type.Response.errors:type_name -> amadeus.type.ErrorWarning
+	2,   // 5: amadeus.type.Data.offerItems:type_name -> amadeus.type.OfferItem
+	20,  // 6: amadeus.type.Data.analytics:type_name -> amadeus.type.Analytics
+	22,  // 7: amadeus.type.Data.geoCode:type_name -> amadeus.type.GeoCode
+	23,  // 8: amadeus.type.Data.address:type_name -> amadeus.type.Address
+	24,  // 9: amadeus.type.Data.distance:type_name -> amadeus.type.Distance
+	10,  // 10: amadeus.type.Data.price:type_name -> amadeus.type.Price
+	18,  // 11: amadeus.type.Data.links:type_name -> amadeus.type.Links
+	25,  // 12: amadeus.type.Data.self:type_name -> amadeus.type.Self
+	81,  // 13: amadeus.type.Data.parameters:type_name -> amadeus.type.Data.ParametersEntry
+	3,   // 14: amadeus.type.OfferItem.services:type_name -> amadeus.type.Service
+	10,  // 15: amadeus.type.OfferItem.price:type_name -> amadeus.type.Price
+	10,  // 16: amadeus.type.OfferItem.pricePerAdult:type_name -> amadeus.type.Price
+	4,   // 17: amadeus.type.Service.segments:type_name -> amadeus.type.Segment
+	5,   // 18: amadeus.type.Segment.flightSegment:type_name -> amadeus.type.FlightSegment
+	9,   // 19: amadeus.type.Segment.pricingDetailPerAdult:type_name -> amadeus.type.PricingDetailPerAdult
+	6,   // 20: amadeus.type.FlightSegment.departure:type_name -> amadeus.type.DepartureArrival
+	6,   // 21: amadeus.type.FlightSegment.arrival:type_name -> amadeus.type.DepartureArrival
+	7,   // 22: amadeus.type.FlightSegment.aircraft:type_name -> amadeus.type.Aircraft
+	8,   // 23: amadeus.type.FlightSegment.operating:type_name -> amadeus.type.Operating
+	82,  // 24: amadeus.type.Dictionaries.carriers:type_name -> amadeus.type.Dictionaries.CarriersEntry
+	83,  // 25: amadeus.type.Dictionaries.currencies:type_name -> amadeus.type.Dictionaries.CurrenciesEntry
+	84,  // 26: amadeus.type.Dictionaries.aircrafts:type_name -> amadeus.type.Dictionaries.AircraftsEntry
+	85,  // 27: amadeus.type.Dictionaries.locations:type_name -> amadeus.type.Dictionaries.LocationsEntry
+	86,  // 28: amadeus.type.Carrier.carrier:type_name -> amadeus.type.Carrier.CarrierEntry
+	87,  // 29: amadeus.type.Currency.currency:type_name -> amadeus.type.Currency.CurrencyEntry
+	88,  // 30: amadeus.type.DictionariesAircraft.aircraft:type_name -> amadeus.type.DictionariesAircraft.AircraftEntry
+	89,  // 31: amadeus.type.Location.location:type_name -> amadeus.type.Location.LocationEntry
+	90,  // 32: amadeus.type.LocationDetail.detail:type_name -> amadeus.type.LocationDetail.DetailEntry
+	18,  // 33: amadeus.type.Meta.links:type_name -> amadeus.type.Links
+	19,  // 34: amadeus.type.Meta.defaults:type_name -> amadeus.type.Defaults
+	21,  // 35: amadeus.type.Analytics.flights:type_name -> amadeus.type.Score
+	21,  // 36: amadeus.type.Analytics.travelers:type_name -> amadeus.type.Score
+	21,  // 37: amadeus.type.Analytics.searches:type_name -> amadeus.type.Score
+	28,  // 38: amadeus.type.Score.numberOfSearches:type_name -> amadeus.type.NumberOfSearches
+	27,  // 39: amadeus.type.ErrorWarning.source:type_name -> amadeus.type.Source
+	91,  // 40: amadeus.type.NumberOfSearches.perTripDuration:type_name -> amadeus.type.NumberOfSearches.PerTripDurationEntry
+	92,  // 41: amadeus.type.NumberOfSearches.perDaysInAdvance:type_name -> amadeus.type.NumberOfSearches.PerDaysInAdvanceEntry
+	31,  // 42: amadeus.type.FlightOffersResponse.data:type_name -> amadeus.type.FlightOffer
+	41,  // 43: amadeus.type.FlightOffersResponse.dictionaries:type_name -> amadeus.type.OfferDictionaries
+	17,  // 44: amadeus.type.FlightOffersResponse.meta:type_name -> amadeus.type.Meta
+	26,  // 45: amadeus.type.FlightOffersResponse.warnings:type_name -> amadeus.type.ErrorWarning
+	26,  // 46: amadeus.type.FlightOffersResponse.errors:type_name -> amadeus.type.ErrorWarning
+	32,  // 47: amadeus.type.FlightOffer.itineraries:type_name -> amadeus.type.Itinerary
+	35,  // 48: amadeus.type.FlightOffer.price:type_name -> amadeus.type.OfferPrice
+	37,  // 49: amadeus.type.FlightOffer.pricingOptions:type_name -> amadeus.type.PricingOptions
+	38,  // 50: amadeus.type.FlightOffer.travelerPricings:type_name -> amadeus.type.TravelerPricing
+	33,  // 51: amadeus.type.Itinerary.segments:type_name -> amadeus.type.OfferSegment
+	34,  // 52: amadeus.type.OfferSegment.departure:type_name -> amadeus.type.FlightEndPoint
+	34,  // 53: amadeus.type.OfferSegment.arrival:type_name -> amadeus.type.FlightEndPoint
+	7,   // 54: amadeus.type.OfferSegment.aircraft:type_name -> amadeus.type.Aircraft
+	8,   // 55: amadeus.type.OfferSegment.operating:type_name -> amadeus.type.Operating
+	36,  // 56: amadeus.type.OfferPrice.fees:type_name -> amadeus.type.Fee
+	43,  // 57: amadeus.type.OfferPrice.taxes:type_name -> amadeus.type.Tax
+	36,  // 58: amadeus.type.OfferPrice.additionalServices:type_name -> amadeus.type.Fee
+	35,  // 59: amadeus.type.TravelerPricing.price:type_name -> amadeus.type.OfferPrice
+	39,  // 60: amadeus.type.TravelerPricing.fareDetailsBySegment:type_name -> amadeus.type.FareDetailsBySegment
+	40,  // 61: amadeus.type.FareDetailsBySegment.includedCheckedBags:type_name -> amadeus.type.BaggageAllowance
+	75,  // 62: amadeus.type.FareDetailsBySegment.amenities:type_name -> amadeus.type.Amenity
+	93,  // 63: amadeus.type.OfferDictionaries.locations:type_name -> amadeus.type.OfferDictionaries.LocationsEntry
+	94,  // 64: amadeus.type.OfferDictionaries.aircraft:type_name -> amadeus.type.OfferDictionaries.AircraftEntry
+	95,  // 65: amadeus.type.OfferDictionaries.currencies:type_name -> amadeus.type.OfferDictionaries.CurrenciesEntry
+	96,  // 66: amadeus.type.OfferDictionaries.carriers:type_name -> amadeus.type.OfferDictionaries.CarriersEntry
+	45,  // 67: amadeus.type.FlightOffersPriceResponse.data:type_name -> amadeus.type.FlightOffersPricing
+	48,  // 68: amadeus.type.FlightOffersPriceResponse.included:type_name -> amadeus.type.PricingIncluded
+	41,  // 69: amadeus.type.FlightOffersPriceResponse.dictionaries:type_name -> amadeus.type.OfferDictionaries
+	26,  // 70: amadeus.type.FlightOffersPriceResponse.warnings:type_name -> amadeus.type.ErrorWarning
+	26,  // 71: amadeus.type.FlightOffersPriceResponse.errors:type_name -> amadeus.type.ErrorWarning
+	31,  // 72: amadeus.type.FlightOffersPricing.flightOffers:type_name -> amadeus.type.FlightOffer
+	46,  // 73: amadeus.type.FlightOffersPricing.bookingRequirements:type_name -> amadeus.type.BookingRequirements
+	47,  // 74: amadeus.type.BookingRequirements.travelerRequirements:type_name -> amadeus.type.TravelerRequirements
+	97,  // 75: amadeus.type.PricingIncluded.creditCardFees:type_name -> amadeus.type.PricingIncluded.CreditCardFeesEntry
+	98,  // 76: amadeus.type.PricingIncluded.bags:type_name -> amadeus.type.PricingIncluded.BagsEntry
+	99,  // 77: amadeus.type.PricingIncluded.detailedFareRules:type_name -> amadeus.type.PricingIncluded.DetailedFareRulesEntry
+	51,  // 78: amadeus.type.BagOffer.price:type_name -> amadeus.type.BagPrice
+	53,  // 79: amadeus.type.DetailedFareRules.fareNotes:type_name -> amadeus.type.FareNotes
+	54,  // 80: amadeus.type.FareNotes.descriptions:type_name -> amadeus.type.FareDescription
+	56,  // 81: amadeus.type.FlightOrderResponse.data:type_name -> amadeus.type.FlightOrder
+	41,  // 82: amadeus.type.FlightOrderResponse.dictionaries:type_name -> amadeus.type.OfferDictionaries
+	26,  // 83: amadeus.type.FlightOrderResponse.warnings:type_name -> amadeus.type.ErrorWarning
+	26,  // 84: amadeus.type.FlightOrderResponse.errors:type_name -> amadeus.type.ErrorWarning
+	57,  // 85: amadeus.type.FlightOrder.associatedRecords:type_name -> amadeus.type.AssociatedRecord
+	31,  // 86: amadeus.type.FlightOrder.flightOffers:type_name -> amadeus.type.FlightOffer
+	58,  // 87: amadeus.type.FlightOrder.travelers:type_name -> amadeus.type.Traveler
+	60,  // 88: amadeus.type.FlightOrder.contacts:type_name -> amadeus.type.Contact
+	59,  // 89: amadeus.type.Traveler.name:type_name -> amadeus.type.TravelerName
+	60,  // 90: amadeus.type.Traveler.contact:type_name -> amadeus.type.Contact
+	62,  // 91: amadeus.type.Traveler.documents:type_name -> amadeus.type.TravelerDocument
+	59,  // 92: amadeus.type.Contact.addresseeName:type_name -> amadeus.type.TravelerName
+	61,  // 93: amadeus.type.Contact.phones:type_name -> amadeus.type.Phone
+	63,  // 94: amadeus.type.Contact.address:type_name -> amadeus.type.PostalAddress
+	66,  // 95: amadeus.type.SeatMapResponse.data:type_name -> amadeus.type.SeatMap
+	74,  // 96: amadeus.type.SeatMapResponse.dictionaries:type_name -> amadeus.type.SeatMapDictionaries
+	17,  // 97: amadeus.type.SeatMapResponse.meta:type_name -> amadeus.type.Meta
+	26,  // 98: amadeus.type.SeatMapResponse.warnings:type_name -> amadeus.type.ErrorWarning
+	26,  // 99: amadeus.type.SeatMapResponse.errors:type_name -> amadeus.type.ErrorWarning
+	34,  // 100: amadeus.type.SeatMap.departure:type_name -> amadeus.type.FlightEndPoint
+	34,  // 101: amadeus.type.SeatMap.arrival:type_name -> amadeus.type.FlightEndPoint
+	8,   // 102: amadeus.type.SeatMap.operating:type_name -> amadeus.type.Operating
+	7,   // 103: amadeus.type.SeatMap.aircraft:type_name -> amadeus.type.Aircraft
+	67,  // 104: amadeus.type.SeatMap.decks:type_name -> amadeus.type.Deck
+	73,  // 105: amadeus.type.SeatMap.availableSeatsCounters:type_name -> amadeus.type.AvailableSeatsCounter
+	68,  // 106: amadeus.type.Deck.deckConfiguration:type_name -> amadeus.type.DeckConfiguration
+	69,  // 107: amadeus.type.Deck.facilities:type_name -> amadeus.type.Facility
+	70,  // 108: amadeus.type.Deck.seats:type_name -> amadeus.type.Seat
+	72,  // 109: amadeus.type.Facility.coordinates:type_name -> amadeus.type.Coordinates
+	71,  // 110: amadeus.type.Seat.travelerPricing:type_name -> amadeus.type.SeatTravelerPricing
+	72,  // 111: amadeus.type.Seat.coordinates:type_name -> amadeus.type.Coordinates
+	35,  // 112: amadeus.type.SeatTravelerPricing.price:type_name -> amadeus.type.OfferPrice
+	100, // 113: amadeus.type.SeatMapDictionaries.locations:type_name -> amadeus.type.SeatMapDictionaries.LocationsEntry
+	101, // 114: amadeus.type.SeatMapDictionaries.facility:type_name -> amadeus.type.SeatMapDictionaries.FacilityEntry
+	102, // 115: amadeus.type.SeatMapDictionaries.seatCharacteristic:type_name -> amadeus.type.SeatMapDictionaries.SeatCharacteristicEntry
+	76,  // 116: amadeus.type.Amenity.amenityProvider:type_name -> amadeus.type.AmenityProvider
+	78,  // 117: amadeus.type.FlightAvailabilitiesResponse.data:type_name -> amadeus.type.FlightAvailability
+	41,  // 118: amadeus.type.FlightAvailabilitiesResponse.dictionaries:type_name -> amadeus.type.OfferDictionaries
+	17,  // 119: amadeus.type.FlightAvailabilitiesResponse.meta:type_name -> amadeus.type.Meta
+	26,  // 120: amadeus.type.FlightAvailabilitiesResponse.warnings:type_name -> amadeus.type.ErrorWarning
+	26,  // 121: amadeus.type.FlightAvailabilitiesResponse.errors:type_name -> amadeus.type.ErrorWarning
+	79,  // 122: amadeus.type.FlightAvailability.segments:type_name -> amadeus.type.AvailabilitySegment
+	34,  // 123: amadeus.type.AvailabilitySegment.departure:type_name -> amadeus.type.FlightEndPoint
+	34,  // 124: amadeus.type.AvailabilitySegment.arrival:type_name -> amadeus.type.FlightEndPoint
+	7,   // 125: amadeus.type.AvailabilitySegment.aircraft:type_name -> amadeus.type.Aircraft
+	8,   // 126: amadeus.type.AvailabilitySegment.operating:type_name -> amadeus.type.Operating
+	80,  // 127: amadeus.type.AvailabilitySegment.availabilityClasses:type_name -> amadeus.type.AvailabilityClass
+	29,  // 128: amadeus.type.Data.ParametersEntry.value:type_name -> amadeus.type.ParamDetail
+	16,  // 129: amadeus.type.Dictionaries.LocationsEntry.value:type_name -> amadeus.type.LocationDetail
+	16,  // 130: amadeus.type.Location.LocationEntry.value:type_name -> amadeus.type.LocationDetail
+	42,  // 131: amadeus.type.OfferDictionaries.LocationsEntry.value:type_name -> amadeus.type.LocationValue
+	49,  // 132: amadeus.type.PricingIncluded.CreditCardFeesEntry.value:type_name -> amadeus.type.CreditCardFee
+	50,  // 133: amadeus.type.PricingIncluded.BagsEntry.value:type_name -> amadeus.type.BagOffer
+	52,  // 134: amadeus.type.PricingIncluded.DetailedFareRulesEntry.value:type_name -> amadeus.type.DetailedFareRules
+	42,  // 135: amadeus.type.SeatMapDictionaries.LocationsEntry.value:type_name -> amadeus.type.LocationValue
+	136, // [136:136] is the sub-list for method output_type
+	136, // [136:136] is the sub-list for method input_type
+	136, // [136:136] is the sub-list for extension type_name
+	136, // [136:136] is the sub-list for extension extendee
+	0,   // [0:136] is the sub-list for field type_name
+}
+
+func init() { file_amadeus_go_api_amadeus_type_amadeus_type_proto_init() }
+func file_amadeus_go_api_amadeus_type_amadeus_type_proto_init() {
+	if File_amadeus_go_api_amadeus_type_amadeus_type_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Data); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*OfferItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Service); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Segment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DepartureArrival); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Aircraft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Operating); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*PricingDetailPerAdult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Price); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Dictionaries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Carrier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Currency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*DictionariesAircraft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*LocationDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*Meta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*Links); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*Defaults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*Analytics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*Score); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GeoCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*Distance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*Self); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ErrorWarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*Source); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*NumberOfSearches); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ParamDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightOffersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightOffer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*Itinerary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*OfferSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightEndPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*OfferPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*Fee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*PricingOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*TravelerPricing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*FareDetailsBySegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*BaggageAllowance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*OfferDictionaries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*LocationValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*Tax); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightOffersPriceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightOffersPricing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*BookingRequirements); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*TravelerRequirements); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*PricingIncluded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*CreditCardFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*BagOffer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*BagPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*DetailedFareRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*FareNotes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*FareDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*AssociatedRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*Traveler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*TravelerName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*Contact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*Phone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*TravelerDocument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*PostalAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelFlightOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatMapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*Deck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*DeckConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*Facility); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*Seat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatTravelerPricing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*Coordinates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*AvailableSeatsCounter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatMapDictionaries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*Amenity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*AmenityProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightAvailabilitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*FlightAvailability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*AvailabilitySegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*AvailabilityClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   103,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_amadeus_go_api_amadeus_type_amadeus_type_proto_goTypes,
+		DependencyIndexes: file_amadeus_go_api_amadeus_type_amadeus_type_proto_depIdxs,
+		MessageInfos:      file_amadeus_go_api_amadeus_type_amadeus_type_proto_msgTypes,
+	}.Build()
+	File_amadeus_go_api_amadeus_type_amadeus_type_proto = out.File
+	file_amadeus_go_api_amadeus_type_amadeus_type_proto_rawDesc = nil
+	file_amadeus_go_api_amadeus_type_amadeus_type_proto_goTypes = nil
+	file_amadeus_go_api_amadeus_type_amadeus_type_proto_depIdxs = nil
+}
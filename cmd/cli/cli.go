@@ -21,6 +21,7 @@ func main() {
 	fs := flag.NewFlagSet("amadeus-cli", flag.ExitOnError)
 	var (
 		grpcAddr = fs.String("grpc-addr", ":8000", "gRPC listener address")
+		settings = utils.ClientFlags(fs)
 	)
 
 	err := fs.Parse(os.Args[1:])
@@ -28,5 +29,5 @@ func main() {
 		panic(err)
 	}
 
-	utils.SendReq(grpcAddr, FLIGHT_LOW_FARE_SEARCH)
+	utils.SendReq(grpcAddr, settings(), FLIGHT_LOW_FARE_SEARCH)
 }
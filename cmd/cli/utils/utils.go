@@ -1,34 +1,62 @@
 package utils
 
 import (
+	"amadeus-go/pkg/logging"
 	sv "amadeus-go/pkg/services"
 	"amadeus-go/pkg/transports"
 
 	"context"
+	"flag"
+	"fmt"
 	"log"
+	"os"
 	"time"
 
-	"google.golang.org/grpc"
+	kitLog "github.com/go-kit/kit/log"
 )
 
-func SendReq(grpcAddr *string, request interface{}) {
-	ctx, _ := context.WithTimeout(context.TODO(), time.Second*1)
-	conn, err := grpc.DialContext(ctx, *grpcAddr, grpc.WithInsecure())
-	defer conn.Close()
+// ClientFlags registers the client flags of the CLIs on fs. The returned
+// function gives the settings they make up.
+func ClientFlags(fs *flag.FlagSet) func() transports.ClientSettings {
+	var (
+		credentials = fs.String("api-key", os.Getenv("AMADEUS_GO_API_KEY"), "API key or JWT to call the service with")
+		caFile      = fs.String("tls-ca", "", "CA bundle to verify the server with; enables TLS")
+		certFile    = fs.String("tls-cert", "", "client certificate, for servers requiring mutual TLS")
+		keyFile     = fs.String("tls-key", "", "key of the client certificate")
+		serverName  = fs.String("tls-server-name", "", "name to verify the server certificate against")
+	)
+
+	return func() transports.ClientSettings {
+		settings := transports.ClientSettings{Credentials: *credentials}
+		if *caFile != "" {
+			settings.TLS = &transports.TLSSettings{
+				CAFile:     *caFile,
+				CertFile:   *certFile,
+				KeyFile:    *keyFile,
+				ServerName: *serverName,
+			}
+		}
+		return settings
+	}
+}
+
+func SendReq(grpcAddr *string, settings transports.ClientSettings, request interface{}) {
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*1)
+	defer cancel()
+
+	conn, err := transports.Dial(ctx, *grpcAddr, settings)
 	if err != nil {
 		panic(err)
 	}
-	srv := transports.NewGRPCClient(conn)
-
-	var (
-		response interface{}
-	)
+	defer conn.Close()
 
-	if req, ok := request.(*sv.FlightLowFareSearchRequest); ok {
-		response, err = srv.FlightLowFareSearch(context.TODO(), req)
-	} else if req, ok := request.(*sv.FlightInspirationSearchRequest); ok {
-		response, err = srv.FlightInspirationSearch(context.TODO(), req)
+	logger, err := logging.NewLogger(kitLog.NewLogfmtLogger(os.Stderr), logging.Config{})
+	if err != nil {
+		panic(err)
 	}
+	srv := transports.NewGRPCClient(conn, logger, settings)
+
+	response, err := call(logging.WithRequestID(context.TODO(), logging.NewRequestID()), srv, request)
 	if err != nil {
 		panic(err)
 	}
@@ -36,3 +64,50 @@ func SendReq(grpcAddr *string, request interface{}) {
 	log.Println("sending\n\t\t", request)
 	log.Println("receiving\n\t\t", response)
 }
+
+func call(ctx context.Context, srv sv.AmadeusService, request interface{}) (interface{}, error) {
+	switch req := request.(type) {
+	case *sv.FlightLowFareSearchRequest:
+		return srv.FlightLowFareSearch(ctx, req)
+	case *sv.FlightInspirationSearchRequest:
+		return srv.FlightInspirationSearch(ctx, req)
+	case *sv.FlightCheapestDateSearchRequest:
+		return srv.FlightCheapestDateSearch(ctx, req)
+	case *sv.FlightMostSearchedDestinationsRequest:
+		return srv.FlightMostSearchedDestinations(ctx, req)
+	case *sv.FlightMostSearchedByDestinationRequest:
+		return srv.FlightMostSearchedByDestination(ctx, req)
+	case *sv.FlightCheckInLinksRequest:
+		return srv.FlightCheckInLinks(ctx, req)
+	case *sv.FlightMostTraveledDestinationsRequest:
+		return srv.FlightMostTraveledDestinations(ctx, req)
+	case *sv.FlightMostBookedDestinationsRequest:
+		return srv.FlightMostBookedDestinations(ctx, req)
+	case *sv.FlightBusiestTravelingPeriodRequest:
+		return srv.FlightBusiestTravelingPeriod(ctx, req)
+	case *sv.AirportNearestRelevantRequest:
+		return srv.AirportNearestRelevant(ctx, req)
+	case *sv.AirportAndCitySearchRequest:
+		return srv.AirportAndCitySearch(ctx, req)
+	case *sv.AirlineCodeLookupRequest:
+		return srv.AirlineCodeLookup(ctx, req)
+	case *sv.FlightOffersSearchRequest:
+		return srv.FlightOffersSearch(ctx, req)
+	case *sv.FlightOffersPriceRequest:
+		return srv.FlightOffersPrice(ctx, req)
+	case *sv.CreateFlightOrderRequest:
+		return srv.CreateFlightOrder(ctx, req)
+	case *sv.GetFlightOrderRequest:
+		return srv.GetFlightOrder(ctx, req)
+	case *sv.CancelFlightOrderRequest:
+		return srv.CancelFlightOrder(ctx, req)
+	case *sv.SeatMapDisplayRequest:
+		return srv.SeatMapDisplay(ctx, req)
+	case *sv.BrandedFaresUpsellRequest:
+		return srv.BrandedFaresUpsell(ctx, req)
+	case *sv.FlightAvailabilitiesSearchRequest:
+		return srv.FlightAvailabilitiesSearch(ctx, req)
+	default:
+		return nil, fmt.Errorf("unsupported request type %T", request)
+	}
+}
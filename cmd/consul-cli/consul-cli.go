@@ -4,7 +4,9 @@ import (
 	"amadeus-go/cmd/cli/utils"
 	"amadeus-go/pkg/services"
 
+	"flag"
 	"fmt"
+	"os"
 
 	consul "github.com/hashicorp/consul/api"
 )
@@ -24,6 +26,14 @@ var (
 )
 
 func main() {
+	fs := flag.NewFlagSet("amadeus-consul-cli", flag.ExitOnError)
+	settings := utils.ClientFlags(fs)
+
+	err := fs.Parse(os.Args[1:])
+	if err != nil {
+		panic(err)
+	}
+
 	c, err := consul.NewClient(consul.DefaultConfig())
 	if err != nil {
 		panic(err)
@@ -40,5 +50,5 @@ func main() {
 	}
 
 	grpcAddr := fmt.Sprintf("%s:%v", resp[0].Service.Address, resp[0].Service.Port)
-	utils.SendReq(&grpcAddr, FLIGHT_LOW_FARE_SEARCH)
+	utils.SendReq(&grpcAddr, settings(), FLIGHT_LOW_FARE_SEARCH)
 }
@@ -1,25 +1,54 @@
 package main
 
 import (
+	pbAdmin "amadeus-go/api/amadeus/admin"
 	pb "amadeus-go/api/amadeus/func"
+	"amadeus-go/pkg/auth"
 	"amadeus-go/pkg/endpoints"
+	"amadeus-go/pkg/instrumenting"
+	"amadeus-go/pkg/logging"
 	"amadeus-go/pkg/services"
+	"amadeus-go/pkg/tracing"
 	"amadeus-go/pkg/transports"
+	"context"
 	"flag"
+	"fmt"
 	defaultLogger "log"
 	"net"
+	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/go-kit/kit/log"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 func main() {
 	fs := flag.NewFlagSet("amadeus-srv", flag.ContinueOnError)
 	var (
-		grpcAddr = fs.String("grpc-addr", ":8000", "gRPC listen address")
+		grpcAddr        = fs.String("grpc-addr", ":8000", "gRPC listen address")
+		httpAddr        = fs.String("http-addr", ":8001", "HTTP/JSON listen address")
+		adminAddr       = fs.String("admin-addr", ":8080", "admin HTTP listen address")
+		breakerFailures = fs.Uint("breaker-failures", 5, "consecutive failures of an Amadeus route that open its circuit breaker")
+		breakerCooldown = fs.Duration("breaker-cooldown", time.Second*30, "how long an open circuit breaker waits before a trial request")
+		tlsCert         = fs.String("tls-cert", "", "certificate of the gRPC listener; enables TLS")
+		tlsKey          = fs.String("tls-key", "", "key of the gRPC listener's certificate")
+		tlsClientCA     = fs.String("tls-client-ca", "", "CA bundle client certificates must be signed by; enables mutual TLS")
+		authKeys        = fs.String("auth-keys", "config/keys.dev.json", "file of the API keys and JWT settings callers authenticate with; empty disables authentication")
+		otlpEndpoint    = fs.String("otlp-endpoint", "", "host:port of the OTLP collector spans are exported to over gRPC; empty disables tracing")
+		otlpInsecure    = fs.Bool("otlp-insecure", false, "export spans to the OTLP collector without TLS")
+		traceRatio      = fs.Float64("trace-sample-ratio", 1, "fraction of the traces started here that are sampled")
+		shutdownTimeout = fs.Duration("shutdown-timeout", time.Second*15, "how long in-flight requests are given to finish on shutdown")
 	)
 	fs.Parse(os.Args[1:])
 
@@ -34,17 +63,74 @@ func main() {
 		panic(err)
 	}
 
+	configFilename := "config/config.dev.json"
+
+	logConf, err := logging.ReadConfig(configFilename)
+	if err != nil {
+		panic(err)
+	}
 	var logger log.Logger
 	logger = log.NewLogfmtLogger(os.Stderr)
+	logger, err = logging.NewLogger(logger, logConf)
+	if err != nil {
+		panic(err)
+	}
 	logger = log.With(logger, "caller", log.DefaultCaller)
 
-	srv, err := services.NewBasicService(port, "config/config.dev.json", "config/API-urls.json", logger)
+	metrics := instrumenting.NewPrometheusMetrics()
+
+	var tracerProvider trace.TracerProvider = trace.NewNoopTracerProvider()
+	if *otlpEndpoint != "" {
+		exporter, err := tracing.NewOTLPExporter(context.Background(), *otlpEndpoint, *otlpInsecure)
+		if err != nil {
+			panic(err)
+		}
+		provider := tracing.NewTracerProvider(exporter, *traceRatio)
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancel()
+			if err := provider.Shutdown(ctx); err != nil {
+				defaultLogger.Println("flushing spans:", err)
+			}
+		}()
+		tracerProvider = provider
+	}
+	tracer := tracing.Tracer(tracerProvider)
+
+	srv, lifecycle, err := services.NewBasicService(port, configFilename, "config/API-urls.json", logger, metrics, tracer)
 	if err != nil {
 		panic(err)
 	}
+	breakers := endpoints.NewBreakerSet(endpoints.BreakerSettings{
+		ConsecutiveFailures: uint32(*breakerFailures),
+		Cooldown:            *breakerCooldown,
+		HalfOpenRequests:    1,
+	}, logger)
+
+	var authenticator *auth.Authenticator
+	if *authKeys != "" {
+		authenticator, err = auth.NewAuthenticator(*authKeys)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	var (
-		endpointSet = endpoints.NewEndpointSet(srv, logger)
+		endpointSet = endpoints.NewEndpointSet(srv, logger, metrics, tracer, breakers, authenticator)
 		grpcServer  = transports.NewGRPCServer(endpointSet, logger)
+		httpHandler = transports.NewHTTPHandler(endpointSet, logger)
+
+		adminEndpointSet = endpoints.NewAdminEndpointSet(lifecycle.Usage(), logger, metrics, tracer, authenticator)
+		adminGRPCServer  = transports.NewGRPCAdminServer(adminEndpointSet, logger)
+	)
+
+	adminMux := http.NewServeMux()
+	adminMux.Handle("/admin/breakers", breakers)
+	adminMux.Handle("/metrics", promhttp.Handler())
+
+	var (
+		httpServer  = &http.Server{Addr: *httpAddr, Handler: httpHandler}
+		adminServer = &http.Server{Addr: *adminAddr, Handler: adminMux}
 	)
 
 	grpcListener, err := net.Listen("tcp", string(*grpcAddr))
@@ -52,10 +138,105 @@ func main() {
 		panic(err)
 	}
 
-	baseServer := grpc.NewServer()
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			// the span of every call continues the trace the client sent in its metadata
+			otelgrpc.UnaryServerInterceptor(
+				otelgrpc.WithTracerProvider(tracerProvider),
+				otelgrpc.WithPropagators(tracing.Propagator),
+			),
+			transports.RequestIDInterceptor,
+		),
+	}
+	if *tlsCert != "" {
+		creds, err := transports.ServerCredentials(transports.TLSSettings{
+			CertFile: *tlsCert,
+			KeyFile:  *tlsKey,
+			CAFile:   *tlsClientCA,
+		})
+		if err != nil {
+			panic(err)
+		}
+		serverOptions = append(serverOptions, grpc.Creds(creds))
+	}
+
+	baseServer := grpc.NewServer(serverOptions...)
 	pb.RegisterAmadeusServiceServer(baseServer, grpcServer)
-	defaultLogger.Fatalln(
-		"listening to", *grpcAddr,
-		"serving...", baseServer.Serve(grpcListener),
-	)
+	pbAdmin.RegisterAdminServiceServer(baseServer, adminGRPCServer)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(baseServer, healthServer)
+	reflection.Register(baseServer)
+
+	stopHealth := make(chan struct{})
+	go watchHealth(healthServer, lifecycle, stopHealth)
+
+	errs := make(chan error, 4)
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+		errs <- fmt.Errorf("%s", <-c)
+	}()
+	go func() {
+		defaultLogger.Println("http listening to", *httpAddr)
+		errs <- httpServer.ListenAndServe()
+	}()
+	go func() {
+		defaultLogger.Println("admin listening to", *adminAddr)
+		errs <- adminServer.ListenAndServe()
+	}()
+	go func() {
+		defaultLogger.Println("listening to", *grpcAddr)
+		errs <- baseServer.Serve(grpcListener)
+	}()
+
+	defaultLogger.Println("shutting down:", <-errs)
+
+	// stop routing requests here first, then let the ones in flight finish
+	close(stopHealth)
+	healthServer.Shutdown()
+	if err := lifecycle.Deregister(); err != nil {
+		defaultLogger.Println("deregistering from consul:", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		baseServer.GracefulStop()
+		close(stopped)
+	}()
+	_ = httpServer.Shutdown(ctx)
+	_ = adminServer.Shutdown(ctx)
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		defaultLogger.Println("in-flight requests didn't finish in", *shutdownTimeout)
+		baseServer.Stop()
+	}
+
+	if err := lifecycle.Close(); err != nil {
+		defaultLogger.Println("closing the service:", err)
+	}
+}
+
+// watchHealth reports the service as serving only while it holds a valid
+// access token for Amadeus.
+func watchHealth(healthServer *health.Server, lifecycle *services.Lifecycle, stop <-chan struct{}) {
+	ticker := time.NewTicker(time.Second * 5)
+	defer ticker.Stop()
+	for {
+		status := healthpb.HealthCheckResponse_NOT_SERVING
+		if lifecycle.Healthy() {
+			status = healthpb.HealthCheckResponse_SERVING
+		}
+		healthServer.SetServingStatus("", status)
+		healthServer.SetServingStatus("amadeus.func.AmadeusService", status)
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
 }
@@ -0,0 +1,77 @@
+/*
+	Auth tells who is calling the service: callers present an API key or a
+	JWT, which resolves to the tenant the call is made for.
+*/
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+)
+
+var (
+	// ErrUnauthenticated is returned for calls without credentials, or whose
+	// credentials don't resolve to a tenant.
+	ErrUnauthenticated = errors.New("missing or invalid credentials")
+	// ErrPermissionDenied is returned when a tenant asks for something only
+	// an admin may see or do.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// Tenant is who a call is made for. Admins may act on behalf of any tenant.
+type Tenant struct {
+	ID    string
+	Admin bool
+}
+
+type contextKey int
+
+const (
+	tenantKey contextKey = iota
+	credentialsKey
+)
+
+// NewContext returns a copy of ctx carrying tenant.
+func NewContext(ctx context.Context, tenant *Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey, tenant)
+}
+
+// FromContext returns the tenant a call is made for, if it was authenticated.
+func FromContext(ctx context.Context) (*Tenant, bool) {
+	tenant, ok := ctx.Value(tenantKey).(*Tenant)
+	return tenant, ok
+}
+
+// WithCredentials returns a copy of ctx carrying the credentials a transport
+// found on the request: an API key or a JWT.
+func WithCredentials(ctx context.Context, credentials string) context.Context {
+	return context.WithValue(ctx, credentialsKey, credentials)
+}
+
+// ParseAuthorization returns the credentials of an Authorization header value.
+func ParseAuthorization(value string) string {
+	if len(value) > 7 && strings.EqualFold(value[:7], "bearer ") {
+		return strings.TrimSpace(value[7:])
+	}
+	return ""
+}
+
+// Middleware authenticates the credentials the transport put in the context
+// and passes the tenant they resolve to on in the context.
+func Middleware(a *Authenticator) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			credentials, _ := ctx.Value(credentialsKey).(string)
+			tenant, err := a.Authenticate(credentials)
+			if err != nil {
+				return nil, err
+			}
+
+			return next(NewContext(ctx, tenant), request)
+		}
+	}
+}
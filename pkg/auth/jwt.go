@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// verifyJWT checks the HS256 signature of token and its exp, nbf and iss
+// claims, and returns the tenant named by the configured claim. Tokens have to
+// expire, and within the configured lifetime if there is one.
+func verifyJWT(token string, conf jwtConf) (*Tenant, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrUnauthenticated
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if decodeSegment(parts[0], &header) != nil || header.Alg != "HS256" {
+		return nil, ErrUnauthenticated
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrUnauthenticated
+	}
+	mac := hmac.New(sha256.New, []byte(conf.Secret))
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, ErrUnauthenticated
+	}
+
+	var claims map[string]interface{}
+	if decodeSegment(parts[1], &claims) != nil {
+		return nil, ErrUnauthenticated
+	}
+
+	now := float64(time.Now().Unix())
+	exp, ok := claims["exp"].(float64)
+	if !ok || now >= exp {
+		return nil, ErrUnauthenticated
+	}
+	if conf.MaxLifetime > 0 {
+		max := float64(conf.MaxLifetime)
+		if exp-now > max {
+			return nil, ErrUnauthenticated
+		}
+		if iat, ok := claims["iat"].(float64); ok && exp-iat > max {
+			return nil, ErrUnauthenticated
+		}
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now < nbf {
+		return nil, ErrUnauthenticated
+	}
+	if conf.Issuer != "" && claims["iss"] != conf.Issuer {
+		return nil, ErrUnauthenticated
+	}
+
+	tenant, _ := claims[conf.TenantClaim].(string)
+	if tenant == "" {
+		return nil, ErrUnauthenticated
+	}
+	admin := false
+	if conf.AdminClaim != "" {
+		admin, _ = claims[conf.AdminClaim].(bool)
+	}
+	return &Tenant{ID: tenant, Admin: admin}, nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "s3cret"
+
+// sign returns a JWT of claims signed with secret, under a header naming alg.
+func sign(t *testing.T, alg, secret string, claims map[string]interface{}) string {
+	t.Helper()
+	segment := func(v interface{}) string {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+
+	unsigned := segment(map[string]string{"alg": alg, "typ": "JWT"}) + "." + segment(claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyJWT(t *testing.T) {
+	now := time.Now().Unix()
+	conf := jwtConf{Secret: testSecret, Issuer: "issuer", TenantClaim: "tenant", AdminClaim: "admin", MaxLifetime: 3600}
+	claims := func(extra map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{"tenant": "acme", "iss": "issuer", "iat": now, "exp": now + 600}
+		for k, v := range extra {
+			if v == nil {
+				delete(c, k)
+			} else {
+				c[k] = v
+			}
+		}
+		return c
+	}
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		conf  jwtConf
+		// the tenant the token resolves to, nil if it must be refused
+		want *Tenant
+	}{
+		{"valid", sign(t, "HS256", testSecret, claims(nil)), conf, &Tenant{ID: "acme"}},
+		{"admin", sign(t, "HS256", testSecret, claims(map[string]interface{}{"admin": true})), conf, &Tenant{ID: "acme", Admin: true}},
+		{"admin claim not configured", sign(t, "HS256", testSecret, claims(map[string]interface{}{"admin": true})), jwtConf{Secret: testSecret, TenantClaim: "tenant"}, &Tenant{ID: "acme"}},
+		{"no issuer required", sign(t, "HS256", testSecret, claims(map[string]interface{}{"iss": nil})), jwtConf{Secret: testSecret, TenantClaim: "tenant"}, &Tenant{ID: "acme"}},
+		{"no lifetime limit", sign(t, "HS256", testSecret, claims(map[string]interface{}{"exp": now + 86400*365})), jwtConf{Secret: testSecret, TenantClaim: "tenant"}, &Tenant{ID: "acme"}},
+		{"wrong secret", sign(t, "HS256", "other", claims(nil)), conf, nil},
+		{"other algorithm", sign(t, "none", testSecret, claims(nil)), conf, nil},
+		{"tampered claims", tamper(sign(t, "HS256", testSecret, claims(nil))), conf, nil},
+		{"not a JWT", "a.b.c", conf, nil},
+		{"expired", sign(t, "HS256", testSecret, claims(map[string]interface{}{"exp": now - 1})), conf, nil},
+		{"without exp", sign(t, "HS256", testSecret, claims(map[string]interface{}{"exp": nil})), conf, nil},
+		{"without exp and no lifetime limit", sign(t, "HS256", testSecret, claims(map[string]interface{}{"exp": nil})), jwtConf{Secret: testSecret, TenantClaim: "tenant"}, nil},
+		{"exp that isn't a number", sign(t, "HS256", testSecret, claims(map[string]interface{}{"exp": "tomorrow"})), conf, nil},
+		{"expiring too far away", sign(t, "HS256", testSecret, claims(map[string]interface{}{"iat": nil, "exp": now + 7200})), conf, nil},
+		{"issued for too long", sign(t, "HS256", testSecret, claims(map[string]interface{}{"iat": now - 7000, "exp": now + 600})), conf, nil},
+		{"not yet valid", sign(t, "HS256", testSecret, claims(map[string]interface{}{"nbf": now + 60})), conf, nil},
+		{"other issuer", sign(t, "HS256", testSecret, claims(map[string]interface{}{"iss": "other"})), conf, nil},
+		{"without tenant", sign(t, "HS256", testSecret, claims(map[string]interface{}{"tenant": nil})), conf, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tenant, err := verifyJWT(tc.token, tc.conf)
+			if tc.want == nil {
+				if err != ErrUnauthenticated {
+					t.Fatalf("got %+v, %v, want the token refused", tenant, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("the token was refused: %v", err)
+			}
+			if *tenant != *tc.want {
+				t.Errorf("got %+v, want %+v", tenant, tc.want)
+			}
+		})
+	}
+}
+
+// tamper makes the tenant claim of token another one, keeping its signature.
+func tamper(token string) string {
+	parts := strings.Split(token, ".")
+	b, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	b = []byte(strings.Replace(string(b), `"acme"`, `"evil"`, 1))
+	parts[1] = base64.RawURLEncoding.EncodeToString(b)
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// the keys file is looked at again once this long has passed, and reloaded if
+// it changed
+const keysCheckInterval = time.Second * 5
+
+type keysConf struct {
+	Keys []struct {
+		Key    string `json:"KEY"`
+		Tenant string `json:"TENANT"`
+		Admin  bool   `json:"ADMIN"`
+	} `json:"KEYS"`
+	JWT jwtConf `json:"JWT"`
+}
+
+type jwtConf struct {
+	// Secret of the HS256 signature; JWTs are refused when it is empty
+	Secret string `json:"SECRET"`
+	// Issuer the iss claim must match, if set
+	Issuer string `json:"ISSUER"`
+	// TenantClaim names the claim holding the tenant, "sub" by default
+	TenantClaim string `json:"TENANT_CLAIM"`
+	// AdminClaim names a boolean claim making the tenant an admin, if set
+	AdminClaim string `json:"ADMIN_CLAIM"`
+	// MaxLifetime is the longest, in seconds, a token may be valid for;
+	// tokens expiring further away are refused. Unlimited when 0
+	MaxLifetime int64 `json:"MAX_LIFETIME"`
+}
+
+// Authenticator resolves credentials to tenants with the keys file it was
+// created with. Changes to the file are picked up while running; a file that
+// can't be read or parsed leaves the previous keys in use.
+type Authenticator struct {
+	filename string
+
+	mu        sync.RWMutex
+	keys      map[string]*Tenant
+	jwt       jwtConf
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+func NewAuthenticator(filename string) (*Authenticator, error) {
+	a := &Authenticator{filename: filename}
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		return nil, err
+	}
+	err = a.load(info.ModTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Authenticate returns the tenant of credentials, which are either an API key
+// or a JWT signed with the configured secret.
+func (a *Authenticator) Authenticate(credentials string) (*Tenant, error) {
+	if credentials == "" {
+		return nil, ErrUnauthenticated
+	}
+	a.reload()
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if strings.Count(credentials, ".") == 2 && a.jwt.Secret != "" {
+		return verifyJWT(credentials, a.jwt)
+	}
+
+	tenant, ok := a.keys[credentials]
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	return tenant, nil
+}
+
+func (a *Authenticator) reload() {
+	a.mu.RLock()
+	due := time.Since(a.checkedAt) >= keysCheckInterval
+	a.mu.RUnlock()
+	if !due {
+		return
+	}
+
+	info, err := os.Stat(a.filename)
+
+	a.mu.Lock()
+	a.checkedAt = time.Now()
+	changed := err == nil && !info.ModTime().Equal(a.modTime)
+	a.mu.Unlock()
+
+	if changed {
+		_ = a.load(info.ModTime())
+	}
+}
+
+func (a *Authenticator) load(modTime time.Time) error {
+	b, err := ioutil.ReadFile(a.filename)
+	if err != nil {
+		return err
+	}
+
+	var conf keysConf
+	err = json.Unmarshal(b, &conf)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]*Tenant)
+	for _, k := range conf.Keys {
+		if k.Key != "" && k.Tenant != "" {
+			keys[k.Key] = &Tenant{ID: k.Tenant, Admin: k.Admin}
+		}
+	}
+	if conf.JWT.TenantClaim == "" {
+		conf.JWT.TenantClaim = "sub"
+	}
+
+	a.mu.Lock()
+	a.keys, a.jwt, a.modTime, a.checkedAt = keys, conf.JWT, modTime, time.Now()
+	a.mu.Unlock()
+	return nil
+}
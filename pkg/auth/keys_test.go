@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testKeys = `{
+  "KEYS": [
+    {"KEY": "key", "TENANT": "acme"},
+    {"KEY": "admin-key", "TENANT": "ops", "ADMIN": true},
+    {"KEY": "no-tenant"}
+  ],
+  "JWT": {"SECRET": "s3cret"}
+}`
+
+// newTestAuthenticator returns an Authenticator of a keys file holding keys.
+func newTestAuthenticator(t *testing.T, keys string) (*Authenticator, string) {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), "keys.json")
+	if err := ioutil.WriteFile(filename, []byte(keys), 0600); err != nil {
+		t.Fatal(err)
+	}
+	a, err := NewAuthenticator(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a, filename
+}
+
+func TestAuthenticate(t *testing.T) {
+	a, _ := newTestAuthenticator(t, testKeys)
+	exp := time.Now().Add(time.Hour).Unix()
+
+	for _, tc := range []struct {
+		name        string
+		credentials string
+		// the tenant the credentials resolve to, nil if they must be refused
+		want *Tenant
+	}{
+		{"API key", "key", &Tenant{ID: "acme"}},
+		{"admin API key", "admin-key", &Tenant{ID: "ops", Admin: true}},
+		{"unknown API key", "other", nil},
+		{"API key without tenant", "no-tenant", nil},
+		{"no credentials", "", nil},
+		{"JWT with the default tenant claim", sign(t, "HS256", testSecret, map[string]interface{}{"sub": "acme", "exp": exp}), &Tenant{ID: "acme"}},
+		{"JWT without exp", sign(t, "HS256", testSecret, map[string]interface{}{"sub": "acme"}), nil},
+		{"JWT of another secret", sign(t, "HS256", "other", map[string]interface{}{"sub": "acme", "exp": exp}), nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tenant, err := a.Authenticate(tc.credentials)
+			if tc.want == nil {
+				if err != ErrUnauthenticated {
+					t.Fatalf("got %+v, %v, want the credentials refused", tenant, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("the credentials were refused: %v", err)
+			}
+			if *tenant != *tc.want {
+				t.Errorf("got %+v, want %+v", tenant, tc.want)
+			}
+		})
+	}
+}
+
+func TestJWTsRefusedWithoutSecret(t *testing.T) {
+	a, _ := newTestAuthenticator(t, `{"KEYS": [{"KEY": "key", "TENANT": "acme"}]}`)
+	token := sign(t, "HS256", "", map[string]interface{}{"sub": "acme", "exp": time.Now().Add(time.Hour).Unix()})
+	if tenant, err := a.Authenticate(token); err != ErrUnauthenticated {
+		t.Fatalf("got %+v, %v, want the JWT refused", tenant, err)
+	}
+}
+
+func TestKeysReloaded(t *testing.T) {
+	a, filename := newTestAuthenticator(t, testKeys)
+
+	if err := ioutil.WriteFile(filename, []byte(`{"KEYS": [{"KEY": "new-key", "TENANT": "acme"}]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(filename, later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	// the file isn't looked at again before the check interval
+	if _, err := a.Authenticate("key"); err != nil {
+		t.Fatalf("the old key was dropped before the check interval: %v", err)
+	}
+
+	a.mu.Lock()
+	a.checkedAt = time.Time{}
+	a.mu.Unlock()
+	if _, err := a.Authenticate("new-key"); err != nil {
+		t.Fatalf("the new key wasn't picked up: %v", err)
+	}
+	if _, err := a.Authenticate("key"); err != ErrUnauthenticated {
+		t.Fatalf("the removed key still works: %v", err)
+	}
+
+	// a broken file leaves the keys in use
+	if err := ioutil.WriteFile(filename, []byte(`{`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	later = later.Add(time.Minute)
+	if err := os.Chtimes(filename, later, later); err != nil {
+		t.Fatal(err)
+	}
+	a.mu.Lock()
+	a.checkedAt = time.Time{}
+	a.mu.Unlock()
+	if _, err := a.Authenticate("new-key"); err != nil {
+		t.Fatalf("a broken file dropped the keys: %v", err)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	a, _ := newTestAuthenticator(t, testKeys)
+	var got *Tenant
+	ep := Middleware(a)(func(ctx context.Context, request interface{}) (interface{}, error) {
+		got, _ = FromContext(ctx)
+		return nil, nil
+	})
+
+	if _, err := ep(context.Background(), nil); err != ErrUnauthenticated {
+		t.Fatalf("a call without credentials got %v", err)
+	}
+	if got != nil {
+		t.Fatal("a call without credentials got to the endpoint")
+	}
+
+	if _, err := ep(WithCredentials(context.Background(), "key"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || got.ID != "acme" {
+		t.Errorf("the endpoint got tenant %+v, want acme", got)
+	}
+}
+
+func TestParseAuthorization(t *testing.T) {
+	for value, want := range map[string]string{
+		"Bearer abc":   "abc",
+		"bearer  abc ": "abc",
+		"Basic abc":    "",
+		"Bearer":       "",
+		"":             "",
+	} {
+		if got := ParseAuthorization(value); got != want {
+			t.Errorf("%q: got %q, want %q", value, got, want)
+		}
+	}
+}
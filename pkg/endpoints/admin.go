@@ -0,0 +1,56 @@
+package endpoints
+
+import (
+	"amadeus-go/pkg/auth"
+	"amadeus-go/pkg/instrumenting"
+	sv "amadeus-go/pkg/services"
+
+	"context"
+	"errors"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type AdminEndpointSet struct {
+	GetUsageEndpoint endpoint.Endpoint
+}
+
+func (s AdminEndpointSet) GetUsage(ctx context.Context, request *sv.GetUsageRequest) (*sv.Usage, error) {
+	resp, err := s.GetUsageEndpoint(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := resp.(*sv.Usage)
+	return response, nil
+}
+
+// NewAdminEndpointSet wraps the admin API in endpoints, authenticated the same
+// way as the Amadeus ones.
+func NewAdminEndpointSet(usage sv.UsageService, logger log.Logger, metrics *instrumenting.Metrics, tracer trace.Tracer, authenticator *auth.Authenticator) *AdminEndpointSet {
+	var getUsageEndpoint endpoint.Endpoint
+
+	getUsageEndpoint = makeGetUsageEndpoint(usage)
+	getUsageEndpoint = authMiddleware(authenticator)(getUsageEndpoint)
+	getUsageEndpoint = instrumentingMiddleware(metrics, "GetUsage")(getUsageEndpoint)
+	getUsageEndpoint = loggingMiddleware(logger, "GetUsage")(getUsageEndpoint)
+	getUsageEndpoint = tracingMiddleware(tracer, "GetUsage")(getUsageEndpoint)
+
+	return &AdminEndpointSet{
+		GetUsageEndpoint: getUsageEndpoint,
+	}
+}
+
+func makeGetUsageEndpoint(usage sv.UsageService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(*sv.GetUsageRequest)
+		if !ok {
+			return nil, errors.New("service did not fetch type <GetUsageRequest>")
+		}
+
+		resp, err := usage.GetUsage(ctx, req)
+		return resp, err
+	}
+}
@@ -0,0 +1,129 @@
+package endpoints
+
+import (
+	sv "amadeus-go/pkg/services"
+
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/circuitbreaker"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/sony/gobreaker"
+)
+
+type BreakerSettings struct {
+	// ConsecutiveFailures trips a breaker open
+	ConsecutiveFailures uint32
+	// Cooldown is how long a breaker stays open before letting a trial
+	// request through
+	Cooldown time.Duration
+	// HalfOpenRequests is how many trial requests must succeed to close it
+	HalfOpenRequests uint32
+}
+
+// BreakerSet holds one circuit breaker per upstream Amadeus route, the keys
+// of serviceUrls, so one route being down doesn't hold up calls to the rest.
+// The endpoints of the methods calling the same route share its breaker.
+type BreakerSet struct {
+	settings BreakerSettings
+	logger   log.Logger
+
+	mu       sync.Mutex
+	breakers map[string]*gobreaker.CircuitBreaker
+}
+
+// breakerRoutes names the route of the methods that aren't named after it.
+var breakerRoutes = map[string]string{
+	"FlightCheckInLinks": "FlightCheckInLists",
+	"CreateFlightOrder":  "FlightOrders",
+	"GetFlightOrder":     "FlightOrders",
+	"CancelFlightOrder":  "FlightOrders",
+}
+
+func NewBreakerSet(settings BreakerSettings, logger log.Logger) *BreakerSet {
+	return &BreakerSet{
+		settings: settings,
+		logger:   logger,
+		breakers: make(map[string]*gobreaker.CircuitBreaker),
+	}
+}
+
+func (b *BreakerSet) breaker(method string) (string, *gobreaker.CircuitBreaker) {
+	route, ok := breakerRoutes[method]
+	if !ok {
+		route = method
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cb, ok := b.breakers[route]
+	if !ok {
+		cb = gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:        route,
+			MaxRequests: b.settings.HalfOpenRequests,
+			Timeout:     b.settings.Cooldown,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				return counts.ConsecutiveFailures >= b.settings.ConsecutiveFailures
+			},
+			OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
+				_ = level.Warn(b.logger).Log(
+					"layer", "breaker",
+					"route", name,
+					"from", from.String(),
+					"to", to.String(),
+				)
+			},
+			// requests Amadeus rejected say nothing about its health
+			IsSuccessful: func(err error) bool {
+				return err == nil || !sv.IsUnavailable(err)
+			},
+		})
+		b.breakers[route] = cb
+	}
+	return route, cb
+}
+
+// middleware fails the calls of the endpoint of methodName fast while the
+// breaker of its route is open, with an UnavailableError, as Amadeus being
+// down would have made them fail. A nil set lets every call through.
+func (b *BreakerSet) middleware(methodName string) endpoint.Middleware {
+	if b == nil {
+		return func(next endpoint.Endpoint) endpoint.Endpoint { return next }
+	}
+
+	route, cb := b.breaker(methodName)
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		guarded := circuitbreaker.Gobreaker(cb)(next)
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			response, err := guarded(ctx, request)
+			if err == gobreaker.ErrOpenState || err == gobreaker.ErrTooManyRequests {
+				return nil, &sv.UnavailableError{AmadeusError: &sv.AmadeusError{Route: route, Cause: err}}
+			}
+			return response, err
+		}
+	}
+}
+
+// States reports the state of each breaker: closed, open or half-open.
+func (b *BreakerSet) States() map[string]string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	states := make(map[string]string)
+	for name, cb := range b.breakers {
+		states[name] = cb.State().String()
+	}
+	return states
+}
+
+// ServeHTTP serves the breaker states as JSON, for the admin listener.
+func (b *BreakerSet) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(b.States())
+}
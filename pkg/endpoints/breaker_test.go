@@ -0,0 +1,202 @@
+package endpoints
+
+import (
+	sv "amadeus-go/pkg/services"
+
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/sony/gobreaker"
+)
+
+const testCooldown = time.Millisecond * 50
+
+func newTestBreakers() *BreakerSet {
+	return NewBreakerSet(BreakerSettings{ConsecutiveFailures: 2, Cooldown: testCooldown, HalfOpenRequests: 1}, log.NewNopLogger())
+}
+
+var (
+	errUnavailable = &sv.UnavailableError{AmadeusError: &sv.AmadeusError{Route: "AirlineCodeLookup", Cause: errors.New("502 Bad Gateway")}}
+	errInvalid     = &sv.ValidationError{AmadeusError: &sv.AmadeusError{Route: "AirlineCodeLookup", Status: http.StatusBadRequest, Cause: errors.New("bad code")}}
+)
+
+func TestBreakerTransitions(t *testing.T) {
+	type step struct {
+		// wait for the cooldown first
+		wait bool
+		// what the endpoint answers when it is called
+		err        error
+		wantCalled bool
+		wantState  string
+	}
+
+	for _, tc := range []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "closed while calls succeed",
+			steps: []step{
+				{err: nil, wantCalled: true, wantState: "closed"},
+				{err: nil, wantCalled: true, wantState: "closed"},
+			},
+		},
+		{
+			name: "rejected requests don't trip it",
+			steps: []step{
+				{err: errInvalid, wantCalled: true, wantState: "closed"},
+				{err: errInvalid, wantCalled: true, wantState: "closed"},
+				{err: errInvalid, wantCalled: true, wantState: "closed"},
+			},
+		},
+		{
+			name: "a success resets the failures",
+			steps: []step{
+				{err: errUnavailable, wantCalled: true, wantState: "closed"},
+				{err: nil, wantCalled: true, wantState: "closed"},
+				{err: errUnavailable, wantCalled: true, wantState: "closed"},
+			},
+		},
+		{
+			name: "opened by consecutive failures",
+			steps: []step{
+				{err: errUnavailable, wantCalled: true, wantState: "closed"},
+				{err: errUnavailable, wantCalled: true, wantState: "open"},
+				{err: nil, wantCalled: false, wantState: "open"},
+			},
+		},
+		{
+			name: "closed by a successful trial",
+			steps: []step{
+				{err: errUnavailable, wantCalled: true, wantState: "closed"},
+				{err: errUnavailable, wantCalled: true, wantState: "open"},
+				{wait: true, err: nil, wantCalled: true, wantState: "closed"},
+				{err: nil, wantCalled: true, wantState: "closed"},
+			},
+		},
+		{
+			name: "opened again by a failed trial",
+			steps: []step{
+				{err: errUnavailable, wantCalled: true, wantState: "closed"},
+				{err: errUnavailable, wantCalled: true, wantState: "open"},
+				{wait: true, err: errUnavailable, wantCalled: true, wantState: "open"},
+				{err: nil, wantCalled: false, wantState: "open"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			breakers := newTestBreakers()
+
+			var (
+				called bool
+				answer error
+			)
+			ep := breakers.middleware("AirlineCodeLookup")(func(ctx context.Context, request interface{}) (interface{}, error) {
+				called = true
+				if answer != nil {
+					return nil, answer
+				}
+				return &sv.Response{}, nil
+			})
+
+			for i, s := range tc.steps {
+				if s.wait {
+					time.Sleep(testCooldown * 2)
+					if state := breakers.States()["AirlineCodeLookup"]; state != "half-open" {
+						t.Fatalf("step %d: the breaker is %s after the cooldown, want half-open", i, state)
+					}
+				}
+
+				called, answer = false, s.err
+				_, err := ep(context.Background(), &sv.AirlineCodeLookupRequest{AirlineCodes: "BA"})
+
+				if called != s.wantCalled {
+					t.Fatalf("step %d: the endpoint was called: %t, want %t", i, called, s.wantCalled)
+				}
+				if !called {
+					uErr, ok := err.(*sv.UnavailableError)
+					if !ok || uErr.Cause != gobreaker.ErrOpenState {
+						t.Fatalf("step %d: got %v, want the open breaker as an UnavailableError", i, err)
+					}
+				} else if err != s.err {
+					t.Fatalf("step %d: got %v, want %v", i, err, s.err)
+				}
+				if state := breakers.States()["AirlineCodeLookup"]; state != s.wantState {
+					t.Fatalf("step %d: the breaker is %s, want %s", i, state, s.wantState)
+				}
+			}
+		})
+	}
+}
+
+func TestBreakerSharedByRoute(t *testing.T) {
+	breakers := newTestBreakers()
+	failing := func(ctx context.Context, request interface{}) (interface{}, error) {
+		return nil, errUnavailable
+	}
+	get := breakers.middleware("GetFlightOrder")(failing)
+	create := breakers.middleware("CreateFlightOrder")(func(ctx context.Context, request interface{}) (interface{}, error) {
+		t.Fatal("the booking went through an open breaker")
+		return nil, nil
+	})
+
+	for i := 0; i < 2; i++ {
+		_, _ = get(context.Background(), &sv.GetFlightOrderRequest{FlightOrderId: "1"})
+	}
+
+	// the orders route is down, whichever method calls it
+	_, err := create(context.Background(), &sv.CreateFlightOrderRequest{})
+	if uErr, ok := err.(*sv.UnavailableError); !ok || uErr.Route != "FlightOrders" {
+		t.Fatalf("got %v, want the FlightOrders breaker to be open", err)
+	}
+}
+
+func TestNilBreakerSet(t *testing.T) {
+	var breakers *BreakerSet
+	ep := breakers.middleware("AirlineCodeLookup")(func(ctx context.Context, request interface{}) (interface{}, error) {
+		return nil, errUnavailable
+	})
+	for i := 0; i < 5; i++ {
+		if _, err := ep(context.Background(), nil); err != errUnavailable {
+			t.Fatalf("call %d: got %v, want every call through", i, err)
+		}
+	}
+}
+
+func TestBreakerStatesJSON(t *testing.T) {
+	breakers := newTestBreakers()
+	ok := func(ctx context.Context, request interface{}) (interface{}, error) { return nil, nil }
+	failing := func(ctx context.Context, request interface{}) (interface{}, error) { return nil, errUnavailable }
+
+	_, _ = breakers.middleware("AirlineCodeLookup")(ok)(context.Background(), nil)
+	cancel := breakers.middleware("CancelFlightOrder")(failing)
+	_, _ = cancel(context.Background(), nil)
+	_, _ = cancel(context.Background(), nil)
+	breakers.middleware("FlightCheckInLinks")
+
+	rec := httptest.NewRecorder()
+	breakers.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/breakers", nil))
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("got content type %q", ct)
+	}
+	var states map[string]string
+	if err := json.Unmarshal(rec.Body.Bytes(), &states); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"AirlineCodeLookup":  "closed",
+		"FlightOrders":       "open",
+		"FlightCheckInLists": "closed",
+	}
+	if !reflect.DeepEqual(states, want) {
+		t.Errorf("got %v, want %v", states, want)
+	}
+}
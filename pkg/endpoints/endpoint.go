@@ -1,6 +1,8 @@
 package endpoints
 
 import (
+	"amadeus-go/pkg/auth"
+	"amadeus-go/pkg/instrumenting"
 	sv "amadeus-go/pkg/services"
 
 	"context"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type AmadeusEndpointSet struct {
@@ -23,6 +26,14 @@ type AmadeusEndpointSet struct {
 	AirportNearestRelevantEndpoint          endpoint.Endpoint
 	AirportAndCitySearchEndpoint            endpoint.Endpoint
 	AirlineCodeLookupEndpoint               endpoint.Endpoint
+	FlightOffersSearchEndpoint              endpoint.Endpoint
+	FlightOffersPriceEndpoint               endpoint.Endpoint
+	CreateFlightOrderEndpoint               endpoint.Endpoint
+	GetFlightOrderEndpoint                  endpoint.Endpoint
+	CancelFlightOrderEndpoint               endpoint.Endpoint
+	SeatMapDisplayEndpoint                  endpoint.Endpoint
+	BrandedFaresUpsellEndpoint              endpoint.Endpoint
+	FlightAvailabilitiesSearchEndpoint      endpoint.Endpoint
 }
 
 func (s AmadeusEndpointSet) FlightLowFareSearch(ctx context.Context, request *sv.FlightLowFareSearchRequest) (*sv.Response, error) {
@@ -145,7 +156,89 @@ func (s AmadeusEndpointSet) AirlineCodeLookup(ctx context.Context, request *sv.A
 	return response, nil
 }
 
-func NewEndpointSet(srv sv.AmadeusService, logger log.Logger) *AmadeusEndpointSet {
+func (s AmadeusEndpointSet) FlightOffersSearch(ctx context.Context, request *sv.FlightOffersSearchRequest) (*sv.FlightOffersResponse, error) {
+	resp, err := s.FlightOffersSearchEndpoint(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := resp.(*sv.FlightOffersResponse)
+	return response, nil
+}
+
+func (s AmadeusEndpointSet) FlightOffersPrice(ctx context.Context, request *sv.FlightOffersPriceRequest) (*sv.FlightOffersPriceResponse, error) {
+	resp, err := s.FlightOffersPriceEndpoint(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := resp.(*sv.FlightOffersPriceResponse)
+	return response, nil
+}
+
+func (s AmadeusEndpointSet) CreateFlightOrder(ctx context.Context, request *sv.CreateFlightOrderRequest) (*sv.FlightOrderResponse, error) {
+	resp, err := s.CreateFlightOrderEndpoint(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := resp.(*sv.FlightOrderResponse)
+	return response, nil
+}
+
+func (s AmadeusEndpointSet) GetFlightOrder(ctx context.Context, request *sv.GetFlightOrderRequest) (*sv.FlightOrderResponse, error) {
+	resp, err := s.GetFlightOrderEndpoint(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := resp.(*sv.FlightOrderResponse)
+	return response, nil
+}
+
+func (s AmadeusEndpointSet) CancelFlightOrder(ctx context.Context, request *sv.CancelFlightOrderRequest) (*sv.CancelFlightOrderResponse, error) {
+	resp, err := s.CancelFlightOrderEndpoint(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := resp.(*sv.CancelFlightOrderResponse)
+	return response, nil
+}
+
+func (s AmadeusEndpointSet) SeatMapDisplay(ctx context.Context, request *sv.SeatMapDisplayRequest) (*sv.SeatMapResponse, error) {
+	resp, err := s.SeatMapDisplayEndpoint(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := resp.(*sv.SeatMapResponse)
+	return response, nil
+}
+
+func (s AmadeusEndpointSet) BrandedFaresUpsell(ctx context.Context, request *sv.BrandedFaresUpsellRequest) (*sv.FlightOffersResponse, error) {
+	resp, err := s.BrandedFaresUpsellEndpoint(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := resp.(*sv.FlightOffersResponse)
+	return response, nil
+}
+
+func (s AmadeusEndpointSet) FlightAvailabilitiesSearch(ctx context.Context, request *sv.FlightAvailabilitiesSearchRequest) (*sv.FlightAvailabilitiesResponse, error) {
+	resp, err := s.FlightAvailabilitiesSearchEndpoint(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := resp.(*sv.FlightAvailabilitiesResponse)
+	return response, nil
+}
+
+// NewEndpointSet wraps each method of srv in an endpoint. With a non-nil
+// authenticator, calls must carry credentials resolving to a tenant.
+func NewEndpointSet(srv sv.AmadeusService, logger log.Logger, metrics *instrumenting.Metrics, tracer trace.Tracer, breakers *BreakerSet, authenticator *auth.Authenticator) *AmadeusEndpointSet {
 	var (
 		flightLowFareSearchEndpoint             endpoint.Endpoint
 		flightInspirationSearchEndpoint         endpoint.Endpoint
@@ -159,43 +252,155 @@ func NewEndpointSet(srv sv.AmadeusService, logger log.Logger) *AmadeusEndpointSe
 		airportNearestRelevantEndpoint          endpoint.Endpoint
 		airportAndCitySearchEndpoint            endpoint.Endpoint
 		airlineCodeLookupEndpoint               endpoint.Endpoint
+		flightOffersSearchEndpoint              endpoint.Endpoint
+		flightOffersPriceEndpoint               endpoint.Endpoint
+		createFlightOrderEndpoint               endpoint.Endpoint
+		getFlightOrderEndpoint                  endpoint.Endpoint
+		cancelFlightOrderEndpoint               endpoint.Endpoint
+		seatMapDisplayEndpoint                  endpoint.Endpoint
+		brandedFaresUpsellEndpoint              endpoint.Endpoint
+		flightAvailabilitiesSearchEndpoint      endpoint.Endpoint
 	)
 
 	flightLowFareSearchEndpoint = makeFlightLowFareSearchEndpoint(srv)
+	flightLowFareSearchEndpoint = breakers.middleware("FlightLowFareSearch")(flightLowFareSearchEndpoint)
+	flightLowFareSearchEndpoint = authMiddleware(authenticator)(flightLowFareSearchEndpoint)
+	flightLowFareSearchEndpoint = instrumentingMiddleware(metrics, "FlightLowFareSearch")(flightLowFareSearchEndpoint)
 	flightLowFareSearchEndpoint = loggingMiddleware(logger, "FlightLowFareSearch")(flightLowFareSearchEndpoint)
+	flightLowFareSearchEndpoint = tracingMiddleware(tracer, "FlightLowFareSearch")(flightLowFareSearchEndpoint)
 
 	flightInspirationSearchEndpoint = makeFlightInspirationSearchEndpoint(srv)
+	flightInspirationSearchEndpoint = breakers.middleware("FlightInspirationSearch")(flightInspirationSearchEndpoint)
+	flightInspirationSearchEndpoint = authMiddleware(authenticator)(flightInspirationSearchEndpoint)
+	flightInspirationSearchEndpoint = instrumentingMiddleware(metrics, "FlightInspirationSearch")(flightInspirationSearchEndpoint)
 	flightInspirationSearchEndpoint = loggingMiddleware(logger, "FlightInspirationSearch")(flightInspirationSearchEndpoint)
+	flightInspirationSearchEndpoint = tracingMiddleware(tracer, "FlightInspirationSearch")(flightInspirationSearchEndpoint)
 
 	flightCheapestDateSearchEndpoint = makeFlightCheapestDateSearchEndpoint(srv)
+	flightCheapestDateSearchEndpoint = breakers.middleware("FlightCheapestDateSearch")(flightCheapestDateSearchEndpoint)
+	flightCheapestDateSearchEndpoint = authMiddleware(authenticator)(flightCheapestDateSearchEndpoint)
+	flightCheapestDateSearchEndpoint = instrumentingMiddleware(metrics, "FlightCheapestDateSearch")(flightCheapestDateSearchEndpoint)
 	flightCheapestDateSearchEndpoint = loggingMiddleware(logger, "FlightCheapestDateSearch")(flightCheapestDateSearchEndpoint)
+	flightCheapestDateSearchEndpoint = tracingMiddleware(tracer, "FlightCheapestDateSearch")(flightCheapestDateSearchEndpoint)
 
 	flightMostSearchedDestinationsEndpoint = makeFlightMostSearchedDestinationsEndpoint(srv)
+	flightMostSearchedDestinationsEndpoint = breakers.middleware("FlightMostSearchedDestinations")(flightMostSearchedDestinationsEndpoint)
+	flightMostSearchedDestinationsEndpoint = authMiddleware(authenticator)(flightMostSearchedDestinationsEndpoint)
+	flightMostSearchedDestinationsEndpoint = instrumentingMiddleware(metrics, "FlightMostSearchedDestinations")(flightMostSearchedDestinationsEndpoint)
 	flightMostSearchedDestinationsEndpoint = loggingMiddleware(logger, "FlightMostSearchedDestinations")(flightMostSearchedDestinationsEndpoint)
+	flightMostSearchedDestinationsEndpoint = tracingMiddleware(tracer, "FlightMostSearchedDestinations")(flightMostSearchedDestinationsEndpoint)
 
 	flightMostSearchedByDestinationEndpoint = makeFlightMostSearchedByDestinationEndpoint(srv)
+	flightMostSearchedByDestinationEndpoint = breakers.middleware("FlightMostSearchedByDestination")(flightMostSearchedByDestinationEndpoint)
+	flightMostSearchedByDestinationEndpoint = authMiddleware(authenticator)(flightMostSearchedByDestinationEndpoint)
+	flightMostSearchedByDestinationEndpoint = instrumentingMiddleware(metrics, "FlightMostSearchedByDestination")(flightMostSearchedByDestinationEndpoint)
 	flightMostSearchedByDestinationEndpoint = loggingMiddleware(logger, "FlightMostSearchedByDestination")(flightMostSearchedByDestinationEndpoint)
+	flightMostSearchedByDestinationEndpoint = tracingMiddleware(tracer, "FlightMostSearchedByDestination")(flightMostSearchedByDestinationEndpoint)
 
 	flightCheckInLinksEndpoint = makeFlightCheckInLinksEndpoint(srv)
+	flightCheckInLinksEndpoint = breakers.middleware("FlightCheckInLinks")(flightCheckInLinksEndpoint)
+	flightCheckInLinksEndpoint = authMiddleware(authenticator)(flightCheckInLinksEndpoint)
+	flightCheckInLinksEndpoint = instrumentingMiddleware(metrics, "FlightCheckInLinks")(flightCheckInLinksEndpoint)
 	flightCheckInLinksEndpoint = loggingMiddleware(logger, "FlightCheckInLinks")(flightCheckInLinksEndpoint)
+	flightCheckInLinksEndpoint = tracingMiddleware(tracer, "FlightCheckInLinks")(flightCheckInLinksEndpoint)
 
 	flightMostTraveledDestinationsEndpoint = makeFlightMostTraveledDestinationsEndpoint(srv)
+	flightMostTraveledDestinationsEndpoint = breakers.middleware("FlightMostTraveledDestinations")(flightMostTraveledDestinationsEndpoint)
+	flightMostTraveledDestinationsEndpoint = authMiddleware(authenticator)(flightMostTraveledDestinationsEndpoint)
+	flightMostTraveledDestinationsEndpoint = instrumentingMiddleware(metrics, "FlightMostTraveledDestinations")(flightMostTraveledDestinationsEndpoint)
 	flightMostTraveledDestinationsEndpoint = loggingMiddleware(logger, "FlightMostTraveledDestinations")(flightMostTraveledDestinationsEndpoint)
+	flightMostTraveledDestinationsEndpoint = tracingMiddleware(tracer, "FlightMostTraveledDestinations")(flightMostTraveledDestinationsEndpoint)
 
 	flightMostBookedDestinationsEndpoint = makeFlightMostBookedDestinationsEndpoint(srv)
+	flightMostBookedDestinationsEndpoint = breakers.middleware("FlightMostBookedDestinations")(flightMostBookedDestinationsEndpoint)
+	flightMostBookedDestinationsEndpoint = authMiddleware(authenticator)(flightMostBookedDestinationsEndpoint)
+	flightMostBookedDestinationsEndpoint = instrumentingMiddleware(metrics, "FlightMostBookedDestinations")(flightMostBookedDestinationsEndpoint)
 	flightMostBookedDestinationsEndpoint = loggingMiddleware(logger, "FlightMostBookedDestinations")(flightMostBookedDestinationsEndpoint)
+	flightMostBookedDestinationsEndpoint = tracingMiddleware(tracer, "FlightMostBookedDestinations")(flightMostBookedDestinationsEndpoint)
 
 	flightBusiestTravelingPeriodEndpoint = makeFlightBusiestTravelingPeriodEndpoint(srv)
+	flightBusiestTravelingPeriodEndpoint = breakers.middleware("FlightBusiestTravelingPeriod")(flightBusiestTravelingPeriodEndpoint)
+	flightBusiestTravelingPeriodEndpoint = authMiddleware(authenticator)(flightBusiestTravelingPeriodEndpoint)
+	flightBusiestTravelingPeriodEndpoint = instrumentingMiddleware(metrics, "FlightBusiestTravelingPeriod")(flightBusiestTravelingPeriodEndpoint)
 	flightBusiestTravelingPeriodEndpoint = loggingMiddleware(logger, "FlightBusiestTravelingPeriod")(flightBusiestTravelingPeriodEndpoint)
+	flightBusiestTravelingPeriodEndpoint = tracingMiddleware(tracer, "FlightBusiestTravelingPeriod")(flightBusiestTravelingPeriodEndpoint)
 
 	airportNearestRelevantEndpoint = makeAirportNearestRelevantEndpoint(srv)
+	airportNearestRelevantEndpoint = breakers.middleware("AirportNearestRelevant")(airportNearestRelevantEndpoint)
+	airportNearestRelevantEndpoint = authMiddleware(authenticator)(airportNearestRelevantEndpoint)
+	airportNearestRelevantEndpoint = instrumentingMiddleware(metrics, "AirportNearestRelevant")(airportNearestRelevantEndpoint)
 	airportNearestRelevantEndpoint = loggingMiddleware(logger, "AirportNearestRelevant")(airportNearestRelevantEndpoint)
+	airportNearestRelevantEndpoint = tracingMiddleware(tracer, "AirportNearestRelevant")(airportNearestRelevantEndpoint)
 
 	airportAndCitySearchEndpoint = makeAirportAndCitySearchEndpoint(srv)
+	airportAndCitySearchEndpoint = breakers.middleware("AirportAndCitySearch")(airportAndCitySearchEndpoint)
+	airportAndCitySearchEndpoint = authMiddleware(authenticator)(airportAndCitySearchEndpoint)
+	airportAndCitySearchEndpoint = instrumentingMiddleware(metrics, "AirportAndCitySearch")(airportAndCitySearchEndpoint)
 	airportAndCitySearchEndpoint = loggingMiddleware(logger, "AirportAndCitySearch")(airportAndCitySearchEndpoint)
+	airportAndCitySearchEndpoint = tracingMiddleware(tracer, "AirportAndCitySearch")(airportAndCitySearchEndpoint)
 
 	airlineCodeLookupEndpoint = makeAirlineCodeLookupEndpoint(srv)
+	airlineCodeLookupEndpoint = breakers.middleware("AirlineCodeLookup")(airlineCodeLookupEndpoint)
+	airlineCodeLookupEndpoint = authMiddleware(authenticator)(airlineCodeLookupEndpoint)
+	airlineCodeLookupEndpoint = instrumentingMiddleware(metrics, "AirlineCodeLookup")(airlineCodeLookupEndpoint)
 	airlineCodeLookupEndpoint = loggingMiddleware(logger, "AirlineCodeLookup")(airlineCodeLookupEndpoint)
+	airlineCodeLookupEndpoint = tracingMiddleware(tracer, "AirlineCodeLookup")(airlineCodeLookupEndpoint)
+
+	flightOffersSearchEndpoint = makeFlightOffersSearchEndpoint(srv)
+	flightOffersSearchEndpoint = breakers.middleware("FlightOffersSearch")(flightOffersSearchEndpoint)
+	flightOffersSearchEndpoint = authMiddleware(authenticator)(flightOffersSearchEndpoint)
+	flightOffersSearchEndpoint = instrumentingMiddleware(metrics, "FlightOffersSearch")(flightOffersSearchEndpoint)
+	flightOffersSearchEndpoint = loggingMiddleware(logger, "FlightOffersSearch")(flightOffersSearchEndpoint)
+	flightOffersSearchEndpoint = tracingMiddleware(tracer, "FlightOffersSearch")(flightOffersSearchEndpoint)
+
+	flightOffersPriceEndpoint = makeFlightOffersPriceEndpoint(srv)
+	flightOffersPriceEndpoint = breakers.middleware("FlightOffersPrice")(flightOffersPriceEndpoint)
+	flightOffersPriceEndpoint = authMiddleware(authenticator)(flightOffersPriceEndpoint)
+	flightOffersPriceEndpoint = instrumentingMiddleware(metrics, "FlightOffersPrice")(flightOffersPriceEndpoint)
+	flightOffersPriceEndpoint = loggingMiddleware(logger, "FlightOffersPrice")(flightOffersPriceEndpoint)
+	flightOffersPriceEndpoint = tracingMiddleware(tracer, "FlightOffersPrice")(flightOffersPriceEndpoint)
+
+	createFlightOrderEndpoint = makeCreateFlightOrderEndpoint(srv)
+	createFlightOrderEndpoint = breakers.middleware("CreateFlightOrder")(createFlightOrderEndpoint)
+	createFlightOrderEndpoint = authMiddleware(authenticator)(createFlightOrderEndpoint)
+	createFlightOrderEndpoint = instrumentingMiddleware(metrics, "CreateFlightOrder")(createFlightOrderEndpoint)
+	createFlightOrderEndpoint = loggingMiddleware(logger, "CreateFlightOrder")(createFlightOrderEndpoint)
+	createFlightOrderEndpoint = tracingMiddleware(tracer, "CreateFlightOrder")(createFlightOrderEndpoint)
+
+	getFlightOrderEndpoint = makeGetFlightOrderEndpoint(srv)
+	getFlightOrderEndpoint = breakers.middleware("GetFlightOrder")(getFlightOrderEndpoint)
+	getFlightOrderEndpoint = authMiddleware(authenticator)(getFlightOrderEndpoint)
+	getFlightOrderEndpoint = instrumentingMiddleware(metrics, "GetFlightOrder")(getFlightOrderEndpoint)
+	getFlightOrderEndpoint = loggingMiddleware(logger, "GetFlightOrder")(getFlightOrderEndpoint)
+	getFlightOrderEndpoint = tracingMiddleware(tracer, "GetFlightOrder")(getFlightOrderEndpoint)
+
+	cancelFlightOrderEndpoint = makeCancelFlightOrderEndpoint(srv)
+	cancelFlightOrderEndpoint = breakers.middleware("CancelFlightOrder")(cancelFlightOrderEndpoint)
+	cancelFlightOrderEndpoint = authMiddleware(authenticator)(cancelFlightOrderEndpoint)
+	cancelFlightOrderEndpoint = instrumentingMiddleware(metrics, "CancelFlightOrder")(cancelFlightOrderEndpoint)
+	cancelFlightOrderEndpoint = loggingMiddleware(logger, "CancelFlightOrder")(cancelFlightOrderEndpoint)
+	cancelFlightOrderEndpoint = tracingMiddleware(tracer, "CancelFlightOrder")(cancelFlightOrderEndpoint)
+
+	seatMapDisplayEndpoint = makeSeatMapDisplayEndpoint(srv)
+	seatMapDisplayEndpoint = breakers.middleware("SeatMapDisplay")(seatMapDisplayEndpoint)
+	seatMapDisplayEndpoint = authMiddleware(authenticator)(seatMapDisplayEndpoint)
+	seatMapDisplayEndpoint = instrumentingMiddleware(metrics, "SeatMapDisplay")(seatMapDisplayEndpoint)
+	seatMapDisplayEndpoint = loggingMiddleware(logger, "SeatMapDisplay")(seatMapDisplayEndpoint)
+	seatMapDisplayEndpoint = tracingMiddleware(tracer, "SeatMapDisplay")(seatMapDisplayEndpoint)
+
+	brandedFaresUpsellEndpoint = makeBrandedFaresUpsellEndpoint(srv)
+	brandedFaresUpsellEndpoint = breakers.middleware("BrandedFaresUpsell")(brandedFaresUpsellEndpoint)
+	brandedFaresUpsellEndpoint = authMiddleware(authenticator)(brandedFaresUpsellEndpoint)
+	brandedFaresUpsellEndpoint = instrumentingMiddleware(metrics, "BrandedFaresUpsell")(brandedFaresUpsellEndpoint)
+	brandedFaresUpsellEndpoint = loggingMiddleware(logger, "BrandedFaresUpsell")(brandedFaresUpsellEndpoint)
+	brandedFaresUpsellEndpoint = tracingMiddleware(tracer, "BrandedFaresUpsell")(brandedFaresUpsellEndpoint)
+
+	flightAvailabilitiesSearchEndpoint = makeFlightAvailabilitiesSearchEndpoint(srv)
+	flightAvailabilitiesSearchEndpoint = breakers.middleware("FlightAvailabilitiesSearch")(flightAvailabilitiesSearchEndpoint)
+	flightAvailabilitiesSearchEndpoint = authMiddleware(authenticator)(flightAvailabilitiesSearchEndpoint)
+	flightAvailabilitiesSearchEndpoint = instrumentingMiddleware(metrics, "FlightAvailabilitiesSearch")(flightAvailabilitiesSearchEndpoint)
+	flightAvailabilitiesSearchEndpoint = loggingMiddleware(logger, "FlightAvailabilitiesSearch")(flightAvailabilitiesSearchEndpoint)
+	flightAvailabilitiesSearchEndpoint = tracingMiddleware(tracer, "FlightAvailabilitiesSearch")(flightAvailabilitiesSearchEndpoint)
 
 	return &AmadeusEndpointSet{
 		FlightLowFareSearchEndpoint:             flightLowFareSearchEndpoint,
@@ -210,6 +415,14 @@ func NewEndpointSet(srv sv.AmadeusService, logger log.Logger) *AmadeusEndpointSe
 		AirportNearestRelevantEndpoint:          airportNearestRelevantEndpoint,
 		AirportAndCitySearchEndpoint:            airportAndCitySearchEndpoint,
 		AirlineCodeLookupEndpoint:               airlineCodeLookupEndpoint,
+		FlightOffersSearchEndpoint:              flightOffersSearchEndpoint,
+		FlightOffersPriceEndpoint:               flightOffersPriceEndpoint,
+		CreateFlightOrderEndpoint:               createFlightOrderEndpoint,
+		GetFlightOrderEndpoint:                  getFlightOrderEndpoint,
+		CancelFlightOrderEndpoint:               cancelFlightOrderEndpoint,
+		SeatMapDisplayEndpoint:                  seatMapDisplayEndpoint,
+		BrandedFaresUpsellEndpoint:              brandedFaresUpsellEndpoint,
+		FlightAvailabilitiesSearchEndpoint:      flightAvailabilitiesSearchEndpoint,
 	}
 }
 
@@ -357,3 +570,99 @@ func makeAirlineCodeLookupEndpoint(srv sv.AmadeusService) endpoint.Endpoint {
 		return resp, err
 	}
 }
+
+func makeFlightOffersSearchEndpoint(srv sv.AmadeusService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(*sv.FlightOffersSearchRequest)
+		if !ok {
+			return nil, errors.New("service did not fetch type <FlightOffersSearchRequest>")
+		}
+
+		resp, err := srv.FlightOffersSearch(ctx, req)
+		return resp, err
+	}
+}
+
+func makeFlightOffersPriceEndpoint(srv sv.AmadeusService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(*sv.FlightOffersPriceRequest)
+		if !ok {
+			return nil, errors.New("service did not fetch type <FlightOffersPriceRequest>")
+		}
+
+		resp, err := srv.FlightOffersPrice(ctx, req)
+		return resp, err
+	}
+}
+
+func makeCreateFlightOrderEndpoint(srv sv.AmadeusService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(*sv.CreateFlightOrderRequest)
+		if !ok {
+			return nil, errors.New("service did not fetch type <CreateFlightOrderRequest>")
+		}
+
+		resp, err := srv.CreateFlightOrder(ctx, req)
+		return resp, err
+	}
+}
+
+func makeGetFlightOrderEndpoint(srv sv.AmadeusService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(*sv.GetFlightOrderRequest)
+		if !ok {
+			return nil, errors.New("service did not fetch type <GetFlightOrderRequest>")
+		}
+
+		resp, err := srv.GetFlightOrder(ctx, req)
+		return resp, err
+	}
+}
+
+func makeCancelFlightOrderEndpoint(srv sv.AmadeusService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(*sv.CancelFlightOrderRequest)
+		if !ok {
+			return nil, errors.New("service did not fetch type <CancelFlightOrderRequest>")
+		}
+
+		resp, err := srv.CancelFlightOrder(ctx, req)
+		return resp, err
+	}
+}
+
+func makeSeatMapDisplayEndpoint(srv sv.AmadeusService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(*sv.SeatMapDisplayRequest)
+		if !ok {
+			return nil, errors.New("service did not fetch type <SeatMapDisplayRequest>")
+		}
+
+		resp, err := srv.SeatMapDisplay(ctx, req)
+		return resp, err
+	}
+}
+
+func makeBrandedFaresUpsellEndpoint(srv sv.AmadeusService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(*sv.BrandedFaresUpsellRequest)
+		if !ok {
+			return nil, errors.New("service did not fetch type <BrandedFaresUpsellRequest>")
+		}
+
+		resp, err := srv.BrandedFaresUpsell(ctx, req)
+		return resp, err
+	}
+}
+
+func makeFlightAvailabilitiesSearchEndpoint(srv sv.AmadeusService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(*sv.FlightAvailabilitiesSearchRequest)
+		if !ok {
+			return nil, errors.New("service did not fetch type <FlightAvailabilitiesSearchRequest>")
+		}
+
+		resp, err := srv.FlightAvailabilitiesSearch(ctx, req)
+		return resp, err
+	}
+}
@@ -1,18 +1,25 @@
 package endpoints
 
 import (
+	"amadeus-go/pkg/auth"
+	"amadeus-go/pkg/instrumenting"
+	"amadeus-go/pkg/logging"
+	sv "amadeus-go/pkg/services"
+	"amadeus-go/pkg/tracing"
+
 	"context"
 	"time"
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func loggingMiddleware(logger log.Logger, methodName string) endpoint.Middleware {
 	return func(next endpoint.Endpoint) endpoint.Endpoint {
 		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 			defer func(begin time.Time) {
-				_ = logger.Log(
+				_ = logging.ByError(logging.WithContext(logger, ctx), err).Log(
 					"layer", "endpoint",
 					"method", methodName,
 					"input", request,
@@ -26,3 +33,43 @@ func loggingMiddleware(logger log.Logger, methodName string) endpoint.Middleware
 		}
 	}
 }
+
+// instrumentingMiddleware counts the calls of an endpoint and how long they
+// took, and the ones that failed by kind of error.
+func instrumentingMiddleware(metrics *instrumenting.Metrics, methodName string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			defer func(begin time.Time) {
+				kind := ""
+				if err != nil {
+					kind = sv.ErrorKind(err)
+				}
+				metrics.ObserveRequest("endpoint", methodName, kind, time.Since(begin))
+			}(time.Now())
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// tracingMiddleware wraps the calls of an endpoint in a span, a child of the
+// one the transport started for the request, if any.
+func tracingMiddleware(tracer trace.Tracer, methodName string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			ctx, span := tracer.Start(ctx, "endpoint."+methodName)
+			defer func() { tracing.End(span, err) }()
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// authMiddleware rejects calls that don't authenticate with authenticator.
+// Without one, every call goes through.
+func authMiddleware(authenticator *auth.Authenticator) endpoint.Middleware {
+	if authenticator == nil {
+		return func(next endpoint.Endpoint) endpoint.Endpoint { return next }
+	}
+	return auth.Middleware(authenticator)
+}
@@ -0,0 +1,109 @@
+/*
+	Instrumenting holds the metrics the service, its endpoints and its client
+	of Amadeus report to, so they can all be scraped from one place.
+*/
+
+package instrumenting
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "amadeus_go"
+
+// Metrics are the instruments of the service. Layers are "endpoint" and
+// "service", methods are the names of the RPCs and routes are the names of
+// the Amadeus APIs, as in API-urls.json.
+type Metrics struct {
+	// Requests counts calls by layer and method.
+	Requests metrics.Counter
+	// Errors counts failed calls by layer, method and kind of error.
+	Errors metrics.Counter
+	// Duration observes how long calls took, by layer and method.
+	Duration metrics.Histogram
+	// Upstream counts the responses Amadeus sent, by route and HTTP status;
+	// the status is "none" when no response came back at all.
+	Upstream metrics.Counter
+	// TokenRefreshes counts the refreshes of the access token by result.
+	TokenRefreshes metrics.Counter
+	// Cache counts cache lookups by method and result: "hit", "stale" or
+	// "miss"; misses answered with a stale entry are also counted as
+	// "fallback".
+	Cache metrics.Counter
+}
+
+// NewPrometheusMetrics creates the metrics and registers them with the default
+// Prometheus registry, so they can only be created once per process.
+func NewPrometheusMetrics() *Metrics {
+	return &Metrics{
+		Requests: kitprometheus.NewCounterFrom(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of requests received.",
+		}, []string{"layer", "method"}),
+		Errors: kitprometheus.NewCounterFrom(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "request_errors_total",
+			Help:      "Number of requests that failed, by kind of error.",
+		}, []string{"layer", "method", "kind"}),
+		Duration: kitprometheus.NewHistogramFrom(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Time taken to answer requests, in seconds.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"layer", "method"}),
+		Upstream: kitprometheus.NewCounterFrom(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_responses_total",
+			Help:      "Number of responses from Amadeus, by route and HTTP status.",
+		}, []string{"route", "code"}),
+		TokenRefreshes: kitprometheus.NewCounterFrom(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "token_refreshes_total",
+			Help:      "Number of refreshes of the Amadeus access token, by result.",
+		}, []string{"result"}),
+		Cache: kitprometheus.NewCounterFrom(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_results_total",
+			Help:      "Number of response cache lookups, by result.",
+		}, []string{"method", "result"}),
+	}
+}
+
+// DiscardMetrics returns metrics that record nothing.
+func DiscardMetrics() *Metrics {
+	return &Metrics{
+		Requests:       discard.NewCounter(),
+		Errors:         discard.NewCounter(),
+		Duration:       discard.NewHistogram(),
+		Upstream:       discard.NewCounter(),
+		TokenRefreshes: discard.NewCounter(),
+		Cache:          discard.NewCounter(),
+	}
+}
+
+// ObserveRequest records a call to method at layer that took took and,
+// unless errorKind is empty, failed with an error of that kind.
+func (m *Metrics) ObserveRequest(layer string, method string, errorKind string, took time.Duration) {
+	m.Requests.With("layer", layer, "method", method).Add(1)
+	m.Duration.With("layer", layer, "method", method).Observe(took.Seconds())
+	if errorKind != "" {
+		m.Errors.With("layer", layer, "method", method, "kind", errorKind).Add(1)
+	}
+}
+
+// ObserveUpstream records a response of Amadeus to a call to route; status is
+// 0 when the call got no response.
+func (m *Metrics) ObserveUpstream(route string, status int) {
+	code := "none"
+	if status != 0 {
+		code = strconv.Itoa(status)
+	}
+	m.Upstream.With("route", route, "code", code).Add(1)
+}
@@ -0,0 +1,46 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/go-kit/kit/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type contextKey int
+
+const requestIDKey contextKey = iota
+
+// NewRequestID returns a random ID for a request that came without one.
+func NewRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// WithRequestID returns a copy of ctx carrying the ID of the request it was
+// made for.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestID returns the ID of the request ctx was made for, if any.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
+
+// WithContext returns logger with the request ID and the trace ID in ctx,
+// so the lines every layer logs for a request can be told apart from the
+// others and tied to its trace.
+func WithContext(logger log.Logger, ctx context.Context) log.Logger {
+	if id := RequestID(ctx); id != "" {
+		logger = log.With(logger, "request_id", id)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		logger = log.With(logger, "trace_id", sc.TraceID().String())
+	}
+	return logger
+}
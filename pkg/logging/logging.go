@@ -0,0 +1,268 @@
+/*
+	Logging filters, samples and cleans up what the layers of the service log,
+	and ties together the lines logged for one request.
+*/
+
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+const redacted = "[REDACTED]"
+
+// defaultRedact are the fields never logged whatever the config says: the
+// credentials of the service with Amadeus and those of its callers.
+var defaultRedact = []string{
+	"api_key", "api_secret", "apisecret", "client_secret",
+	"access_token", "accesstoken", "authorization", "x-api-key", "password",
+}
+
+// bearerPattern matches the credentials of an Authorization header, wherever
+// they show up.
+var bearerPattern = regexp.MustCompile(`(?i)(bearer\s+)[a-z0-9\-._~+/]+=*`)
+
+var levels = map[string]int{"debug": 0, "info": 1, "warn": 2, "error": 3}
+
+// Config is the LOGGING section of the config file.
+type Config struct {
+	// Level is the lowest level logged: "debug", "info" (the default), "warn"
+	// or "error".
+	Level string `json:"LEVEL"`
+	// Layers overrides Level for some layers, e.g. {"cache": "warn"}.
+	Layers map[string]string `json:"LAYERS"`
+	// Bodies logs whole responses rather than a summary of them, except for
+	// those holding personal data.
+	Bodies bool `json:"BODIES"`
+	// Redact names more fields whose values are never logged.
+	Redact []string `json:"REDACT"`
+	// SuccessSample is the fraction of successful calls that are logged, all
+	// of them when it is not set. Successful calls are the lines with a nil
+	// "error" at the info level or below. Whether a call is logged is decided by its
+	// request ID, so it is logged by every layer or by none.
+	SuccessSample *float64 `json:"SUCCESS_SAMPLE"`
+}
+
+// ReadConfig reads the LOGGING section of a config file.
+func ReadConfig(filename string) (Config, error) {
+	var conf struct {
+		Logging Config `json:"LOGGING"`
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return Config{}, err
+	}
+	defer file.Close()
+
+	err = json.NewDecoder(file).Decode(&conf)
+	return conf.Logging, err
+}
+
+// Summarizer is implemented by values too big to be logged whole, like
+// responses full of offers.
+type Summarizer interface {
+	LogSummary() string
+}
+
+// Personal is implemented by values holding personal data, like the names
+// and passports of travelers, which are summarised even when Bodies is set.
+type Personal interface {
+	Summarizer
+	PersonalData()
+}
+
+// NewLogger applies conf to the lines logged through next: lines below the
+// level of their layer are dropped, successful calls are sampled, values
+// are summarised and secrets are redacted. Lines without a level count as
+// info.
+func NewLogger(next log.Logger, conf Config) (log.Logger, error) {
+	threshold, err := parseLevel(conf.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	layers := make(map[string]int)
+	for layer, name := range conf.Layers {
+		layers[layer], err = parseLevel(name)
+		if err != nil {
+			return nil, fmt.Errorf("layer %s: %v", layer, err)
+		}
+	}
+
+	sample := 1.0
+	if conf.SuccessSample != nil {
+		sample = *conf.SuccessSample
+	}
+
+	names := append(append([]string{}, defaultRedact...), conf.Redact...)
+	redact := make(map[string]bool)
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		redact[strings.ToLower(name)] = true
+		quoted = append(quoted, regexp.QuoteMeta(name))
+	}
+
+	return &logger{
+		next:      next,
+		threshold: threshold,
+		layers:    layers,
+		bodies:    conf.Bodies,
+		sample:    sample,
+		redact:    redact,
+		// name=value, name: value and "name":"value" alike
+		fieldPattern: regexp.MustCompile(`(?i)("?\b(?:` + strings.Join(quoted, "|") + `)"?\s*[:=]\s*"?)([^"&,\s}\]]+)`),
+	}, nil
+}
+
+func parseLevel(name string) (int, error) {
+	if name == "" {
+		return levels["info"], nil
+	}
+	l, ok := levels[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown log level %q", name)
+	}
+	return l, nil
+}
+
+type logger struct {
+	next         log.Logger
+	threshold    int
+	layers       map[string]int
+	bodies       bool
+	sample       float64
+	redact       map[string]bool
+	fieldPattern *regexp.Regexp
+}
+
+func (l *logger) Log(keyvals ...interface{}) error {
+	var (
+		layer     string
+		requestID string
+		lvl       = levels["info"]
+		succeeded = false
+	)
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		switch keyvals[i] {
+		case level.Key():
+			if v, ok := levels[fmt.Sprint(keyvals[i+1])]; ok {
+				lvl = v
+			}
+		case "layer":
+			layer, _ = keyvals[i+1].(string)
+		case "request_id":
+			requestID, _ = keyvals[i+1].(string)
+		case "error":
+			succeeded = keyvals[i+1] == nil
+		}
+	}
+
+	threshold, ok := l.layers[layer]
+	if !ok {
+		threshold = l.threshold
+	}
+	if lvl < threshold {
+		return nil
+	}
+	if succeeded && lvl <= levels["info"] && !l.sampled(requestID) {
+		return nil
+	}
+
+	cleaned := make([]interface{}, len(keyvals))
+	copy(cleaned, keyvals)
+	for i := 1; i < len(cleaned); i += 2 {
+		if key, ok := cleaned[i-1].(string); ok && l.redact[strings.ToLower(key)] {
+			cleaned[i] = redacted
+			continue
+		}
+		cleaned[i] = l.clean(cleaned[i])
+	}
+
+	return l.next.Log(cleaned...)
+}
+
+// sampled tells whether a successful call is logged. Calls of the same
+// request get the same answer.
+func (l *logger) sampled(requestID string) bool {
+	if l.sample >= 1 {
+		return true
+	}
+	if requestID == "" {
+		return rand.Float64() < l.sample
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(requestID))
+	return float64(h.Sum32())/math.MaxUint32 < l.sample
+}
+
+// clean turns a value into something short and safe to log. Structs, maps
+// and slices are logged as JSON, which logfmt can't do on its own.
+func (l *logger) clean(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+
+	if s, ok := value.(Summarizer); ok {
+		if _, personal := value.(Personal); personal || !l.bodies {
+			return s.LogSummary()
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return l.redactText(v)
+	case error:
+		return l.redactText(v.Error())
+	case fmt.Stringer:
+		return l.redactText(v.String())
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		b, err := json.Marshal(value)
+		if err != nil {
+			return l.redactText(fmt.Sprintf("%+v", value))
+		}
+		return l.redactText(string(b))
+	default:
+		return value
+	}
+}
+
+func (l *logger) redactText(s string) string {
+	s = bearerPattern.ReplaceAllString(s, "${1}"+redacted)
+	return l.fieldPattern.ReplaceAllStringFunc(s, func(field string) string {
+		m := l.fieldPattern.FindStringSubmatch(field)
+		// the token after it is already gone
+		if strings.EqualFold(m[2], "bearer") {
+			return field
+		}
+		return m[1] + redacted
+	})
+}
+
+// ByError returns logger at the error level if err is not nil, at the info
+// level otherwise.
+func ByError(logger log.Logger, err error) log.Logger {
+	if err != nil {
+		return level.Error(logger)
+	}
+	return level.Info(logger)
+}
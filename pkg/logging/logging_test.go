@@ -0,0 +1,112 @@
+package logging
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+// capture returns a logger applying conf whose lines end up in lines.
+func capture(t *testing.T, conf Config) (log.Logger, *[][]interface{}) {
+	t.Helper()
+	var lines [][]interface{}
+	logger, err := NewLogger(log.LoggerFunc(func(keyvals ...interface{}) error {
+		lines = append(lines, keyvals)
+		return nil
+	}), conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return logger, &lines
+}
+
+type summarized struct{ Offers []string }
+
+func (summarized) LogSummary() string { return "2 offers" }
+
+type traveler struct{ Name string }
+
+func (traveler) LogSummary() string { return "1 traveler" }
+func (traveler) PersonalData()      {}
+
+func TestClean(t *testing.T) {
+	secret, _ := url.Parse("https://test.api.amadeus.com/v1/security/oauth2/token?client_secret=s3cret&grant_type=client_credentials")
+
+	for _, tc := range []struct {
+		name   string
+		bodies bool
+		key    string
+		value  interface{}
+		want   interface{}
+	}{
+		{"plain string", false, "msg", "hello", "hello"},
+		{"redacted field", false, "api_secret", "s3cret", redacted},
+		{"redacted field of another case", false, "Authorization", "Bearer abc", redacted},
+		{"extra redacted field", false, "card", "4111", redacted},
+		{"bearer token in text", false, "msg", "sent Bearer abc.def", "sent Bearer " + redacted},
+		{"field in text", false, "msg", `{"access_token":"abc","expires_in":1799}`, `{"access_token":"` + redacted + `","expires_in":1799}`},
+		{"error", false, "error", errors.New("refused: api_key=abc"), "refused: api_key=" + redacted},
+		{"stringer", false, "url", secret, "https://test.api.amadeus.com/v1/security/oauth2/token?client_secret=" + redacted + "&grant_type=client_credentials"},
+		{"stringer without secrets", false, "took", time.Second, "1s"},
+		{"struct as JSON", false, "request", struct{ Password string }{"pw"}, `{"Password":"` + redacted + `"}`},
+		{"summary", false, "response", summarized{[]string{"a", "b"}}, "2 offers"},
+		{"whole body", true, "response", summarized{[]string{"a", "b"}}, `{"Offers":["a","b"]}`},
+		{"personal data", true, "request", traveler{"Jane"}, "1 traveler"},
+		{"nil pointer", false, "response", (*summarized)(nil), nil},
+		{"number", false, "count", 3, 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			logger, lines := capture(t, Config{Bodies: tc.bodies, Redact: []string{"card"}})
+			if err := logger.Log(tc.key, tc.value); err != nil {
+				t.Fatal(err)
+			}
+			if len(*lines) != 1 {
+				t.Fatalf("got %d lines, want one", len(*lines))
+			}
+			if got := (*lines)[0][1]; got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLevels(t *testing.T) {
+	logger, lines := capture(t, Config{Level: "warn", Layers: map[string]string{"cache": "debug"}})
+
+	_ = level.Info(logger).Log("layer", "service", "msg", "dropped")
+	_ = logger.Log("layer", "service", "msg", "no level counts as info")
+	_ = level.Warn(logger).Log("layer", "service", "msg", "kept")
+	_ = level.Debug(logger).Log("layer", "cache", "msg", "kept by its layer")
+
+	if len(*lines) != 2 {
+		t.Fatalf("got %d lines, want 2: %v", len(*lines), *lines)
+	}
+
+	if _, err := NewLogger(log.NewNopLogger(), Config{Level: "loud"}); err == nil {
+		t.Error("an unknown level was accepted")
+	}
+}
+
+func TestSuccessSample(t *testing.T) {
+	none := 0.0
+	logger, lines := capture(t, Config{SuccessSample: &none})
+
+	_ = level.Info(logger).Log("request_id", "1", "error", nil)
+	_ = level.Info(logger).Log("request_id", "1", "error", errors.New("failed"))
+	if len(*lines) != 1 {
+		t.Fatalf("got %d lines, want only the failure", len(*lines))
+	}
+
+	half := 0.5
+	logger, lines = capture(t, Config{SuccessSample: &half})
+	for i := 0; i < 3; i++ {
+		_ = level.Info(logger).Log("request_id", "abc", "error", nil)
+	}
+	if n := len(*lines); n != 0 && n != 3 {
+		t.Errorf("got %d of the 3 lines of a request, want all or none", n)
+	}
+}
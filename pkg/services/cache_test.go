@@ -0,0 +1,186 @@
+package services
+
+import (
+	"amadeus-go/pkg/instrumenting"
+	"amadeus-go/pkg/services/caching"
+
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+)
+
+// fakeService answers AirlineCodeLookup with whatever lookup returns, and
+// counts the calls that get to it. The other methods aren't implemented.
+type fakeService struct {
+	AmadeusService
+	calls  int32
+	lookup func(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error)
+}
+
+func (f *fakeService) AirlineCodeLookup(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+	atomic.AddInt32(&f.calls, 1)
+	return f.lookup(ctx, req)
+}
+
+func (f *fakeService) count() int32 {
+	return atomic.LoadInt32(&f.calls)
+}
+
+func airline(code string) *Response {
+	return &Response{Data: []*Data{{Type: "airline", Id: code}}}
+}
+
+func newTestUsage(quotas quotaConf) *usageTracker {
+	return &usageTracker{
+		store:  &memoryUsageStore{counters: make(map[string]map[string]int64)},
+		quotas: quotas,
+		logger: log.NewNopLogger(),
+	}
+}
+
+func newTestCache(next AmadeusService, policy cachePolicy) (cachemw, caching.Cache) {
+	cache := caching.NewLRUCache(10)
+	policies := map[string]cachePolicy{"AirlineCodeLookup": policy}
+	return cachemw{cache, policies, newTestUsage(quotaConf{}), instrumenting.DiscardMetrics(), log.NewNopLogger(), next, &sync.Map{}}, cache
+}
+
+func TestCacheKeyIgnoresCase(t *testing.T) {
+	lower, err := cacheKey("AirlineCodeLookup", &AirlineCodeLookupRequest{AirlineCodes: "ba"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	upper, _ := cacheKey("AirlineCodeLookup", &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+	other, _ := cacheKey("AirlineCodeLookup", &AirlineCodeLookupRequest{AirlineCodes: "AF"})
+	method, _ := cacheKey("FlightCheckInLinks", &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+
+	if lower != upper {
+		t.Errorf("ba and BA got keys %s and %s, want the same", lower, upper)
+	}
+	if lower == other || lower == method {
+		t.Error("different requests share a key")
+	}
+}
+
+func TestCachedResponses(t *testing.T) {
+	failure := &UnavailableError{&AmadeusError{Route: "AirlineCodeLookup", Cause: errors.New("down")}}
+
+	for _, tc := range []struct {
+		name      string
+		policy    cachePolicy
+		responses []*Response
+		errs      []error
+		// the calls that got to the service after each request
+		wantCalls []int32
+		wantErr   []bool
+	}{
+		{
+			name:      "no policy",
+			responses: []*Response{airline("BA"), airline("BA")},
+			errs:      []error{nil, nil},
+			wantCalls: []int32{1, 2},
+			wantErr:   []bool{false, false},
+		},
+		{
+			name:      "hit",
+			policy:    cachePolicy{ttl: time.Hour},
+			responses: []*Response{airline("BA"), nil, nil},
+			errs:      []error{nil, nil, nil},
+			wantCalls: []int32{1, 1, 1},
+			wantErr:   []bool{false, false, false},
+		},
+		{
+			name:      "errors aren't stored",
+			policy:    cachePolicy{ttl: time.Hour},
+			responses: []*Response{nil, airline("BA"), nil},
+			errs:      []error{failure, nil, nil},
+			wantCalls: []int32{1, 2, 2},
+			wantErr:   []bool{true, false, false},
+		},
+		{
+			name:   "responses listing errors aren't stored",
+			policy: cachePolicy{ttl: time.Hour},
+			responses: []*Response{
+				{Errors: []*ErrorWarning{{Title: "NOTHING FOUND"}}},
+				airline("BA"),
+			},
+			errs:      []error{nil, nil},
+			wantCalls: []int32{1, 2},
+			wantErr:   []bool{false, false},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var i int
+			sv := &fakeService{lookup: func(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+				return tc.responses[i], tc.errs[i]
+			}}
+			mw, _ := newTestCache(sv, tc.policy)
+
+			for i = range tc.wantCalls {
+				resp, err := mw.AirlineCodeLookup(context.Background(), &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+				if (err != nil) != tc.wantErr[i] {
+					t.Fatalf("request %d: got error %v", i, err)
+				}
+				if err == nil && resp == nil {
+					t.Fatalf("request %d: got no response", i)
+				}
+				if got := sv.count(); got != tc.wantCalls[i] {
+					t.Fatalf("request %d: %d calls got to the service, want %d", i, got, tc.wantCalls[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCacheHitsAccountedToTenant(t *testing.T) {
+	sv := &fakeService{lookup: func(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+		return airline("BA"), nil
+	}}
+	mw, _ := newTestCache(sv, cachePolicy{ttl: time.Hour})
+
+	for i := 0; i < 3; i++ {
+		if _, err := mw.AirlineCodeLookup(context.Background(), &AirlineCodeLookupRequest{AirlineCodes: "BA"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	usage, err := mw.usage.GetUsage(context.Background(), &GetUsageRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage.CacheHits != 2 {
+		t.Errorf("got %d cache hits, want 2", usage.CacheHits)
+	}
+}
+
+func TestCacheBackendFailureIsAMiss(t *testing.T) {
+	sv := &fakeService{lookup: func(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+		return airline("BA"), nil
+	}}
+	mw, _ := newTestCache(sv, cachePolicy{ttl: time.Hour})
+	mw.cache = brokenCache{}
+
+	for i := 0; i < 2; i++ {
+		if _, err := mw.AirlineCodeLookup(context.Background(), &AirlineCodeLookupRequest{AirlineCodes: "BA"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if sv.count() != 2 {
+		t.Errorf("%d calls got to the service, want 2", sv.count())
+	}
+}
+
+// brokenCache fails like an unreachable Redis.
+type brokenCache struct{}
+
+func (brokenCache) Get(key string) ([]byte, bool, error) {
+	return nil, false, errors.New("connection refused")
+}
+
+func (brokenCache) Set(key string, value []byte, ttl time.Duration) error {
+	return errors.New("connection refused")
+}
@@ -0,0 +1,12 @@
+package caching
+
+import (
+	"time"
+)
+
+// Cache stores opaque values for a limited time. A missing or expired key is
+// reported through the boolean, errors are reserved for backend failures.
+type Cache interface {
+	Get(key string) ([]byte, bool, error)
+	Set(key string, value []byte, ttl time.Duration) error
+}
@@ -0,0 +1,68 @@
+package caching
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUCache(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		size int
+		run  func(c Cache)
+		want map[string]string
+	}{
+		{
+			name: "stored",
+			size: 2,
+			run: func(c Cache) {
+				_ = c.Set("a", []byte("1"), time.Hour)
+			},
+			want: map[string]string{"a": "1", "b": ""},
+		},
+		{
+			name: "replaced",
+			size: 2,
+			run: func(c Cache) {
+				_ = c.Set("a", []byte("1"), time.Hour)
+				_ = c.Set("a", []byte("2"), time.Hour)
+			},
+			want: map[string]string{"a": "2"},
+		},
+		{
+			name: "expired",
+			size: 2,
+			run: func(c Cache) {
+				_ = c.Set("a", []byte("1"), -time.Second)
+				_ = c.Set("b", []byte("2"), time.Hour)
+			},
+			want: map[string]string{"a": "", "b": "2"},
+		},
+		{
+			name: "least recently used evicted",
+			size: 2,
+			run: func(c Cache) {
+				_ = c.Set("a", []byte("1"), time.Hour)
+				_ = c.Set("b", []byte("2"), time.Hour)
+				_, _, _ = c.Get("a")
+				_ = c.Set("c", []byte("3"), time.Hour)
+			},
+			want: map[string]string{"a": "1", "b": "", "c": "3"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewLRUCache(tc.size)
+			tc.run(c)
+
+			for key, want := range tc.want {
+				value, found, err := c.Get(key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if found != (want != "") || string(value) != want {
+					t.Errorf("%s: got %q (found %t), want %q", key, value, found, want)
+				}
+			}
+		})
+	}
+}
@@ -6,12 +6,21 @@ import (
 	"github.com/go-redis/redis"
 )
 
-func InitRedis() (redisClient *redis.Client, err error) {
+// releaseScript deletes a lock only if it still holds the value written by
+// whoever acquired it, so an expired lock taken over by someone else is safe.
+var releaseScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+func InitRedis(addr string, password string, db int) (redisClient *redis.Client, err error) {
 	redisClient = redis.NewClient(
 		&redis.Options{
-			Addr:     "redis-server:6379",
-			Password: "",
-			DB:       0,
+			Addr:     addr,
+			Password: password,
+			DB:       db,
 		},
 	)
 
@@ -38,10 +47,54 @@ func ReadFromRedis(redisClient *redis.Client, key string) (value string, ttl tim
 }
 
 func WriteToRedis(redisClient *redis.Client, key string, value string, ttl time.Duration) error {
-	_, err := redisClient.Set(key, value, time.Second*ttl).Result()
+	_, err := redisClient.Set(key, value, ttl).Result()
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// AcquireLock takes the lock named key for at most ttl, tagging it with owner.
+// It reports false, without an error, when someone else already holds it.
+func AcquireLock(redisClient *redis.Client, key string, owner string, ttl time.Duration) (bool, error) {
+	return redisClient.SetNX(key, owner, ttl).Result()
+}
+
+func ReleaseLock(redisClient *redis.Client, key string, owner string) error {
+	_, err := releaseScript.Run(redisClient, []string{key}, owner).Result()
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
+
+// redisCache is a Cache shared by every replica, keeping its keys under
+// prefix.
+type redisCache struct {
+	redisClient *redis.Client
+	prefix      string
+}
+
+func NewRedisCache(redisClient *redis.Client, prefix string) Cache {
+	return &redisCache{
+		redisClient: redisClient,
+		prefix:      prefix,
+	}
+}
+
+func (c *redisCache) Get(key string) ([]byte, bool, error) {
+	value, err := c.redisClient.Get(c.prefix + key).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
+
+func (c *redisCache) Set(key string, value []byte, ttl time.Duration) error {
+	return c.redisClient.Set(c.prefix+key, value, ttl).Err()
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"amadeus-go/pkg/instrumenting"
+	"amadeus-go/pkg/tracing"
+
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"reflect"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultUserAgent           = "amadeus-go"
+	defaultTimeout             = time.Second * 30
+	defaultMaxIdleConns        = 100
+	defaultMaxIdleConnsPerHost = 10
+	defaultIdleConnTimeout     = time.Second * 90
+
+	// methodOverrideHeader tells Amadeus a POST is really the GET it names,
+	// for the searches whose parameters only fit in a body
+	methodOverrideHeader = "X-HTTP-Method-Override"
+)
+
+// upstreamClient is the one HTTP client every call to Amadeus goes through,
+// so connections are pooled across requests and the caller's context decides
+// when an upstream call has to be given up on.
+type upstreamClient struct {
+	httpClient *http.Client
+	tokens     *tokenManager
+	retry      retryPolicy
+	limiter    *rateLimiter
+	metrics    *instrumenting.Metrics
+	tracer     trace.Tracer
+	userAgent  string
+	timeout    time.Duration
+	timeouts   map[string]time.Duration
+}
+
+type upstreamConf struct {
+	UserAgent           string              `json:"USER_AGENT"`
+	Timeout             duration            `json:"TIMEOUT"`
+	EndpointTimeouts    map[string]duration `json:"ENDPOINT_TIMEOUTS"`
+	MaxIdleConns        int                 `json:"MAX_IDLE_CONNS"`
+	MaxIdleConnsPerHost int                 `json:"MAX_IDLE_CONNS_PER_HOST"`
+	IdleConnTimeout     duration            `json:"IDLE_CONN_TIMEOUT"`
+	Retry               retryConf           `json:"RETRY"`
+	RateLimit           rateLimitConf       `json:"RATE_LIMIT"`
+}
+
+func newUpstreamClient(configFilename string) (*upstreamClient, error) {
+	var conf struct {
+		Upstream upstreamConf `json:"UPSTREAM"`
+	}
+
+	err := readConf(configFilename, &conf)
+	if err != nil {
+		return nil, err
+	}
+	c := conf.Upstream
+
+	if c.UserAgent == "" {
+		c.UserAgent = defaultUserAgent
+	}
+	if c.Timeout == 0 {
+		c.Timeout = duration(defaultTimeout)
+	}
+	if c.MaxIdleConns == 0 {
+		c.MaxIdleConns = defaultMaxIdleConns
+	}
+	if c.MaxIdleConnsPerHost == 0 {
+		c.MaxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	}
+	if c.IdleConnTimeout == 0 {
+		c.IdleConnTimeout = duration(defaultIdleConnTimeout)
+	}
+
+	timeouts := make(map[string]time.Duration)
+	for route, t := range c.EndpointTimeouts {
+		timeouts[route] = time.Duration(t)
+	}
+
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   time.Second * 10,
+			KeepAlive: time.Second * 30,
+		}).DialContext,
+		MaxIdleConns:          c.MaxIdleConns,
+		MaxIdleConnsPerHost:   c.MaxIdleConnsPerHost,
+		IdleConnTimeout:       time.Duration(c.IdleConnTimeout),
+		TLSHandshakeTimeout:   time.Second * 10,
+		ExpectContinueTimeout: time.Second * 1,
+	}
+
+	return &upstreamClient{
+		httpClient: &http.Client{Transport: transport},
+		retry:      newRetryPolicy(c.Retry),
+		limiter:    newRateLimiter(c.RateLimit),
+		userAgent:  c.UserAgent,
+		timeout:    time.Duration(c.Timeout),
+		timeouts:   timeouts,
+	}, nil
+}
+
+// do sends req on behalf of route (the name of the service method, which is
+// also the key of ENDPOINT_TIMEOUTS), authorised with the current token, and
+// decodes the JSON body into out, unless out is nil. Every attempt is subject to the rate limit,
+// and failed ones are retried as the retry policy allows, within whatever
+// deadline ctx has. Failures are returned as the typed errors of errors.go.
+func (c *upstreamClient) do(ctx context.Context, route string, req *http.Request, out interface{}) error {
+	err := c.send(ctx, route, req, out)
+	if err != nil {
+		return typedError(ctx, route, err)
+	}
+
+	// out points at the response, which Amadeus may have put errors in
+	// despite answering with a successful status
+	if out == nil {
+		return nil
+	}
+	resp := reflect.ValueOf(out).Elem()
+	if resp.Kind() != reflect.Ptr || resp.IsNil() {
+		return nil
+	}
+	if list, ok := resp.Interface().(errorLister); ok {
+		return responseError(route, list.errorList())
+	}
+	return nil
+}
+
+// errorLister is implemented by the responses that list errors.
+type errorLister interface {
+	errorList() []*ErrorWarning
+}
+
+func (c *upstreamClient) send(ctx context.Context, route string, req *http.Request, out interface{}) error {
+	var err error
+	refreshed := false
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			wait := c.retry.delay(attempt, err)
+			if !fitsDeadline(ctx, wait) {
+				return err
+			}
+
+			select {
+			case <-time.After(wait):
+			case <-ctx.Done():
+				return err
+			}
+
+			if req.GetBody != nil {
+				req.Body, err = req.GetBody()
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		err = c.limiter.wait(ctx, route)
+		if err != nil {
+			return err
+		}
+
+		err = c.attempt(ctx, route, req, out)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+
+		switch classify(err) {
+		case classUnauthorized:
+			// the token was revoked or expired early: fetch a new one and
+			// try again straight away, but only once
+			if refreshed {
+				return err
+			}
+			refreshed = true
+			c.tokens.invalidate()
+		case classNetwork, classServer, classRateLimited:
+			if !c.retry.retryable(req) || attempt+1 >= c.retry.maxAttempts {
+				return err
+			}
+		default:
+			return err
+		}
+	}
+}
+
+// attempt makes a single call, in a span of its own that carries the route
+// and the HTTP status Amadeus answered with. The body is read before
+// returning so the per-route timeout can cover it.
+func (c *upstreamClient) attempt(ctx context.Context, route string, req *http.Request, out interface{}) (err error) {
+	ctx, span := c.tracer.Start(ctx, "amadeus."+route,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("amadeus.route", route),
+			semconv.HTTPMethod(req.Method),
+		),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeoutFor(route))
+	defer cancel()
+
+	bearer, err := c.tokens.bearer(ctx)
+	if err != nil {
+		return &tokenError{err}
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", bearer)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	tracing.Propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	markSent(ctx)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.metrics.ObserveUpstream(route, 0)
+		return err
+	}
+	defer resp.Body.Close()
+	c.metrics.ObserveUpstream(route, resp.StatusCode)
+	span.SetAttributes(semconv.HTTPStatusCode(resp.StatusCode))
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		uErr := &upstreamError{
+			Route:      route,
+			StatusCode: resp.StatusCode,
+			retryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+		// Amadeus explains most failures in the usual errors list
+		var body Response
+		if json.Unmarshal(b, &body) == nil {
+			uErr.Body = &body
+		}
+		return uErr
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
+
+func (c *upstreamClient) timeoutFor(route string) time.Duration {
+	if t, ok := c.timeouts[route]; ok {
+		return t
+	}
+	return c.timeout
+}
+
+// budget is the longest a call to route may take: every attempt running out
+// of time, with the longest wait between them.
+func (c *upstreamClient) budget(route string) time.Duration {
+	attempts := time.Duration(c.retry.maxAttempts)
+	return c.timeoutFor(route)*attempts + c.retry.max*(attempts-1)
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"golang.org/x/sync/singleflight"
+)
+
+func newTestCoalescing(next AmadeusService, timeout time.Duration) coalescemw {
+	return coalescemw{newTestUsage(quotaConf{}), func(string) time.Duration { return timeout }, log.NewNopLogger(), next, &singleflight.Group{}}
+}
+
+// blockedService holds every call until release is closed, telling started
+// about each one as it arrives.
+func blockedService() (*fakeService, chan struct{}, chan struct{}) {
+	started, release := make(chan struct{}, 10), make(chan struct{})
+	sv := &fakeService{lookup: func(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+		started <- struct{}{}
+		select {
+		case <-release:
+			return airline(req.AirlineCodes), nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}}
+	return sv, started, release
+}
+
+func TestCoalescedCalls(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		codes     []string
+		wantCalls int32
+	}{
+		{"identical", []string{"BA", "BA", "ba", "BA"}, 1},
+		{"different", []string{"BA", "AF", "LH"}, 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sv, started, release := blockedService()
+			mw := newTestCoalescing(sv, time.Second)
+
+			var wg sync.WaitGroup
+			errs := make(chan error, len(tc.codes))
+			call := func(code string) {
+				defer wg.Done()
+				resp, err := mw.AirlineCodeLookup(context.Background(), &AirlineCodeLookupRequest{AirlineCodes: code})
+				if err == nil && len(resp.Data) != 1 {
+					t.Errorf("%s: got %d airlines", code, len(resp.Data))
+				}
+				errs <- err
+			}
+
+			// the first call is in flight before the others arrive
+			wg.Add(1)
+			go call(tc.codes[0])
+			<-started
+			for _, code := range tc.codes[1:] {
+				wg.Add(1)
+				go call(code)
+			}
+
+			time.Sleep(time.Millisecond * 50)
+			close(release)
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				if err != nil {
+					t.Error(err)
+				}
+			}
+			if got := sv.count(); got != tc.wantCalls {
+				t.Errorf("%d calls got to the service, want %d", got, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCoalescedFollowerGivesUp(t *testing.T) {
+	sv, started, release := blockedService()
+	mw := newTestCoalescing(sv, time.Second)
+
+	leader := make(chan error, 1)
+	go func() {
+		_, err := mw.AirlineCodeLookup(context.Background(), &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+		leader <- err
+	}()
+	<-started
+
+	// a follower that gives up returns at once, without a call of its own
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := mw.AirlineCodeLookup(ctx, &AirlineCodeLookupRequest{AirlineCodes: "BA"}); err != context.Canceled {
+		t.Fatalf("the follower got %v, want it to give up", err)
+	}
+
+	close(release)
+	if err := <-leader; err != nil {
+		t.Fatalf("the leader got %v", err)
+	}
+	if sv.count() != 1 {
+		t.Errorf("%d calls got to the service, want 1", sv.count())
+	}
+}
+
+func TestSharedCallOutlivesLeader(t *testing.T) {
+	sv, started, release := blockedService()
+	mw := newTestCoalescing(sv, time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leader := make(chan error, 1)
+	go func() {
+		_, err := mw.AirlineCodeLookup(ctx, &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+		leader <- err
+	}()
+	<-started
+
+	follower := make(chan error, 1)
+	go func() {
+		_, err := mw.AirlineCodeLookup(context.Background(), &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+		follower <- err
+	}()
+
+	cancel()
+	if err := <-leader; err != context.Canceled {
+		t.Fatalf("the leader got %v, want it to give up", err)
+	}
+
+	close(release)
+	if err := <-follower; err != nil {
+		t.Fatalf("the follower got %v", err)
+	}
+}
+
+func TestSharedCallTimesOut(t *testing.T) {
+	sv, _, _ := blockedService()
+	mw := newTestCoalescing(sv, time.Millisecond*50)
+
+	// the caller doesn't set a deadline, the shared call still has one
+	done := make(chan error, 1)
+	go func() {
+		_, err := mw.AirlineCodeLookup(context.Background(), &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != context.DeadlineExceeded {
+			t.Fatalf("got %v, want the shared call to time out", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the shared call never timed out")
+	}
+}
@@ -1,5 +1,7 @@
 package services
 
+import "fmt"
+
 // ==================================== RPC ====================================
 type Response struct {
 	Data         []*Data         `json:"data"`
@@ -9,6 +11,16 @@ type Response struct {
 	Errors       []*ErrorWarning `json:"errors"`
 }
 
+// LogSummary stands in for a response in the logs, where the whole of it
+// could take hundreds of KB.
+func (r *Response) LogSummary() string {
+	return fmt.Sprintf("data=%d warnings=%d errors=%d", len(r.Data), len(r.Warnings), len(r.Errors))
+}
+
+func (r *Response) errorList() []*ErrorWarning {
+	return r.Errors
+}
+
 type FlightLowFareSearchRequest struct {
 	Origin        string
 	Destination   string
@@ -75,6 +87,199 @@ type AirlineCodeLookupRequest struct {
 	AirlineCodes string
 }
 
+// FlightOffersSearchRequest searches the flight offers of a round or one-way
+// trip, or of the trip made of OriginDestinations when there are any.
+type FlightOffersSearchRequest struct {
+	OriginLocationCode      string               `json:"originLocationCode"`
+	DestinationLocationCode string               `json:"destinationLocationCode"`
+	DepartureDate           string               `json:"departureDate"`
+	ReturnDate              string               `json:"returnDate"`
+	Adults                  int32                `json:"adults"`
+	Children                int32                `json:"children"`
+	Infants                 int32                `json:"infants"`
+	TravelClass             string               `json:"travelClass"`
+	IncludedAirlineCodes    []string             `json:"includedAirlineCodes"`
+	ExcludedAirlineCodes    []string             `json:"excludedAirlineCodes"`
+	NonStop                 bool                 `json:"nonStop"`
+	CurrencyCode            string               `json:"currencyCode"`
+	MaxPrice                int32                `json:"maxPrice"`
+	Max                     int32                `json:"max"`
+	OriginDestinations      []*OriginDestination `json:"originDestinations"`
+}
+
+// OriginDestination is one leg of a trip; DepartureTime narrows the search
+// around a time of the day, e.g. "10:00:00".
+type OriginDestination struct {
+	Id                      string `json:"id"`
+	OriginLocationCode      string `json:"originLocationCode"`
+	DestinationLocationCode string `json:"destinationLocationCode"`
+	DepartureDate           string `json:"departureDate"`
+	DepartureTime           string `json:"departureTime"`
+}
+
+// FlightOffersResponse is what the v2 flight offers APIs answer with.
+type FlightOffersResponse struct {
+	Data         []*FlightOffer     `json:"data"`
+	Dictionaries *OfferDictionaries `json:"dictionaries"`
+	Meta         *Meta              `json:"meta"`
+	Warnings     []*ErrorWarning    `json:"warnings"`
+	Errors       []*ErrorWarning    `json:"errors"`
+}
+
+// LogSummary stands in for the offers in the logs.
+func (r *FlightOffersResponse) LogSummary() string {
+	return fmt.Sprintf("offers=%d warnings=%d errors=%d", len(r.Data), len(r.Warnings), len(r.Errors))
+}
+
+func (r *FlightOffersResponse) errorList() []*ErrorWarning {
+	return r.Errors
+}
+
+// FlightOffersPriceRequest confirms the price of offers FlightOffersSearch
+// returned. Include asks for more: "bags", "detailed-fare-rules" and
+// "credit-card-fees".
+type FlightOffersPriceRequest struct {
+	FlightOffers []*FlightOffer `json:"flightOffers"`
+	Include      []string       `json:"include"`
+}
+
+// FlightOffersPriceResponse is the offers at their confirmed price. A price
+// that changed since the search shows up in Warnings.
+type FlightOffersPriceResponse struct {
+	Data         *FlightOffersPricing `json:"data"`
+	Included     *PricingIncluded     `json:"included"`
+	Dictionaries *OfferDictionaries   `json:"dictionaries"`
+	Warnings     []*ErrorWarning      `json:"warnings"`
+	Errors       []*ErrorWarning      `json:"errors"`
+}
+
+// LogSummary stands in for the priced offers in the logs.
+func (r *FlightOffersPriceResponse) LogSummary() string {
+	offers := 0
+	if r.Data != nil {
+		offers = len(r.Data.FlightOffers)
+	}
+	return fmt.Sprintf("offers=%d warnings=%d errors=%d", offers, len(r.Warnings), len(r.Errors))
+}
+
+func (r *FlightOffersPriceResponse) errorList() []*ErrorWarning {
+	return r.Errors
+}
+
+// CreateFlightOrderRequest books offers FlightOffersPrice confirmed. A retry
+// with the same IdempotencyKey gets back the order the first call made
+// rather than booking again.
+type CreateFlightOrderRequest struct {
+	FlightOffers   []*FlightOffer `json:"flightOffers"`
+	Travelers      []*Traveler    `json:"travelers"`
+	Contacts       []*Contact     `json:"contacts"`
+	IdempotencyKey string         `json:"idempotencyKey"`
+}
+
+// LogSummary keeps the names and documents of the travelers out of the logs.
+func (r *CreateFlightOrderRequest) LogSummary() string {
+	return fmt.Sprintf("offers=%d travelers=%d idempotency_key=%s", len(r.FlightOffers), len(r.Travelers), r.IdempotencyKey)
+}
+
+// PersonalData marks the request as one never to log whole.
+func (r *CreateFlightOrderRequest) PersonalData() {}
+
+type GetFlightOrderRequest struct {
+	FlightOrderId string `json:"flightOrderId"`
+}
+
+type CancelFlightOrderRequest struct {
+	FlightOrderId string `json:"flightOrderId"`
+}
+
+type FlightOrderResponse struct {
+	Data         *FlightOrder       `json:"data"`
+	Dictionaries *OfferDictionaries `json:"dictionaries"`
+	Warnings     []*ErrorWarning    `json:"warnings"`
+	Errors       []*ErrorWarning    `json:"errors"`
+}
+
+// LogSummary keeps the names and documents of the travelers out of the logs.
+func (r *FlightOrderResponse) LogSummary() string {
+	id := ""
+	if r.Data != nil {
+		id = r.Data.Id
+	}
+	return fmt.Sprintf("order=%s warnings=%d errors=%d", id, len(r.Warnings), len(r.Errors))
+}
+
+func (r *FlightOrderResponse) errorList() []*ErrorWarning {
+	return r.Errors
+}
+
+// PersonalData marks the response as one never to log whole.
+func (r *FlightOrderResponse) PersonalData() {}
+
+// CancelFlightOrderResponse names the order that was cancelled; Amadeus
+// answers a cancellation with no body.
+type CancelFlightOrderResponse struct {
+	FlightOrderId string `json:"flightOrderId"`
+}
+
+// SeatMapDisplayRequest asks for the seat maps of either FlightOffers, as
+// FlightOffersSearch or FlightOffersPrice returned them, or of the booking
+// FlightOrderId.
+type SeatMapDisplayRequest struct {
+	FlightOffers  []*FlightOffer `json:"flightOffers"`
+	FlightOrderId string         `json:"flightOrderId"`
+}
+
+type SeatMapResponse struct {
+	Data         []*SeatMap           `json:"data"`
+	Dictionaries *SeatMapDictionaries `json:"dictionaries"`
+	Meta         *Meta                `json:"meta"`
+	Warnings     []*ErrorWarning      `json:"warnings"`
+	Errors       []*ErrorWarning      `json:"errors"`
+}
+
+// LogSummary stands in for the seat maps in the logs.
+func (r *SeatMapResponse) LogSummary() string {
+	return fmt.Sprintf("seatmaps=%d warnings=%d errors=%d", len(r.Data), len(r.Warnings), len(r.Errors))
+}
+
+func (r *SeatMapResponse) errorList() []*ErrorWarning {
+	return r.Errors
+}
+
+// BrandedFaresUpsellRequest asks for the fare families offers
+// FlightOffersSearch returned could be upgraded to. The answer is a
+// FlightOffersResponse with an offer per fare family.
+type BrandedFaresUpsellRequest struct {
+	FlightOffers []*FlightOffer `json:"flightOffers"`
+}
+
+// FlightAvailabilitiesSearchRequest searches the seats left in each booking
+// class of the flights of OriginDestinations, for Adults and Children.
+type FlightAvailabilitiesSearchRequest struct {
+	OriginDestinations   []*OriginDestination `json:"originDestinations"`
+	Adults               int32                `json:"adults"`
+	Children             int32                `json:"children"`
+	IncludedAirlineCodes []string             `json:"includedAirlineCodes"`
+	ExcludedAirlineCodes []string             `json:"excludedAirlineCodes"`
+}
+
+type FlightAvailabilitiesResponse struct {
+	Data         []*FlightAvailability `json:"data"`
+	Dictionaries *OfferDictionaries    `json:"dictionaries"`
+	Meta         *Meta                 `json:"meta"`
+	Warnings     []*ErrorWarning       `json:"warnings"`
+	Errors       []*ErrorWarning       `json:"errors"`
+}
+
+// LogSummary stands in for the availabilities in the logs.
+func (r *FlightAvailabilitiesResponse) LogSummary() string {
+	return fmt.Sprintf("availabilities=%d warnings=%d errors=%d", len(r.Data), len(r.Warnings), len(r.Errors))
+}
+
+func (r *FlightAvailabilitiesResponse) errorList() []*ErrorWarning {
+	return r.Errors
+}
+
 // ============================== Data Structures ==============================
 type Data struct {
 	Type           string                  `json:"type"`
@@ -97,7 +302,7 @@ type Data struct {
 	ReturnDate     string                  `json:"returnDate"`
 	Price          *Price                  `json:"price"`
 	Links          *Links                  `json:"links"`
-	Self           *Self                   `json:"links"`
+	Self           *Self                   `json:"self"`
 	Href           string                  `json:"href"`
 	Channel        string                  `json:"channel"`
 	Parameters     map[string]*ParamDetail `json:"parameters"`
@@ -143,7 +348,7 @@ type Aircraft struct {
 
 type Operating struct {
 	CarrierCode string `json:"carrierCode"`
-	Number      string `json:"number"`
+	Number      string `json:"number,omitempty"`
 }
 
 type PricingDetailPerAdult struct {
@@ -229,7 +434,7 @@ type ErrorWarning struct {
 	Status int32   `json:"status"`
 	Code   int32   `json:"code"`
 	Title  string  `json:"title"`
-	Detail string  `json:"title"`
+	Detail string  `json:"detail"`
 	Source *Source `json:"source"`
 }
 
@@ -249,3 +454,385 @@ type ParamDetail struct {
 	Type        string `json:"type"`
 	Format      string `json:"format"`
 }
+
+// ================================ Flight Offers ===============================
+// The offer model of the v2 APIs. Offers are sent back to Amadeus as they
+// came to price and book them, so the JSON names are those of Amadeus.
+type FlightOffer struct {
+	Type                     string             `json:"type"`
+	Id                       string             `json:"id"`
+	Source                   string             `json:"source"`
+	InstantTicketingRequired bool               `json:"instantTicketingRequired"`
+	NonHomogeneous           bool               `json:"nonHomogeneous"`
+	OneWay                   bool               `json:"oneWay"`
+	LastTicketingDate        string             `json:"lastTicketingDate,omitempty"`
+	NumberOfBookableSeats    int32              `json:"numberOfBookableSeats,omitempty"`
+	Itineraries              []*Itinerary       `json:"itineraries"`
+	Price                    *OfferPrice        `json:"price"`
+	PricingOptions           *PricingOptions    `json:"pricingOptions,omitempty"`
+	ValidatingAirlineCodes   []string           `json:"validatingAirlineCodes"`
+	TravelerPricings         []*TravelerPricing `json:"travelerPricings"`
+}
+
+type Itinerary struct {
+	Duration string          `json:"duration,omitempty"`
+	Segments []*OfferSegment `json:"segments"`
+}
+
+type OfferSegment struct {
+	Departure       *FlightEndPoint `json:"departure"`
+	Arrival         *FlightEndPoint `json:"arrival"`
+	CarrierCode     string          `json:"carrierCode"`
+	Number          string          `json:"number"`
+	Aircraft        *Aircraft       `json:"aircraft,omitempty"`
+	Operating       *Operating      `json:"operating,omitempty"`
+	Duration        string          `json:"duration,omitempty"`
+	Id              string          `json:"id"`
+	NumberOfStops   int32           `json:"numberOfStops"`
+	BlacklistedInEU bool            `json:"blacklistedInEU"`
+}
+
+type FlightEndPoint struct {
+	IataCode string `json:"iataCode"`
+	Terminal string `json:"terminal,omitempty"`
+	At       string `json:"at"`
+}
+
+type OfferPrice struct {
+	Currency           string `json:"currency"`
+	Total              string `json:"total"`
+	Base               string `json:"base"`
+	Fees               []*Fee `json:"fees,omitempty"`
+	GrandTotal         string `json:"grandTotal,omitempty"`
+	Taxes              []*Tax `json:"taxes,omitempty"`
+	RefundableTaxes    string `json:"refundableTaxes,omitempty"`
+	AdditionalServices []*Fee `json:"additionalServices,omitempty"`
+}
+
+type Tax struct {
+	Amount string `json:"amount"`
+	Code   string `json:"code"`
+}
+
+type Fee struct {
+	Amount string `json:"amount"`
+	Type   string `json:"type"`
+}
+
+type PricingOptions struct {
+	FareType                []string `json:"fareType,omitempty"`
+	IncludedCheckedBagsOnly bool     `json:"includedCheckedBagsOnly"`
+}
+
+type TravelerPricing struct {
+	TravelerId           string                  `json:"travelerId"`
+	FareOption           string                  `json:"fareOption"`
+	TravelerType         string                  `json:"travelerType"`
+	AssociatedAdultId    string                  `json:"associatedAdultId,omitempty"`
+	Price                *OfferPrice             `json:"price"`
+	FareDetailsBySegment []*FareDetailsBySegment `json:"fareDetailsBySegment"`
+}
+
+type FareDetailsBySegment struct {
+	SegmentId           string            `json:"segmentId"`
+	Cabin               string            `json:"cabin,omitempty"`
+	FareBasis           string            `json:"fareBasis,omitempty"`
+	BrandedFare         string            `json:"brandedFare,omitempty"`
+	Class               string            `json:"class,omitempty"`
+	IncludedCheckedBags *BaggageAllowance `json:"includedCheckedBags,omitempty"`
+	BrandedFareLabel    string            `json:"brandedFareLabel,omitempty"`
+	Amenities           []*Amenity        `json:"amenities,omitempty"`
+}
+
+// Amenity is a service of a fare family, such as a checked bag, a meal or a
+// change of date, and whether it costs extra.
+type Amenity struct {
+	Description     string           `json:"description"`
+	IsChargeable    bool             `json:"isChargeable"`
+	AmenityType     string           `json:"amenityType,omitempty"`
+	AmenityProvider *AmenityProvider `json:"amenityProvider,omitempty"`
+}
+
+type AmenityProvider struct {
+	Name string `json:"name"`
+}
+
+type BaggageAllowance struct {
+	Quantity   int32  `json:"quantity,omitempty"`
+	Weight     int32  `json:"weight,omitempty"`
+	WeightUnit string `json:"weightUnit,omitempty"`
+}
+
+type OfferDictionaries struct {
+	Locations  map[string]*LocationValue `json:"locations"`
+	Aircraft   map[string]string         `json:"aircraft"`
+	Currencies map[string]string         `json:"currencies"`
+	Carriers   map[string]string         `json:"carriers"`
+}
+
+type LocationValue struct {
+	CityCode    string `json:"cityCode"`
+	CountryCode string `json:"countryCode"`
+}
+
+// ================================ Flight Pricing ===============================
+type FlightOffersPricing struct {
+	Type                string               `json:"type"`
+	FlightOffers        []*FlightOffer       `json:"flightOffers"`
+	BookingRequirements *BookingRequirements `json:"bookingRequirements"`
+}
+
+// BookingRequirements are what the airlines need to know of the travelers to
+// book the offers.
+type BookingRequirements struct {
+	EmailAddressRequired      bool                    `json:"emailAddressRequired"`
+	MobilePhoneNumberRequired bool                    `json:"mobilePhoneNumberRequired"`
+	InvoiceAddressRequired    bool                    `json:"invoiceAddressRequired"`
+	MailingAddressRequired    bool                    `json:"mailingAddressRequired"`
+	TravelerRequirements      []*TravelerRequirements `json:"travelerRequirements"`
+}
+
+type TravelerRequirements struct {
+	TravelerId                   string `json:"travelerId"`
+	GenderRequired               bool   `json:"genderRequired"`
+	DocumentRequired             bool   `json:"documentRequired"`
+	DocumentIssuanceCityRequired bool   `json:"documentIssuanceCityRequired"`
+	DateOfBirthRequired          bool   `json:"dateOfBirthRequired"`
+	RedressRequiredIfAny         bool   `json:"redressRequiredIfAny"`
+	ResidenceRequired            bool   `json:"residenceRequired"`
+}
+
+// PricingIncluded holds what the request asked to include, by ID.
+type PricingIncluded struct {
+	CreditCardFees    map[string]*CreditCardFee     `json:"credit-card-fees"`
+	Bags              map[string]*BagOffer          `json:"bags"`
+	DetailedFareRules map[string]*DetailedFareRules `json:"detailed-fare-rules"`
+}
+
+type CreditCardFee struct {
+	Brand         string `json:"brand"`
+	Amount        string `json:"amount"`
+	Currency      string `json:"currency"`
+	FlightOfferId string `json:"flightOfferId"`
+}
+
+// BagOffer is a bag that can be added to an offer at Price.
+type BagOffer struct {
+	Quantity            int32     `json:"quantity"`
+	Weight              int32     `json:"weight"`
+	WeightUnit          string    `json:"weightUnit"`
+	Name                string    `json:"name"`
+	Price               *BagPrice `json:"price"`
+	BookableByItinerary bool      `json:"bookableByItinerary"`
+	SegmentIds          []string  `json:"segmentIds"`
+	TravelerIds         []string  `json:"travelerIds"`
+}
+
+type BagPrice struct {
+	Amount       string `json:"amount"`
+	CurrencyCode string `json:"currencyCode"`
+}
+
+type DetailedFareRules struct {
+	FareBasis string     `json:"fareBasis"`
+	Name      string     `json:"name"`
+	SegmentId string     `json:"segmentId"`
+	FareNotes *FareNotes `json:"fareNotes"`
+}
+
+type FareNotes struct {
+	Descriptions []*FareDescription `json:"descriptions"`
+}
+
+type FareDescription struct {
+	DescriptionType string `json:"descriptionType"`
+	Text            string `json:"text"`
+}
+
+// ================================ Flight Orders ================================
+type FlightOrder struct {
+	Type              string              `json:"type"`
+	Id                string              `json:"id,omitempty"`
+	QueuingOfficeId   string              `json:"queuingOfficeId,omitempty"`
+	AssociatedRecords []*AssociatedRecord `json:"associatedRecords,omitempty"`
+	FlightOffers      []*FlightOffer      `json:"flightOffers"`
+	Travelers         []*Traveler         `json:"travelers"`
+	Contacts          []*Contact          `json:"contacts,omitempty"`
+}
+
+// AssociatedRecord is the booking in one of the systems involved; Reference
+// is its record locator.
+type AssociatedRecord struct {
+	Reference        string `json:"reference"`
+	CreationDate     string `json:"creationDate"`
+	OriginSystemCode string `json:"originSystemCode"`
+	FlightOfferId    string `json:"flightOfferId"`
+}
+
+// Traveler is one of the people flying. Id is the travelerId of the
+// travelerPricings of the offers.
+type Traveler struct {
+	Id          string              `json:"id"`
+	DateOfBirth string              `json:"dateOfBirth"`
+	Gender      string              `json:"gender,omitempty"`
+	Name        *TravelerName       `json:"name"`
+	Contact     *Contact            `json:"contact,omitempty"`
+	Documents   []*TravelerDocument `json:"documents,omitempty"`
+}
+
+type TravelerName struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+type Contact struct {
+	AddresseeName *TravelerName  `json:"addresseeName,omitempty"`
+	CompanyName   string         `json:"companyName,omitempty"`
+	Purpose       string         `json:"purpose,omitempty"`
+	Phones        []*Phone       `json:"phones,omitempty"`
+	EmailAddress  string         `json:"emailAddress,omitempty"`
+	Address       *PostalAddress `json:"address,omitempty"`
+}
+
+type Phone struct {
+	DeviceType         string `json:"deviceType,omitempty"`
+	CountryCallingCode string `json:"countryCallingCode"`
+	Number             string `json:"number"`
+}
+
+type TravelerDocument struct {
+	DocumentType     string `json:"documentType"`
+	Number           string `json:"number"`
+	ExpiryDate       string `json:"expiryDate,omitempty"`
+	IssuanceCountry  string `json:"issuanceCountry,omitempty"`
+	IssuanceDate     string `json:"issuanceDate,omitempty"`
+	IssuanceLocation string `json:"issuanceLocation,omitempty"`
+	Nationality      string `json:"nationality,omitempty"`
+	BirthPlace       string `json:"birthPlace,omitempty"`
+	ValidityCountry  string `json:"validityCountry,omitempty"`
+	Holder           bool   `json:"holder"`
+}
+
+type PostalAddress struct {
+	Lines       []string `json:"lines"`
+	PostalCode  string   `json:"postalCode"`
+	CityName    string   `json:"cityName"`
+	CountryCode string   `json:"countryCode"`
+}
+
+// =================================== Seat Maps =================================
+// SeatMap is the seat map of one segment of an offer or order.
+type SeatMap struct {
+	Type                   string                   `json:"type"`
+	Id                     string                   `json:"id"`
+	Departure              *FlightEndPoint          `json:"departure"`
+	Arrival                *FlightEndPoint          `json:"arrival"`
+	CarrierCode            string                   `json:"carrierCode"`
+	Number                 string                   `json:"number"`
+	Operating              *Operating               `json:"operating"`
+	Aircraft               *Aircraft                `json:"aircraft"`
+	Class                  string                   `json:"class"`
+	FlightOfferId          string                   `json:"flightOfferId"`
+	SegmentId              string                   `json:"segmentId"`
+	Decks                  []*Deck                  `json:"decks"`
+	AvailableSeatsCounters []*AvailableSeatsCounter `json:"availableSeatsCounters"`
+}
+
+type Deck struct {
+	DeckType          string             `json:"deckType"`
+	DeckConfiguration *DeckConfiguration `json:"deckConfiguration"`
+	Facilities        []*Facility        `json:"facilities"`
+	Seats             []*Seat            `json:"seats"`
+}
+
+// DeckConfiguration is the size of a deck in the coordinates of its seats, X
+// running along the rows and Y across them.
+type DeckConfiguration struct {
+	Width         int32   `json:"width"`
+	Length        int32   `json:"length"`
+	StartSeatRow  int32   `json:"startSeatRow"`
+	EndSeatRow    int32   `json:"endSeatRow"`
+	StartWingsX   int32   `json:"startWingsX"`
+	EndWingsX     int32   `json:"endWingsX"`
+	StartWingsRow int32   `json:"startWingsRow"`
+	EndWingsRow   int32   `json:"endWingsRow"`
+	ExitRowsX     []int32 `json:"exitRowsX"`
+}
+
+// Facility is what isn't a seat: lavatories, galleys, closets and the like.
+type Facility struct {
+	Code        string       `json:"code"`
+	Column      string       `json:"column"`
+	Row         string       `json:"row"`
+	Position    string       `json:"position"`
+	Coordinates *Coordinates `json:"coordinates"`
+}
+
+// Seat is a seat and what it is for each traveler; its CharacteristicsCodes
+// are explained in the seatCharacteristic dictionary.
+type Seat struct {
+	Cabin                string                 `json:"cabin"`
+	Number               string                 `json:"number"`
+	CharacteristicsCodes []string               `json:"characteristicsCodes"`
+	TravelerPricing      []*SeatTravelerPricing `json:"travelerPricing"`
+	Coordinates          *Coordinates           `json:"coordinates"`
+}
+
+// SeatTravelerPricing tells whether a traveler can take a seat, AVAILABLE,
+// BLOCKED or OCCUPIED, and at what price.
+type SeatTravelerPricing struct {
+	TravelerId             string      `json:"travelerId"`
+	SeatAvailabilityStatus string      `json:"seatAvailabilityStatus"`
+	Price                  *OfferPrice `json:"price"`
+}
+
+type Coordinates struct {
+	X int32 `json:"x"`
+	Y int32 `json:"y"`
+}
+
+type AvailableSeatsCounter struct {
+	TravelerId string `json:"travelerId"`
+	Value      int32  `json:"value"`
+}
+
+type SeatMapDictionaries struct {
+	Locations          map[string]*LocationValue `json:"locations"`
+	Facility           map[string]string         `json:"facility"`
+	SeatCharacteristic map[string]string         `json:"seatCharacteristic"`
+}
+
+// ============================ Flight Availabilities ============================
+// FlightAvailability is one way of flying one of the origin-destinations
+// searched for.
+type FlightAvailability struct {
+	Type                     string                 `json:"type"`
+	Id                       string                 `json:"id"`
+	OriginDestinationId      string                 `json:"originDestinationId"`
+	Source                   string                 `json:"source"`
+	InstantTicketingRequired bool                   `json:"instantTicketingRequired"`
+	PaymentCardRequired      bool                   `json:"paymentCardRequired"`
+	Duration                 string                 `json:"duration"`
+	Segments                 []*AvailabilitySegment `json:"segments"`
+}
+
+type AvailabilitySegment struct {
+	Id                  string               `json:"id"`
+	NumberOfStops       int32                `json:"numberOfStops"`
+	BlacklistedInEU     bool                 `json:"blacklistedInEU"`
+	Departure           *FlightEndPoint      `json:"departure"`
+	Arrival             *FlightEndPoint      `json:"arrival"`
+	CarrierCode         string               `json:"carrierCode"`
+	Number              string               `json:"number"`
+	Aircraft            *Aircraft            `json:"aircraft"`
+	Operating           *Operating           `json:"operating"`
+	AvailabilityClasses []*AvailabilityClass `json:"availabilityClasses"`
+}
+
+// AvailabilityClass is the seats left in a booking class. ClosedStatus is
+// set, to WAITLISTOPEN, WAITLISTCLOSED or CLOSED, when it can't be booked.
+type AvailabilityClass struct {
+	NumberOfBookableSeats int32  `json:"numberOfBookableSeats"`
+	Class                 string `json:"class"`
+	ClosedStatus          string `json:"closedStatus,omitempty"`
+}
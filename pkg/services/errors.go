@@ -0,0 +1,192 @@
+package services
+
+import (
+	"amadeus-go/pkg/auth"
+
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// AmadeusError describes a call to Amadeus that failed: the route it was
+// made for, the HTTP status if Amadeus answered at all, the errors it listed
+// in the body and, for failures that never got an answer, the cause.
+//
+// Service methods return it wrapped in one of the more specific error types
+// below, so callers can tell failures apart with a type switch.
+type AmadeusError struct {
+	Route  string
+	Status int
+	Errors []*ErrorWarning
+	Cause  error
+}
+
+func (e *AmadeusError) Error() string {
+	msg := "amadeus " + e.Route
+	if e.Status != 0 {
+		msg += fmt.Sprintf(" responded %d %s", e.Status, http.StatusText(e.Status))
+	}
+	if len(e.Errors) > 0 {
+		first := e.Errors[0]
+		msg += ": " + first.Title
+		if first.Detail != "" {
+			msg += " (" + first.Detail + ")"
+		}
+	} else if e.Cause != nil {
+		msg += ": " + e.Cause.Error()
+	}
+	return msg
+}
+
+// ValidationError means Amadeus rejected the request itself; the Source of
+// each of its Errors points at the offending parameter.
+type ValidationError struct{ *AmadeusError }
+
+// NotFoundError means what the request asked for doesn't exist.
+type NotFoundError struct{ *AmadeusError }
+
+// ConflictError means the request clashes with another one, like a booking
+// whose idempotency key is still in use by a call in flight.
+type ConflictError struct{ *AmadeusError }
+
+// AuthError means Amadeus didn't accept the credentials of this service.
+type AuthError struct{ *AmadeusError }
+
+// RateLimitError means the call was refused for going over a transactions
+// per second limit, either ours or Amadeus's. RetryAfter is set when it is
+// known how long to back off for.
+type RateLimitError struct {
+	*AmadeusError
+	RetryAfter time.Duration
+}
+
+// UnavailableError means Amadeus could not be reached or failed on its side.
+type UnavailableError struct{ *AmadeusError }
+
+// IsUnavailable tells whether err means Amadeus could not be reached or failed
+// on its side, as opposed to the request being rejected, rate-limited or given
+// up on by the caller.
+func IsUnavailable(err error) bool {
+	_, ok := err.(*UnavailableError)
+	return ok
+}
+
+// rejected tells whether err means Amadeus, or the service on its behalf,
+// refused a request outright, so that it can't have had any effect. Anything
+// else, a timeout or a failure on Amadeus's side say, may have.
+func rejected(err error) bool {
+	switch err.(type) {
+	case *ValidationError, *NotFoundError, *ConflictError, *AuthError, *RateLimitError:
+		return true
+	}
+	return false
+}
+
+// ErrorKind names the kind of err for metrics, after the types above, the
+// errors of package auth and the errors of a context.
+func ErrorKind(err error) string {
+	switch err.(type) {
+	case *ValidationError:
+		return "validation"
+	case *NotFoundError:
+		return "not_found"
+	case *ConflictError:
+		return "conflict"
+	case *AuthError:
+		return "auth"
+	case *RateLimitError:
+		return "rate_limit"
+	case *UnavailableError:
+		return "unavailable"
+	case *AmadeusError:
+		return "amadeus"
+	}
+
+	switch err {
+	case auth.ErrUnauthenticated:
+		return "unauthenticated"
+	case auth.ErrPermissionDenied:
+		return "permission_denied"
+	case context.Canceled:
+		return "canceled"
+	case context.DeadlineExceeded:
+		return "deadline_exceeded"
+	default:
+		return "other"
+	}
+}
+
+// typedError turns the last error of a call to route into one of the types
+// above. Errors of the caller's own context are returned as they are.
+func typedError(ctx context.Context, route string, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *RateLimitError:
+		return e
+	case *tokenError:
+		typed := typedError(ctx, route, e.err)
+		if uErr, ok := typed.(*UnavailableError); ok {
+			return uErr
+		}
+		return &AuthError{&AmadeusError{Route: route, Cause: e}}
+	case *upstreamError:
+		base := &AmadeusError{Route: route, Status: e.StatusCode}
+		if e.Body != nil {
+			base.Errors = e.Body.Errors
+		}
+		return typedStatusError(base, e.retryAfter)
+	default:
+		return &UnavailableError{&AmadeusError{Route: route, Cause: err}}
+	}
+}
+
+// responseError reports the errors of a response Amadeus sent with a
+// successful status, which it does now and then.
+func responseError(route string, errs []*ErrorWarning) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	base := &AmadeusError{Route: route, Status: int(errs[0].Status), Errors: errs}
+	return typedStatusError(base, 0)
+}
+
+// invalidParameter is the ValidationError of a request to route the service
+// refuses before it gets to Amadeus.
+func invalidParameter(route string, parameter string, detail string) error {
+	return &ValidationError{&AmadeusError{
+		Route:  route,
+		Status: http.StatusBadRequest,
+		Errors: []*ErrorWarning{{
+			Status: http.StatusBadRequest,
+			Title:  "INVALID DATA RECEIVED",
+			Detail: parameter + ": " + detail,
+			Source: &Source{Parameter: parameter},
+		}},
+	}}
+}
+
+func typedStatusError(base *AmadeusError, retryAfter time.Duration) error {
+	switch {
+	case base.Status == http.StatusBadRequest || base.Status == http.StatusUnprocessableEntity:
+		return &ValidationError{base}
+	case base.Status == http.StatusNotFound:
+		return &NotFoundError{base}
+	case base.Status == http.StatusConflict:
+		return &ConflictError{base}
+	case base.Status == http.StatusUnauthorized || base.Status == http.StatusForbidden:
+		return &AuthError{base}
+	case base.Status == http.StatusTooManyRequests:
+		return &RateLimitError{base, retryAfter}
+	case base.Status >= 500:
+		return &UnavailableError{base}
+	default:
+		return base
+	}
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"amadeus-go/pkg/auth"
+
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTypedError(t *testing.T) {
+	body := &Response{Errors: []*ErrorWarning{{Status: 400, Code: 477, Title: "INVALID FORMAT", Source: &Source{Parameter: "originLocationCode"}}}}
+
+	for _, tc := range []struct {
+		name     string
+		err      error
+		wantKind string
+	}{
+		{"bad request", &upstreamError{StatusCode: http.StatusBadRequest, Body: body}, "validation"},
+		{"unprocessable", &upstreamError{StatusCode: http.StatusUnprocessableEntity}, "validation"},
+		{"not found", &upstreamError{StatusCode: http.StatusNotFound}, "not_found"},
+		{"conflict", &upstreamError{StatusCode: http.StatusConflict}, "conflict"},
+		{"unauthorized", &upstreamError{StatusCode: http.StatusUnauthorized}, "auth"},
+		{"forbidden", &upstreamError{StatusCode: http.StatusForbidden}, "auth"},
+		{"rate limited", &upstreamError{StatusCode: http.StatusTooManyRequests, retryAfter: time.Second}, "rate_limit"},
+		{"server error", &upstreamError{StatusCode: http.StatusBadGateway}, "unavailable"},
+		{"other status", &upstreamError{StatusCode: http.StatusTeapot}, "amadeus"},
+		{"unreachable", errors.New("connection refused"), "unavailable"},
+		{"local rate limit", rateLimitError("AirlineCodeLookup", time.Second), "rate_limit"},
+		{"token refused", &tokenError{&upstreamError{StatusCode: http.StatusUnauthorized}}, "auth"},
+		{"token endpoint down", &tokenError{errors.New("connection refused")}, "unavailable"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := typedError(context.Background(), "AirlineCodeLookup", tc.err)
+			if kind := ErrorKind(err); kind != tc.wantKind {
+				t.Fatalf("got %T (%s), want kind %s", err, kind, tc.wantKind)
+			}
+
+			switch e := err.(type) {
+			case *ValidationError:
+				if uErr := tc.err.(*upstreamError); uErr.Body != nil && len(e.Errors) != 1 {
+					t.Errorf("got errors %v, want those of the body", e.Errors)
+				}
+			case *RateLimitError:
+				if e.RetryAfter != time.Second {
+					t.Errorf("got RetryAfter %s, want a second", e.RetryAfter)
+				}
+			}
+		})
+	}
+}
+
+func TestTypedErrorOfGivenUpCall(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := typedError(ctx, "AirlineCodeLookup", errors.New("connection reset")); err != context.Canceled {
+		t.Fatalf("got %v, want the error of the caller's context", err)
+	}
+	if err := typedError(context.Background(), "AirlineCodeLookup", nil); err != nil {
+		t.Fatalf("got %v for no error", err)
+	}
+}
+
+func TestResponseError(t *testing.T) {
+	if err := responseError("AirlineCodeLookup", nil); err != nil {
+		t.Fatalf("got %v for a response without errors", err)
+	}
+
+	err := responseError("AirlineCodeLookup", []*ErrorWarning{{Status: 404, Title: "NOT FOUND"}})
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Fatalf("got %T, want a NotFoundError", err)
+	}
+}
+
+func TestErrorKind(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		want string
+	}{
+		{auth.ErrUnauthenticated, "unauthenticated"},
+		{auth.ErrPermissionDenied, "permission_denied"},
+		{context.Canceled, "canceled"},
+		{context.DeadlineExceeded, "deadline_exceeded"},
+		{errors.New("boom"), "other"},
+	} {
+		if got := ErrorKind(tc.err); got != tc.want {
+			t.Errorf("%v: got %s, want %s", tc.err, got, tc.want)
+		}
+	}
+}
+
+func TestRejected(t *testing.T) {
+	base := &AmadeusError{Route: "CreateFlightOrder"}
+	for _, tc := range []struct {
+		err  error
+		want bool
+	}{
+		{&ValidationError{base}, true},
+		{&NotFoundError{base}, true},
+		{&ConflictError{base}, true},
+		{&AuthError{base}, true},
+		{&RateLimitError{AmadeusError: base}, true},
+		{&UnavailableError{base}, false},
+		{base, false},
+		{context.DeadlineExceeded, false},
+	} {
+		if got := rejected(tc.err); got != tc.want {
+			t.Errorf("%T: got %t, want %t", tc.err, got, tc.want)
+		}
+	}
+}
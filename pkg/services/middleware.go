@@ -1,12 +1,33 @@
 package services
 
 import (
+	"amadeus-go/pkg/instrumenting"
+	"amadeus-go/pkg/logging"
+	"amadeus-go/pkg/services/caching"
+	"amadeus-go/pkg/tracing"
+
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
 )
 
+// coalescedCalls counts, per method, the calls that were answered by another
+// identical call already in flight instead of going upstream themselves.
+var coalescedCalls = expvar.NewMap("amadeus_coalesced_calls")
+
 // ============================= logger middleware =============================
 func loggingMiddleware(logger log.Logger) serviceMiddleware {
 	return func(next AmadeusService) AmadeusService {
@@ -19,9 +40,15 @@ type logmw struct {
 	sv     AmadeusService
 }
 
+// requestLogger logs for the request in ctx, at the error level if the call
+// failed.
+func (mw logmw) requestLogger(ctx context.Context, err error) log.Logger {
+	return logging.ByError(logging.WithContext(mw.logger, ctx), err)
+}
+
 func (mw logmw) FlightLowFareSearch(ctx context.Context, req *FlightLowFareSearchRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "FlightLowFareSearch",
 			"input", req,
@@ -37,7 +64,7 @@ func (mw logmw) FlightLowFareSearch(ctx context.Context, req *FlightLowFareSearc
 
 func (mw logmw) FlightInspirationSearch(ctx context.Context, req *FlightInspirationSearchRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "FlightInspirationSearch",
 			"input", req,
@@ -53,7 +80,7 @@ func (mw logmw) FlightInspirationSearch(ctx context.Context, req *FlightInspirat
 
 func (mw logmw) FlightCheapestDateSearch(ctx context.Context, req *FlightCheapestDateSearchRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "FlightCheapestDateSearch",
 			"input", req,
@@ -69,7 +96,7 @@ func (mw logmw) FlightCheapestDateSearch(ctx context.Context, req *FlightCheapes
 
 func (mw logmw) FlightMostSearchedDestinations(ctx context.Context, req *FlightMostSearchedDestinationsRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "FlightMostSearchedDestinations",
 			"input", req,
@@ -85,7 +112,7 @@ func (mw logmw) FlightMostSearchedDestinations(ctx context.Context, req *FlightM
 
 func (mw logmw) FlightMostSearchedByDestination(ctx context.Context, req *FlightMostSearchedByDestinationRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "FlightMostSearchedByDestination",
 			"input", req,
@@ -101,7 +128,7 @@ func (mw logmw) FlightMostSearchedByDestination(ctx context.Context, req *Flight
 
 func (mw logmw) FlightCheckInLinks(ctx context.Context, req *FlightCheckInLinksRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "FlightCheckInLinks",
 			"input", req,
@@ -117,7 +144,7 @@ func (mw logmw) FlightCheckInLinks(ctx context.Context, req *FlightCheckInLinksR
 
 func (mw logmw) FlightMostTraveledDestinations(ctx context.Context, req *FlightMostTraveledDestinationsRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "FlightMostTraveledDestinations",
 			"input", req,
@@ -133,7 +160,7 @@ func (mw logmw) FlightMostTraveledDestinations(ctx context.Context, req *FlightM
 
 func (mw logmw) FlightMostBookedDestinations(ctx context.Context, req *FlightMostBookedDestinationsRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "FlightMostBookedDestinations",
 			"input", req,
@@ -149,7 +176,7 @@ func (mw logmw) FlightMostBookedDestinations(ctx context.Context, req *FlightMos
 
 func (mw logmw) FlightBusiestTravelingPeriod(ctx context.Context, req *FlightBusiestTravelingPeriodRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "FlightBusiestTravelingPeriod",
 			"input", req,
@@ -165,7 +192,7 @@ func (mw logmw) FlightBusiestTravelingPeriod(ctx context.Context, req *FlightBus
 
 func (mw logmw) AirportNearestRelevant(ctx context.Context, req *AirportNearestRelevantRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "AirportNearestRelevant",
 			"input", req,
@@ -181,7 +208,7 @@ func (mw logmw) AirportNearestRelevant(ctx context.Context, req *AirportNearestR
 
 func (mw logmw) AirportAndCitySearch(ctx context.Context, req *AirportAndCitySearchRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "AirportAndCitySearch",
 			"input", req,
@@ -197,7 +224,7 @@ func (mw logmw) AirportAndCitySearch(ctx context.Context, req *AirportAndCitySea
 
 func (mw logmw) AirlineCodeLookup(ctx context.Context, req *AirlineCodeLookupRequest) (resp *Response, err error) {
 	defer func(begin time.Time) {
-		_ = mw.logger.Log(
+		_ = mw.requestLogger(ctx, err).Log(
 			"layer", "service",
 			"method", "AirlineCodeLookup",
 			"input", req,
@@ -210,3 +237,1254 @@ func (mw logmw) AirlineCodeLookup(ctx context.Context, req *AirlineCodeLookupReq
 	resp, err = mw.sv.AirlineCodeLookup(ctx, req)
 	return
 }
+
+func (mw logmw) FlightOffersSearch(ctx context.Context, req *FlightOffersSearchRequest) (resp *FlightOffersResponse, err error) {
+	defer func(begin time.Time) {
+		_ = mw.requestLogger(ctx, err).Log(
+			"layer", "service",
+			"method", "FlightOffersSearch",
+			"input", req,
+			"output", resp,
+			"error", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	resp, err = mw.sv.FlightOffersSearch(ctx, req)
+	return
+}
+
+func (mw logmw) FlightOffersPrice(ctx context.Context, req *FlightOffersPriceRequest) (resp *FlightOffersPriceResponse, err error) {
+	defer func(begin time.Time) {
+		_ = mw.requestLogger(ctx, err).Log(
+			"layer", "service",
+			"method", "FlightOffersPrice",
+			"input", req,
+			"output", resp,
+			"error", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	resp, err = mw.sv.FlightOffersPrice(ctx, req)
+	return
+}
+
+func (mw logmw) CreateFlightOrder(ctx context.Context, req *CreateFlightOrderRequest) (resp *FlightOrderResponse, err error) {
+	defer func(begin time.Time) {
+		_ = mw.requestLogger(ctx, err).Log(
+			"layer", "service",
+			"method", "CreateFlightOrder",
+			"input", req,
+			"output", resp,
+			"error", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	resp, err = mw.sv.CreateFlightOrder(ctx, req)
+	return
+}
+
+func (mw logmw) GetFlightOrder(ctx context.Context, req *GetFlightOrderRequest) (resp *FlightOrderResponse, err error) {
+	defer func(begin time.Time) {
+		_ = mw.requestLogger(ctx, err).Log(
+			"layer", "service",
+			"method", "GetFlightOrder",
+			"input", req,
+			"output", resp,
+			"error", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	resp, err = mw.sv.GetFlightOrder(ctx, req)
+	return
+}
+
+func (mw logmw) CancelFlightOrder(ctx context.Context, req *CancelFlightOrderRequest) (resp *CancelFlightOrderResponse, err error) {
+	defer func(begin time.Time) {
+		_ = mw.requestLogger(ctx, err).Log(
+			"layer", "service",
+			"method", "CancelFlightOrder",
+			"input", req,
+			"output", resp,
+			"error", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	resp, err = mw.sv.CancelFlightOrder(ctx, req)
+	return
+}
+
+func (mw logmw) SeatMapDisplay(ctx context.Context, req *SeatMapDisplayRequest) (resp *SeatMapResponse, err error) {
+	defer func(begin time.Time) {
+		_ = mw.requestLogger(ctx, err).Log(
+			"layer", "service",
+			"method", "SeatMapDisplay",
+			"input", req,
+			"output", resp,
+			"error", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	resp, err = mw.sv.SeatMapDisplay(ctx, req)
+	return
+}
+
+func (mw logmw) BrandedFaresUpsell(ctx context.Context, req *BrandedFaresUpsellRequest) (resp *FlightOffersResponse, err error) {
+	defer func(begin time.Time) {
+		_ = mw.requestLogger(ctx, err).Log(
+			"layer", "service",
+			"method", "BrandedFaresUpsell",
+			"input", req,
+			"output", resp,
+			"error", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	resp, err = mw.sv.BrandedFaresUpsell(ctx, req)
+	return
+}
+
+func (mw logmw) FlightAvailabilitiesSearch(ctx context.Context, req *FlightAvailabilitiesSearchRequest) (resp *FlightAvailabilitiesResponse, err error) {
+	defer func(begin time.Time) {
+		_ = mw.requestLogger(ctx, err).Log(
+			"layer", "service",
+			"method", "FlightAvailabilitiesSearch",
+			"input", req,
+			"output", resp,
+			"error", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+
+	resp, err = mw.sv.FlightAvailabilitiesSearch(ctx, req)
+	return
+}
+
+// ========================== instrumenting middleware =========================
+func instrumentingMiddleware(metrics *instrumenting.Metrics) serviceMiddleware {
+	return func(next AmadeusService) AmadeusService {
+		return instrumw{metrics, next}
+	}
+}
+
+// instrumw counts the calls of every method and how long they took, and the
+// ones that failed by kind of error.
+type instrumw struct {
+	metrics *instrumenting.Metrics
+	sv      AmadeusService
+}
+
+func (mw instrumw) instrumentedResponse(ctx context.Context, method string, call func(context.Context) (*Response, error)) (*Response, error) {
+	resp, err := mw.instrumented(ctx, method, func(ctx context.Context) (interface{}, error) {
+		return call(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*Response), nil
+}
+
+// instrumented makes call on behalf of method, whatever type of response it
+// returns, and records it.
+func (mw instrumw) instrumented(ctx context.Context, method string, call func(context.Context) (interface{}, error)) (resp interface{}, err error) {
+	defer func(begin time.Time) {
+		kind := ""
+		if err != nil {
+			kind = ErrorKind(err)
+		}
+		mw.metrics.ObserveRequest("service", method, kind, time.Since(begin))
+	}(time.Now())
+
+	return call(ctx)
+}
+
+func (mw instrumw) FlightLowFareSearch(ctx context.Context, req *FlightLowFareSearchRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "FlightLowFareSearch", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightLowFareSearch(ctx, req)
+	})
+}
+
+func (mw instrumw) FlightInspirationSearch(ctx context.Context, req *FlightInspirationSearchRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "FlightInspirationSearch", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightInspirationSearch(ctx, req)
+	})
+}
+
+func (mw instrumw) FlightCheapestDateSearch(ctx context.Context, req *FlightCheapestDateSearchRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "FlightCheapestDateSearch", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightCheapestDateSearch(ctx, req)
+	})
+}
+
+func (mw instrumw) FlightMostSearchedDestinations(ctx context.Context, req *FlightMostSearchedDestinationsRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "FlightMostSearchedDestinations", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostSearchedDestinations(ctx, req)
+	})
+}
+
+func (mw instrumw) FlightMostSearchedByDestination(ctx context.Context, req *FlightMostSearchedByDestinationRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "FlightMostSearchedByDestination", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostSearchedByDestination(ctx, req)
+	})
+}
+
+func (mw instrumw) FlightCheckInLinks(ctx context.Context, req *FlightCheckInLinksRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "FlightCheckInLinks", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightCheckInLinks(ctx, req)
+	})
+}
+
+func (mw instrumw) FlightMostTraveledDestinations(ctx context.Context, req *FlightMostTraveledDestinationsRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "FlightMostTraveledDestinations", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostTraveledDestinations(ctx, req)
+	})
+}
+
+func (mw instrumw) FlightMostBookedDestinations(ctx context.Context, req *FlightMostBookedDestinationsRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "FlightMostBookedDestinations", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostBookedDestinations(ctx, req)
+	})
+}
+
+func (mw instrumw) FlightBusiestTravelingPeriod(ctx context.Context, req *FlightBusiestTravelingPeriodRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "FlightBusiestTravelingPeriod", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightBusiestTravelingPeriod(ctx, req)
+	})
+}
+
+func (mw instrumw) AirportNearestRelevant(ctx context.Context, req *AirportNearestRelevantRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "AirportNearestRelevant", func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirportNearestRelevant(ctx, req)
+	})
+}
+
+func (mw instrumw) AirportAndCitySearch(ctx context.Context, req *AirportAndCitySearchRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "AirportAndCitySearch", func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirportAndCitySearch(ctx, req)
+	})
+}
+
+func (mw instrumw) AirlineCodeLookup(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+	return mw.instrumentedResponse(ctx, "AirlineCodeLookup", func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirlineCodeLookup(ctx, req)
+	})
+}
+
+func (mw instrumw) FlightOffersSearch(ctx context.Context, req *FlightOffersSearchRequest) (*FlightOffersResponse, error) {
+	resp, err := mw.instrumented(ctx, "FlightOffersSearch", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightOffersSearch(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersResponse), nil
+}
+
+func (mw instrumw) FlightOffersPrice(ctx context.Context, req *FlightOffersPriceRequest) (*FlightOffersPriceResponse, error) {
+	resp, err := mw.instrumented(ctx, "FlightOffersPrice", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightOffersPrice(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersPriceResponse), nil
+}
+
+func (mw instrumw) CreateFlightOrder(ctx context.Context, req *CreateFlightOrderRequest) (*FlightOrderResponse, error) {
+	resp, err := mw.instrumented(ctx, "CreateFlightOrder", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.CreateFlightOrder(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOrderResponse), nil
+}
+
+func (mw instrumw) GetFlightOrder(ctx context.Context, req *GetFlightOrderRequest) (*FlightOrderResponse, error) {
+	resp, err := mw.instrumented(ctx, "GetFlightOrder", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.GetFlightOrder(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOrderResponse), nil
+}
+
+func (mw instrumw) CancelFlightOrder(ctx context.Context, req *CancelFlightOrderRequest) (*CancelFlightOrderResponse, error) {
+	resp, err := mw.instrumented(ctx, "CancelFlightOrder", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.CancelFlightOrder(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*CancelFlightOrderResponse), nil
+}
+
+func (mw instrumw) SeatMapDisplay(ctx context.Context, req *SeatMapDisplayRequest) (*SeatMapResponse, error) {
+	resp, err := mw.instrumented(ctx, "SeatMapDisplay", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.SeatMapDisplay(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*SeatMapResponse), nil
+}
+
+func (mw instrumw) BrandedFaresUpsell(ctx context.Context, req *BrandedFaresUpsellRequest) (*FlightOffersResponse, error) {
+	resp, err := mw.instrumented(ctx, "BrandedFaresUpsell", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.BrandedFaresUpsell(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersResponse), nil
+}
+
+func (mw instrumw) FlightAvailabilitiesSearch(ctx context.Context, req *FlightAvailabilitiesSearchRequest) (*FlightAvailabilitiesResponse, error) {
+	resp, err := mw.instrumented(ctx, "FlightAvailabilitiesSearch", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightAvailabilitiesSearch(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightAvailabilitiesResponse), nil
+}
+
+// ============================ tracing middleware =============================
+func tracingMiddleware(tracer trace.Tracer) serviceMiddleware {
+	return func(next AmadeusService) AmadeusService {
+		return tracemw{tracer, next}
+	}
+}
+
+// tracemw wraps every method in a span, so the time spent in the cache, the
+// quota and waiting for identical calls shows apart from the upstream call.
+type tracemw struct {
+	tracer trace.Tracer
+	sv     AmadeusService
+}
+
+func (mw tracemw) tracedResponse(ctx context.Context, method string, call func(context.Context) (*Response, error)) (*Response, error) {
+	resp, err := mw.traced(ctx, method, func(ctx context.Context) (interface{}, error) {
+		return call(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*Response), nil
+}
+
+// traced makes call on behalf of method, whatever type of response it
+// returns, in a span of its own.
+func (mw tracemw) traced(ctx context.Context, method string, call func(context.Context) (interface{}, error)) (resp interface{}, err error) {
+	ctx, span := mw.tracer.Start(ctx, "service."+method)
+	defer func() {
+		if err != nil {
+			span.SetAttributes(attribute.String("error.kind", ErrorKind(err)))
+		}
+		tracing.End(span, err)
+	}()
+
+	return call(ctx)
+}
+
+func (mw tracemw) FlightLowFareSearch(ctx context.Context, req *FlightLowFareSearchRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "FlightLowFareSearch", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightLowFareSearch(ctx, req)
+	})
+}
+
+func (mw tracemw) FlightInspirationSearch(ctx context.Context, req *FlightInspirationSearchRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "FlightInspirationSearch", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightInspirationSearch(ctx, req)
+	})
+}
+
+func (mw tracemw) FlightCheapestDateSearch(ctx context.Context, req *FlightCheapestDateSearchRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "FlightCheapestDateSearch", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightCheapestDateSearch(ctx, req)
+	})
+}
+
+func (mw tracemw) FlightMostSearchedDestinations(ctx context.Context, req *FlightMostSearchedDestinationsRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "FlightMostSearchedDestinations", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostSearchedDestinations(ctx, req)
+	})
+}
+
+func (mw tracemw) FlightMostSearchedByDestination(ctx context.Context, req *FlightMostSearchedByDestinationRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "FlightMostSearchedByDestination", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostSearchedByDestination(ctx, req)
+	})
+}
+
+func (mw tracemw) FlightCheckInLinks(ctx context.Context, req *FlightCheckInLinksRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "FlightCheckInLinks", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightCheckInLinks(ctx, req)
+	})
+}
+
+func (mw tracemw) FlightMostTraveledDestinations(ctx context.Context, req *FlightMostTraveledDestinationsRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "FlightMostTraveledDestinations", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostTraveledDestinations(ctx, req)
+	})
+}
+
+func (mw tracemw) FlightMostBookedDestinations(ctx context.Context, req *FlightMostBookedDestinationsRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "FlightMostBookedDestinations", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostBookedDestinations(ctx, req)
+	})
+}
+
+func (mw tracemw) FlightBusiestTravelingPeriod(ctx context.Context, req *FlightBusiestTravelingPeriodRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "FlightBusiestTravelingPeriod", func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightBusiestTravelingPeriod(ctx, req)
+	})
+}
+
+func (mw tracemw) AirportNearestRelevant(ctx context.Context, req *AirportNearestRelevantRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "AirportNearestRelevant", func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirportNearestRelevant(ctx, req)
+	})
+}
+
+func (mw tracemw) AirportAndCitySearch(ctx context.Context, req *AirportAndCitySearchRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "AirportAndCitySearch", func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirportAndCitySearch(ctx, req)
+	})
+}
+
+func (mw tracemw) AirlineCodeLookup(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+	return mw.tracedResponse(ctx, "AirlineCodeLookup", func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirlineCodeLookup(ctx, req)
+	})
+}
+
+func (mw tracemw) FlightOffersSearch(ctx context.Context, req *FlightOffersSearchRequest) (*FlightOffersResponse, error) {
+	resp, err := mw.traced(ctx, "FlightOffersSearch", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightOffersSearch(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersResponse), nil
+}
+
+func (mw tracemw) FlightOffersPrice(ctx context.Context, req *FlightOffersPriceRequest) (*FlightOffersPriceResponse, error) {
+	resp, err := mw.traced(ctx, "FlightOffersPrice", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightOffersPrice(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersPriceResponse), nil
+}
+
+func (mw tracemw) CreateFlightOrder(ctx context.Context, req *CreateFlightOrderRequest) (*FlightOrderResponse, error) {
+	resp, err := mw.traced(ctx, "CreateFlightOrder", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.CreateFlightOrder(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOrderResponse), nil
+}
+
+func (mw tracemw) GetFlightOrder(ctx context.Context, req *GetFlightOrderRequest) (*FlightOrderResponse, error) {
+	resp, err := mw.traced(ctx, "GetFlightOrder", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.GetFlightOrder(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOrderResponse), nil
+}
+
+func (mw tracemw) CancelFlightOrder(ctx context.Context, req *CancelFlightOrderRequest) (*CancelFlightOrderResponse, error) {
+	resp, err := mw.traced(ctx, "CancelFlightOrder", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.CancelFlightOrder(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*CancelFlightOrderResponse), nil
+}
+
+func (mw tracemw) SeatMapDisplay(ctx context.Context, req *SeatMapDisplayRequest) (*SeatMapResponse, error) {
+	resp, err := mw.traced(ctx, "SeatMapDisplay", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.SeatMapDisplay(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*SeatMapResponse), nil
+}
+
+func (mw tracemw) BrandedFaresUpsell(ctx context.Context, req *BrandedFaresUpsellRequest) (*FlightOffersResponse, error) {
+	resp, err := mw.traced(ctx, "BrandedFaresUpsell", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.BrandedFaresUpsell(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersResponse), nil
+}
+
+func (mw tracemw) FlightAvailabilitiesSearch(ctx context.Context, req *FlightAvailabilitiesSearchRequest) (*FlightAvailabilitiesResponse, error) {
+	resp, err := mw.traced(ctx, "FlightAvailabilitiesSearch", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightAvailabilitiesSearch(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightAvailabilitiesResponse), nil
+}
+
+// ============================= caching middleware ============================
+func cachingMiddleware(cache caching.Cache, policies map[string]cachePolicy, usage *usageTracker, metrics *instrumenting.Metrics, logger log.Logger) serviceMiddleware {
+	return func(next AmadeusService) AmadeusService {
+		return cachemw{cache, policies, usage, metrics, logger, next, &sync.Map{}}
+	}
+}
+
+// cachePolicy says how long a method's responses are fresh for, how much
+// longer they may still be served when Amadeus can't give a fresh one, and
+// whether a stale response is returned right away while it is refreshed.
+type cachePolicy struct {
+	ttl        time.Duration
+	maxStale   time.Duration
+	revalidate bool
+}
+
+// cachemw serves a Response from the cache for methods that have a policy;
+// every other method goes straight to the next service. Responses served
+// from the cache are accounted to the tenant as cache hits.
+type cachemw struct {
+	cache      caching.Cache
+	policies   map[string]cachePolicy
+	usage      *usageTracker
+	metrics    *instrumenting.Metrics
+	logger     log.Logger
+	sv         AmadeusService
+	refreshing *sync.Map
+}
+
+type cacheEntry struct {
+	Response *Response `json:"response"`
+	StoredAt time.Time `json:"storedAt"`
+}
+
+func (mw cachemw) cached(ctx context.Context, method string, req interface{}, call func(context.Context) (*Response, error)) (*Response, error) {
+	policy, ok := mw.policies[method]
+	if !ok || policy.ttl <= 0 {
+		return call(ctx)
+	}
+
+	key, err := cacheKey(method, req)
+	if err != nil {
+		return call(ctx)
+	}
+
+	// the cache is an optimisation, so a broken backend only costs a miss
+	var entry *cacheEntry
+	b, found, err := mw.cache.Get(key)
+	if err == nil && found {
+		var e cacheEntry
+		if json.Unmarshal(b, &e) == nil && e.Response != nil {
+			entry = &e
+		}
+	}
+
+	if entry != nil {
+		age := time.Since(entry.StoredAt)
+		if age <= policy.ttl {
+			mw.report(ctx, method, "hit", nil)
+			mw.count(method, "hit")
+			mw.usage.hit(ctx, method)
+			return entry.Response, nil
+		}
+
+		if policy.revalidate {
+			mw.report(ctx, method, "stale", nil)
+			mw.count(method, "stale")
+			mw.usage.hit(ctx, method)
+			mw.revalidate(ctx, method, key, policy, call)
+			return staleResponse(entry, "served while being refreshed"), nil
+		}
+	}
+	mw.report(ctx, method, "miss", err)
+	mw.count(method, "miss")
+
+	resp, err := call(ctx)
+	if err != nil {
+		if entry != nil && fallsBack(err) {
+			mw.report(ctx, method, "stale", err)
+			mw.count(method, "fallback")
+			mw.usage.hit(ctx, method)
+			return staleResponse(entry, "upstream call failed: "+err.Error()), nil
+		}
+		return nil, err
+	}
+
+	mw.store(ctx, method, key, policy, resp)
+	return resp, nil
+}
+
+// fallsBack tells whether a stale entry may be served in place of the
+// response of a call that failed with err: only when Amadeus couldn't give a
+// fresh one, being unavailable or rate-limiting us. A request that is
+// refused, over its tenant's quota or given up on gets its error.
+func fallsBack(err error) bool {
+	switch e := err.(type) {
+	case *UnavailableError:
+		return true
+	case *RateLimitError:
+		return e.Status == http.StatusTooManyRequests && !overQuota(err)
+	}
+	return false
+}
+
+// revalidate refreshes an entry in the background, at most once at a time
+// per key. The refresh must outlive the request that noticed the entry went
+// stale, so it only keeps that request's context values, and with them the
+// tenant the refresh is accounted to.
+func (mw cachemw) revalidate(ctx context.Context, method string, key string, policy cachePolicy, call func(context.Context) (*Response, error)) {
+	if _, loaded := mw.refreshing.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+
+	go func() {
+		defer mw.refreshing.Delete(key)
+
+		ctx, cancel := context.WithTimeout(detachedContext{ctx}, defaultTimeout)
+		defer cancel()
+
+		resp, err := call(ctx)
+		if err != nil {
+			mw.report(ctx, method, "refresh", err)
+			return
+		}
+		mw.store(ctx, method, key, policy, resp)
+	}()
+}
+
+func (mw cachemw) store(ctx context.Context, method string, key string, policy cachePolicy, resp *Response) {
+	if resp == nil || len(resp.Errors) > 0 {
+		return
+	}
+
+	b, err := json.Marshal(cacheEntry{Response: resp, StoredAt: time.Now()})
+	if err == nil {
+		err = mw.cache.Set(key, b, policy.ttl+policy.maxStale)
+	}
+	if err != nil {
+		mw.report(ctx, method, "store", err)
+	}
+}
+
+// staleResponse flags a cached response that is past its TTL with a warning,
+// so clients can tell they aren't looking at live data.
+func staleResponse(entry *cacheEntry, reason string) *Response {
+	resp := entry.Response
+	resp.Warnings = append(resp.Warnings, &ErrorWarning{
+		Title:  "STALE RESPONSE",
+		Detail: fmt.Sprintf("cached %s ago, %s", time.Since(entry.StoredAt).Round(time.Second), reason),
+	})
+	return resp
+}
+
+func (mw cachemw) report(ctx context.Context, method string, result string, err error) {
+	logger := level.Debug(logging.WithContext(mw.logger, ctx))
+	if err != nil {
+		logger = level.Warn(logging.WithContext(mw.logger, ctx))
+	}
+	_ = logger.Log(
+		"layer", "cache",
+		"method", method,
+		"result", result,
+		"error", err,
+	)
+}
+
+// count records the result of a lookup. Misses answered from a stale entry
+// because the upstream call failed are counted again as fallbacks.
+func (mw cachemw) count(method string, result string) {
+	mw.metrics.Cache.With("method", method, "result", result).Add(1)
+}
+
+// cacheKey identifies a request by its method and its fields. Codes are case
+// insensitive for Amadeus, so "del" and "DEL" share an entry.
+func cacheKey(method string, req interface{}) (string, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum([]byte(strings.ToUpper(string(b))))
+	return method + ":" + hex.EncodeToString(sum[:]), nil
+}
+
+func (mw cachemw) FlightLowFareSearch(ctx context.Context, req *FlightLowFareSearchRequest) (*Response, error) {
+	return mw.cached(ctx, "FlightLowFareSearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightLowFareSearch(ctx, req)
+	})
+}
+
+func (mw cachemw) FlightInspirationSearch(ctx context.Context, req *FlightInspirationSearchRequest) (*Response, error) {
+	return mw.cached(ctx, "FlightInspirationSearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightInspirationSearch(ctx, req)
+	})
+}
+
+func (mw cachemw) FlightCheapestDateSearch(ctx context.Context, req *FlightCheapestDateSearchRequest) (*Response, error) {
+	return mw.cached(ctx, "FlightCheapestDateSearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightCheapestDateSearch(ctx, req)
+	})
+}
+
+func (mw cachemw) FlightMostSearchedDestinations(ctx context.Context, req *FlightMostSearchedDestinationsRequest) (*Response, error) {
+	return mw.cached(ctx, "FlightMostSearchedDestinations", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostSearchedDestinations(ctx, req)
+	})
+}
+
+func (mw cachemw) FlightMostSearchedByDestination(ctx context.Context, req *FlightMostSearchedByDestinationRequest) (*Response, error) {
+	return mw.cached(ctx, "FlightMostSearchedByDestination", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostSearchedByDestination(ctx, req)
+	})
+}
+
+func (mw cachemw) FlightCheckInLinks(ctx context.Context, req *FlightCheckInLinksRequest) (*Response, error) {
+	return mw.cached(ctx, "FlightCheckInLinks", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightCheckInLinks(ctx, req)
+	})
+}
+
+func (mw cachemw) FlightMostTraveledDestinations(ctx context.Context, req *FlightMostTraveledDestinationsRequest) (*Response, error) {
+	return mw.cached(ctx, "FlightMostTraveledDestinations", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostTraveledDestinations(ctx, req)
+	})
+}
+
+func (mw cachemw) FlightMostBookedDestinations(ctx context.Context, req *FlightMostBookedDestinationsRequest) (*Response, error) {
+	return mw.cached(ctx, "FlightMostBookedDestinations", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostBookedDestinations(ctx, req)
+	})
+}
+
+func (mw cachemw) FlightBusiestTravelingPeriod(ctx context.Context, req *FlightBusiestTravelingPeriodRequest) (*Response, error) {
+	return mw.cached(ctx, "FlightBusiestTravelingPeriod", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightBusiestTravelingPeriod(ctx, req)
+	})
+}
+
+func (mw cachemw) AirportNearestRelevant(ctx context.Context, req *AirportNearestRelevantRequest) (*Response, error) {
+	return mw.cached(ctx, "AirportNearestRelevant", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirportNearestRelevant(ctx, req)
+	})
+}
+
+func (mw cachemw) AirportAndCitySearch(ctx context.Context, req *AirportAndCitySearchRequest) (*Response, error) {
+	return mw.cached(ctx, "AirportAndCitySearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirportAndCitySearch(ctx, req)
+	})
+}
+
+func (mw cachemw) AirlineCodeLookup(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+	return mw.cached(ctx, "AirlineCodeLookup", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirlineCodeLookup(ctx, req)
+	})
+}
+
+// FlightOffersSearch is never cached: offers are priced live and go stale
+// within minutes.
+func (mw cachemw) FlightOffersSearch(ctx context.Context, req *FlightOffersSearchRequest) (*FlightOffersResponse, error) {
+	return mw.sv.FlightOffersSearch(ctx, req)
+}
+
+// FlightOffersPrice is never cached either: confirming the price now is the
+// whole point of it.
+func (mw cachemw) FlightOffersPrice(ctx context.Context, req *FlightOffersPriceRequest) (*FlightOffersPriceResponse, error) {
+	return mw.sv.FlightOffersPrice(ctx, req)
+}
+
+// Orders are never cached: they change with every booking, ticketing and
+// cancellation.
+func (mw cachemw) CreateFlightOrder(ctx context.Context, req *CreateFlightOrderRequest) (*FlightOrderResponse, error) {
+	return mw.sv.CreateFlightOrder(ctx, req)
+}
+
+func (mw cachemw) GetFlightOrder(ctx context.Context, req *GetFlightOrderRequest) (*FlightOrderResponse, error) {
+	return mw.sv.GetFlightOrder(ctx, req)
+}
+
+func (mw cachemw) CancelFlightOrder(ctx context.Context, req *CancelFlightOrderRequest) (*CancelFlightOrderResponse, error) {
+	return mw.sv.CancelFlightOrder(ctx, req)
+}
+
+// SeatMapDisplay is never cached: seats are taken by the minute.
+func (mw cachemw) SeatMapDisplay(ctx context.Context, req *SeatMapDisplayRequest) (*SeatMapResponse, error) {
+	return mw.sv.SeatMapDisplay(ctx, req)
+}
+
+// BrandedFaresUpsell is never cached: like FlightOffersSearch, its offers
+// are priced live.
+func (mw cachemw) BrandedFaresUpsell(ctx context.Context, req *BrandedFaresUpsellRequest) (*FlightOffersResponse, error) {
+	return mw.sv.BrandedFaresUpsell(ctx, req)
+}
+
+// FlightAvailabilitiesSearch is never cached: the seats left change with
+// every booking.
+func (mw cachemw) FlightAvailabilitiesSearch(ctx context.Context, req *FlightAvailabilitiesSearchRequest) (*FlightAvailabilitiesResponse, error) {
+	return mw.sv.FlightAvailabilitiesSearch(ctx, req)
+}
+
+// ============================ coalescing middleware ==========================
+func coalescingMiddleware(usage *usageTracker, timeout func(method string) time.Duration, logger log.Logger) serviceMiddleware {
+	return func(next AmadeusService) AmadeusService {
+		return coalescemw{usage, timeout, logger, next, &singleflight.Group{}}
+	}
+}
+
+// coalescemw lets concurrent identical requests share one call to the next
+// service; all of them get the same response back. A shared call is given up
+// on after timeout(method). Every caller must have quota left to join one,
+// and those that didn't make it are accounted as coalesced calls.
+type coalescemw struct {
+	usage   *usageTracker
+	timeout func(method string) time.Duration
+	logger  log.Logger
+	sv      AmadeusService
+	group   *singleflight.Group
+}
+
+func (mw coalescemw) coalesced(ctx context.Context, method string, req interface{}, call func(context.Context) (*Response, error)) (*Response, error) {
+	resp, err := mw.share(ctx, method, req, func(ctx context.Context) (interface{}, error) {
+		return call(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*Response), nil
+}
+
+// share makes call on behalf of every identical request of method that
+// arrives while it is in flight, whatever type of response it returns.
+func (mw coalescemw) share(ctx context.Context, method string, req interface{}, call func(context.Context) (interface{}, error)) (interface{}, error) {
+	key, err := cacheKey(method, req)
+	if err != nil {
+		return call(ctx)
+	}
+
+	// the quota of the caller that makes the call is reserved behind this
+	// middleware; the others mustn't get a response they have no quota for
+	// either
+	err = mw.usage.check(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+
+	// the shared call must not fail for everyone when the caller that happened
+	// to start it goes away, so it only keeps that caller's context values,
+	// with a deadline of its own so it can't hold the key forever
+	leader := false
+	ch := mw.group.DoChan(key, func() (interface{}, error) {
+		leader = true
+		ctx, cancel := context.WithTimeout(detachedContext{ctx}, mw.timeout(method))
+		defer cancel()
+		return call(ctx)
+	})
+
+	select {
+	case res := <-ch:
+		if !leader && overQuota(res.Err) {
+			// the quota used up is the one of the caller that made the call,
+			// which needn't be this caller's
+			return call(ctx)
+		}
+		if !leader {
+			coalescedCalls.Add(method, 1)
+			mw.usage.coalesced(ctx, method)
+			_ = level.Debug(logging.WithContext(mw.logger, ctx)).Log(
+				"layer", "coalesce",
+				"method", method,
+				"result", "collapsed",
+			)
+		}
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// detachedContext carries the values of its parent but none of its deadline
+// or cancellation.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
+
+func (mw coalescemw) FlightLowFareSearch(ctx context.Context, req *FlightLowFareSearchRequest) (*Response, error) {
+	return mw.coalesced(ctx, "FlightLowFareSearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightLowFareSearch(ctx, req)
+	})
+}
+
+func (mw coalescemw) FlightInspirationSearch(ctx context.Context, req *FlightInspirationSearchRequest) (*Response, error) {
+	return mw.coalesced(ctx, "FlightInspirationSearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightInspirationSearch(ctx, req)
+	})
+}
+
+func (mw coalescemw) FlightCheapestDateSearch(ctx context.Context, req *FlightCheapestDateSearchRequest) (*Response, error) {
+	return mw.coalesced(ctx, "FlightCheapestDateSearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightCheapestDateSearch(ctx, req)
+	})
+}
+
+func (mw coalescemw) FlightMostSearchedDestinations(ctx context.Context, req *FlightMostSearchedDestinationsRequest) (*Response, error) {
+	return mw.coalesced(ctx, "FlightMostSearchedDestinations", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostSearchedDestinations(ctx, req)
+	})
+}
+
+func (mw coalescemw) FlightMostSearchedByDestination(ctx context.Context, req *FlightMostSearchedByDestinationRequest) (*Response, error) {
+	return mw.coalesced(ctx, "FlightMostSearchedByDestination", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostSearchedByDestination(ctx, req)
+	})
+}
+
+func (mw coalescemw) FlightCheckInLinks(ctx context.Context, req *FlightCheckInLinksRequest) (*Response, error) {
+	return mw.coalesced(ctx, "FlightCheckInLinks", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightCheckInLinks(ctx, req)
+	})
+}
+
+func (mw coalescemw) FlightMostTraveledDestinations(ctx context.Context, req *FlightMostTraveledDestinationsRequest) (*Response, error) {
+	return mw.coalesced(ctx, "FlightMostTraveledDestinations", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostTraveledDestinations(ctx, req)
+	})
+}
+
+func (mw coalescemw) FlightMostBookedDestinations(ctx context.Context, req *FlightMostBookedDestinationsRequest) (*Response, error) {
+	return mw.coalesced(ctx, "FlightMostBookedDestinations", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostBookedDestinations(ctx, req)
+	})
+}
+
+func (mw coalescemw) FlightBusiestTravelingPeriod(ctx context.Context, req *FlightBusiestTravelingPeriodRequest) (*Response, error) {
+	return mw.coalesced(ctx, "FlightBusiestTravelingPeriod", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightBusiestTravelingPeriod(ctx, req)
+	})
+}
+
+func (mw coalescemw) AirportNearestRelevant(ctx context.Context, req *AirportNearestRelevantRequest) (*Response, error) {
+	return mw.coalesced(ctx, "AirportNearestRelevant", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirportNearestRelevant(ctx, req)
+	})
+}
+
+func (mw coalescemw) AirportAndCitySearch(ctx context.Context, req *AirportAndCitySearchRequest) (*Response, error) {
+	return mw.coalesced(ctx, "AirportAndCitySearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirportAndCitySearch(ctx, req)
+	})
+}
+
+func (mw coalescemw) AirlineCodeLookup(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+	return mw.coalesced(ctx, "AirlineCodeLookup", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirlineCodeLookup(ctx, req)
+	})
+}
+
+func (mw coalescemw) FlightOffersSearch(ctx context.Context, req *FlightOffersSearchRequest) (*FlightOffersResponse, error) {
+	resp, err := mw.share(ctx, "FlightOffersSearch", req, func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightOffersSearch(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersResponse), nil
+}
+
+func (mw coalescemw) FlightOffersPrice(ctx context.Context, req *FlightOffersPriceRequest) (*FlightOffersPriceResponse, error) {
+	resp, err := mw.share(ctx, "FlightOffersPrice", req, func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightOffersPrice(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersPriceResponse), nil
+}
+
+// Orders are never shared: two bookings of the same offers are two orders,
+// and only the tenant that booked an order may look at it, which a caller
+// riding on someone else's call wouldn't be checked for.
+func (mw coalescemw) CreateFlightOrder(ctx context.Context, req *CreateFlightOrderRequest) (*FlightOrderResponse, error) {
+	return mw.sv.CreateFlightOrder(ctx, req)
+}
+
+func (mw coalescemw) GetFlightOrder(ctx context.Context, req *GetFlightOrderRequest) (*FlightOrderResponse, error) {
+	return mw.sv.GetFlightOrder(ctx, req)
+}
+
+func (mw coalescemw) CancelFlightOrder(ctx context.Context, req *CancelFlightOrderRequest) (*CancelFlightOrderResponse, error) {
+	return mw.sv.CancelFlightOrder(ctx, req)
+}
+
+// SeatMapDisplay shares the seat maps of offers, but not those of an order,
+// for the same reason as GetFlightOrder.
+func (mw coalescemw) SeatMapDisplay(ctx context.Context, req *SeatMapDisplayRequest) (*SeatMapResponse, error) {
+	if req.FlightOrderId != "" {
+		return mw.sv.SeatMapDisplay(ctx, req)
+	}
+
+	resp, err := mw.share(ctx, "SeatMapDisplay", req, func(ctx context.Context) (interface{}, error) {
+		return mw.sv.SeatMapDisplay(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*SeatMapResponse), nil
+}
+
+func (mw coalescemw) BrandedFaresUpsell(ctx context.Context, req *BrandedFaresUpsellRequest) (*FlightOffersResponse, error) {
+	resp, err := mw.share(ctx, "BrandedFaresUpsell", req, func(ctx context.Context) (interface{}, error) {
+		return mw.sv.BrandedFaresUpsell(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersResponse), nil
+}
+
+func (mw coalescemw) FlightAvailabilitiesSearch(ctx context.Context, req *FlightAvailabilitiesSearchRequest) (*FlightAvailabilitiesResponse, error) {
+	resp, err := mw.share(ctx, "FlightAvailabilitiesSearch", req, func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightAvailabilitiesSearch(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightAvailabilitiesResponse), nil
+}
+
+// ============================== quota middleware =============================
+func quotaMiddleware(usage *usageTracker, orders *orderBook) serviceMiddleware {
+	return func(next AmadeusService) AmadeusService {
+		return quotamw{usage, orders, next}
+	}
+}
+
+// quotamw accounts every call that goes upstream to the tenant making it, and
+// refuses the ones past the tenant's quota. It sits behind the cache and the
+// coalescing, so only calls that go upstream count, and a call shared by
+// several callers counts once, for the one that made it; the coalescing checks
+// the quota of the others. A call the client didn't send after all, because
+// the rate limiter refused it or its deadline was too close say, is handed
+// back.
+type quotamw struct {
+	usage  *usageTracker
+	orders *orderBook
+	sv     AmadeusService
+}
+
+// limited makes call if the service wouldn't refuse req anyway, so invalid
+// requests and ones made on an order of another tenant don't use up the
+// quota, and the tenant has quota left. orderId is the order req is made on,
+// if any.
+func (mw quotamw) limited(ctx context.Context, method string, req interface{}, orderId string, call func(context.Context) (interface{}, error)) (interface{}, error) {
+	if v, ok := req.(validator); ok {
+		if err := v.validate(); err != nil {
+			return nil, err
+		}
+	}
+	if orderId != "" {
+		if err := mw.orders.check(ctx, method, orderId); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.usage.reserve(ctx, method); err != nil {
+		return nil, err
+	}
+
+	ctx, sent := withSent(ctx)
+	resp, err := call(ctx)
+	if !sent() {
+		mw.usage.release(ctx, method)
+	}
+	return resp, err
+}
+
+func (mw quotamw) limitedResponse(ctx context.Context, method string, req interface{}, call func(context.Context) (*Response, error)) (*Response, error) {
+	resp, err := mw.limited(ctx, method, req, "", func(ctx context.Context) (interface{}, error) {
+		return call(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*Response), nil
+}
+
+func (mw quotamw) FlightLowFareSearch(ctx context.Context, req *FlightLowFareSearchRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "FlightLowFareSearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightLowFareSearch(ctx, req)
+	})
+}
+
+func (mw quotamw) FlightInspirationSearch(ctx context.Context, req *FlightInspirationSearchRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "FlightInspirationSearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightInspirationSearch(ctx, req)
+	})
+}
+
+func (mw quotamw) FlightCheapestDateSearch(ctx context.Context, req *FlightCheapestDateSearchRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "FlightCheapestDateSearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightCheapestDateSearch(ctx, req)
+	})
+}
+
+func (mw quotamw) FlightMostSearchedDestinations(ctx context.Context, req *FlightMostSearchedDestinationsRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "FlightMostSearchedDestinations", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostSearchedDestinations(ctx, req)
+	})
+}
+
+func (mw quotamw) FlightMostSearchedByDestination(ctx context.Context, req *FlightMostSearchedByDestinationRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "FlightMostSearchedByDestination", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostSearchedByDestination(ctx, req)
+	})
+}
+
+func (mw quotamw) FlightCheckInLinks(ctx context.Context, req *FlightCheckInLinksRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "FlightCheckInLinks", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightCheckInLinks(ctx, req)
+	})
+}
+
+func (mw quotamw) FlightMostTraveledDestinations(ctx context.Context, req *FlightMostTraveledDestinationsRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "FlightMostTraveledDestinations", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostTraveledDestinations(ctx, req)
+	})
+}
+
+func (mw quotamw) FlightMostBookedDestinations(ctx context.Context, req *FlightMostBookedDestinationsRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "FlightMostBookedDestinations", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightMostBookedDestinations(ctx, req)
+	})
+}
+
+func (mw quotamw) FlightBusiestTravelingPeriod(ctx context.Context, req *FlightBusiestTravelingPeriodRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "FlightBusiestTravelingPeriod", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.FlightBusiestTravelingPeriod(ctx, req)
+	})
+}
+
+func (mw quotamw) AirportNearestRelevant(ctx context.Context, req *AirportNearestRelevantRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "AirportNearestRelevant", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirportNearestRelevant(ctx, req)
+	})
+}
+
+func (mw quotamw) AirportAndCitySearch(ctx context.Context, req *AirportAndCitySearchRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "AirportAndCitySearch", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirportAndCitySearch(ctx, req)
+	})
+}
+
+func (mw quotamw) AirlineCodeLookup(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+	return mw.limitedResponse(ctx, "AirlineCodeLookup", req, func(ctx context.Context) (*Response, error) {
+		return mw.sv.AirlineCodeLookup(ctx, req)
+	})
+}
+
+func (mw quotamw) FlightOffersSearch(ctx context.Context, req *FlightOffersSearchRequest) (*FlightOffersResponse, error) {
+	resp, err := mw.limited(ctx, "FlightOffersSearch", req, "", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightOffersSearch(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersResponse), nil
+}
+
+func (mw quotamw) FlightOffersPrice(ctx context.Context, req *FlightOffersPriceRequest) (*FlightOffersPriceResponse, error) {
+	resp, err := mw.limited(ctx, "FlightOffersPrice", req, "", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightOffersPrice(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersPriceResponse), nil
+}
+
+func (mw quotamw) CreateFlightOrder(ctx context.Context, req *CreateFlightOrderRequest) (*FlightOrderResponse, error) {
+	resp, err := mw.limited(ctx, "CreateFlightOrder", req, "", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.CreateFlightOrder(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOrderResponse), nil
+}
+
+func (mw quotamw) GetFlightOrder(ctx context.Context, req *GetFlightOrderRequest) (*FlightOrderResponse, error) {
+	resp, err := mw.limited(ctx, "GetFlightOrder", req, req.FlightOrderId, func(ctx context.Context) (interface{}, error) {
+		return mw.sv.GetFlightOrder(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOrderResponse), nil
+}
+
+func (mw quotamw) CancelFlightOrder(ctx context.Context, req *CancelFlightOrderRequest) (*CancelFlightOrderResponse, error) {
+	resp, err := mw.limited(ctx, "CancelFlightOrder", req, req.FlightOrderId, func(ctx context.Context) (interface{}, error) {
+		return mw.sv.CancelFlightOrder(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*CancelFlightOrderResponse), nil
+}
+
+func (mw quotamw) SeatMapDisplay(ctx context.Context, req *SeatMapDisplayRequest) (*SeatMapResponse, error) {
+	resp, err := mw.limited(ctx, "SeatMapDisplay", req, req.FlightOrderId, func(ctx context.Context) (interface{}, error) {
+		return mw.sv.SeatMapDisplay(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*SeatMapResponse), nil
+}
+
+func (mw quotamw) BrandedFaresUpsell(ctx context.Context, req *BrandedFaresUpsellRequest) (*FlightOffersResponse, error) {
+	resp, err := mw.limited(ctx, "BrandedFaresUpsell", req, "", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.BrandedFaresUpsell(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightOffersResponse), nil
+}
+
+func (mw quotamw) FlightAvailabilitiesSearch(ctx context.Context, req *FlightAvailabilitiesSearchRequest) (*FlightAvailabilitiesResponse, error) {
+	resp, err := mw.limited(ctx, "FlightAvailabilitiesSearch", req, "", func(ctx context.Context) (interface{}, error) {
+		return mw.sv.FlightAvailabilitiesSearch(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*FlightAvailabilitiesResponse), nil
+}
@@ -0,0 +1,435 @@
+package services
+
+import (
+	"amadeus-go/pkg/auth"
+	"amadeus-go/pkg/logging"
+
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/go-redis/redis"
+)
+
+const (
+	defaultIdempotencyTTL = time.Hour * 24
+	// long enough to outlive the trips booked, which are at most about a
+	// year away
+	defaultRecordTTL = time.Hour * 24 * 400
+)
+
+type ordersConf struct {
+	// IdempotencyTTL is how long a retry with the same idempotency key gets
+	// back the order the first call made.
+	IdempotencyTTL duration `json:"IDEMPOTENCY_TTL"`
+	// RecordTTL is how long the record of an order is kept after it was
+	// booked, and with it who may look at or cancel it.
+	RecordTTL duration `json:"RECORD_TTL"`
+}
+
+// orderRecord is what the service remembers of an order it booked.
+type orderRecord struct {
+	Id             string     `json:"id"`
+	Tenant         string     `json:"tenant"`
+	IdempotencyKey string     `json:"idempotencyKey,omitempty"`
+	References     []string   `json:"references,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	CancelledAt    *time.Time `json:"cancelledAt,omitempty"`
+}
+
+// idempotencyEntry is what an idempotency key holds: the hash of the request
+// first made with it and, once booked, the order it made. Unknown marks a
+// booking that may or may not have gone through.
+type idempotencyEntry struct {
+	RequestHash string `json:"requestHash"`
+	OrderId     string `json:"orderId,omitempty"`
+	Unknown     bool   `json:"unknown,omitempty"`
+}
+
+// orderBook keeps a record of the flight orders booked through the service,
+// whose tenant alone may look at or cancel them, and of the idempotency keys
+// they were booked with.
+//
+// Amadeus has no idempotency of its own, so a key is only freed when Amadeus
+// rejected the booking outright. A booking whose outcome is unknown, because
+// the call timed out or Amadeus failed on its side say, keeps its key, and
+// retries are refused until an operator has looked for the order at Amadeus
+// and deleted the key, or IDEMPOTENCY_TTL has passed. So does a booking whose
+// replica died before it could say how it went.
+type orderBook struct {
+	store          orderStore
+	idempotencyTTL time.Duration
+	recordTTL      time.Duration
+	logger         log.Logger
+}
+
+func newOrderBook(configFilename string, redisClient *redis.Client, logger log.Logger) (*orderBook, error) {
+	var conf struct {
+		Orders ordersConf `json:"ORDERS"`
+	}
+
+	err := readConf(configFilename, &conf)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := time.Duration(conf.Orders.IdempotencyTTL)
+	if ttl <= 0 {
+		ttl = defaultIdempotencyTTL
+	}
+	recordTTL := time.Duration(conf.Orders.RecordTTL)
+	if recordTTL <= 0 {
+		recordTTL = defaultRecordTTL
+	}
+
+	var store orderStore
+	if redisClient != nil {
+		store = &redisOrderStore{redisClient}
+	} else {
+		store = &memoryOrderStore{entries: make(map[string]memoryOrderEntry)}
+	}
+
+	return &orderBook{store: store, idempotencyTTL: ttl, recordTTL: recordTTL, logger: logger}, nil
+}
+
+// claim takes the idempotency key of a booking of the tenant in ctx. It
+// returns the ID of the order already booked with it, if any, for the
+// request to be answered with. A key used for another request, by a booking
+// still in flight or by one whose outcome is unknown, is refused.
+func (o *orderBook) claim(ctx context.Context, key string, requestHash string) (string, error) {
+	b, err := json.Marshal(idempotencyEntry{RequestHash: requestHash})
+	if err != nil {
+		return "", err
+	}
+
+	existing, claimed, err := o.store.add(idempotencyKey(tenantOf(ctx), key), b, o.idempotencyTTL)
+	if err != nil {
+		// without the key, a retry could book twice
+		return "", &UnavailableError{&AmadeusError{Route: "CreateFlightOrder", Cause: err}}
+	}
+	if claimed {
+		return "", nil
+	}
+
+	var entry idempotencyEntry
+	if err := json.Unmarshal(existing, &entry); err != nil {
+		return "", err
+	}
+	switch {
+	case entry.RequestHash != requestHash:
+		return "", invalidParameter("CreateFlightOrder", "idempotencyKey", "already used for another booking")
+	case entry.Unknown:
+		return "", bookingConflict("BOOKING OUTCOME UNKNOWN", "the booking made with this idempotency key may have gone through; it has to be checked before the key can be used again")
+	case entry.OrderId == "":
+		return "", bookingConflict("BOOKING IN PROGRESS", "a booking with this idempotency key is still in flight")
+	default:
+		return entry.OrderId, nil
+	}
+}
+
+func bookingConflict(title string, detail string) error {
+	return &ConflictError{&AmadeusError{
+		Route:  "CreateFlightOrder",
+		Status: http.StatusConflict,
+		Errors: []*ErrorWarning{{
+			Status: http.StatusConflict,
+			Title:  title,
+			Detail: detail,
+			Source: &Source{Parameter: "idempotencyKey"},
+		}},
+	}}
+}
+
+// release frees the idempotency key of a booking Amadeus rejected.
+func (o *orderBook) release(ctx context.Context, key string) {
+	if err := o.store.del(idempotencyKey(tenantOf(ctx), key)); err != nil {
+		o.report(ctx, "", "release", err)
+	}
+}
+
+// unknown marks the idempotency key of a booking that may have gone through,
+// for retries to be refused until someone checks. Should that fail, the
+// claim still holds the key.
+func (o *orderBook) unknown(ctx context.Context, key string, requestHash string, cause error) {
+	_ = level.Error(logging.WithContext(o.logger, ctx)).Log(
+		"layer", "orders",
+		"action", "unknown",
+		"idempotency_key", idempotencyKey(tenantOf(ctx), key),
+		"error", cause,
+	)
+
+	b, err := json.Marshal(idempotencyEntry{RequestHash: requestHash, Unknown: true})
+	if err == nil {
+		err = o.store.set(idempotencyKey(tenantOf(ctx), key), b, o.idempotencyTTL)
+	}
+	if err != nil {
+		o.report(ctx, "", "unknown", err)
+	}
+}
+
+// record remembers an order the tenant in ctx booked, and points the
+// idempotency key it was booked with, if any, at it. The order is booked
+// whatever happens here: failures are logged as errors, and the one of the
+// record, without which only admins may get at the order, is returned for
+// the caller to be told.
+func (o *orderBook) record(ctx context.Context, key string, requestHash string, order *FlightOrder) error {
+	tenant := tenantOf(ctx)
+
+	rec := orderRecord{
+		Id:             order.Id,
+		Tenant:         tenant,
+		IdempotencyKey: key,
+		CreatedAt:      time.Now().UTC(),
+	}
+	for _, r := range order.AssociatedRecords {
+		if r != nil {
+			rec.References = append(rec.References, r.Reference)
+		}
+	}
+	saveErr := o.save(rec)
+	if saveErr != nil {
+		o.failed(ctx, order.Id, "record", saveErr)
+	}
+
+	if key != "" {
+		// the claim keeps holding the key should this fail, so retries are
+		// refused rather than booking again
+		b, err := json.Marshal(idempotencyEntry{RequestHash: requestHash, OrderId: order.Id})
+		if err == nil {
+			err = o.store.set(idempotencyKey(tenant, key), b, o.idempotencyTTL)
+		}
+		if err != nil {
+			o.failed(ctx, order.Id, "idempotency", err)
+		}
+	}
+	return saveErr
+}
+
+// check tells whether the caller in ctx may look at or cancel an order:
+// tenants may only touch the orders they booked, admins any of them. Orders
+// without a record, booked elsewhere or forgotten by the store, are left to
+// admins.
+func (o *orderBook) check(ctx context.Context, route string, orderId string) error {
+	if orderId == "" {
+		return invalidParameter(route, "flightOrderId", "is required")
+	}
+
+	caller, authenticated := auth.FromContext(ctx)
+	if !authenticated || caller.Admin {
+		return nil
+	}
+
+	rec, ok, err := o.load(orderId)
+	if err != nil {
+		return &UnavailableError{&AmadeusError{Route: route, Cause: err}}
+	}
+	if !ok || rec.Tenant != caller.ID {
+		return auth.ErrPermissionDenied
+	}
+	return nil
+}
+
+// cancelled marks the record of an order as cancelled.
+func (o *orderBook) cancelled(ctx context.Context, orderId string) {
+	rec, ok, err := o.load(orderId)
+	if err != nil || !ok {
+		if err != nil {
+			o.report(ctx, orderId, "cancel", err)
+		}
+		return
+	}
+
+	now := time.Now().UTC()
+	rec.CancelledAt = &now
+	if err := o.save(rec); err != nil {
+		o.report(ctx, orderId, "cancel", err)
+	}
+}
+
+func (o *orderBook) load(orderId string) (orderRecord, bool, error) {
+	var rec orderRecord
+	b, ok, err := o.store.get(orderKey(orderId))
+	if err != nil || !ok {
+		return rec, false, err
+	}
+
+	err = json.Unmarshal(b, &rec)
+	return rec, err == nil, err
+}
+
+// save keeps a record until recordTTL after the order was booked, however
+// often it is saved again.
+func (o *orderBook) save(rec orderRecord) error {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	ttl := rec.CreatedAt.Add(o.recordTTL).Sub(time.Now())
+	if ttl < time.Millisecond {
+		// about to expire anyway; a ttl of 0 would keep it for good
+		ttl = time.Millisecond
+	}
+	return o.store.set(orderKey(rec.Id), b, ttl)
+}
+
+func (o *orderBook) report(ctx context.Context, orderId string, action string, err error) {
+	_ = level.Warn(logging.WithContext(o.logger, ctx)).Log(
+		"layer", "orders",
+		"order", orderId,
+		"action", action,
+		"error", err,
+	)
+}
+
+// failed logs what the order book couldn't do, for orders that are booked
+// all the same.
+func (o *orderBook) failed(ctx context.Context, orderId string, action string, err error) {
+	_ = level.Error(logging.WithContext(o.logger, ctx)).Log(
+		"layer", "orders",
+		"order", orderId,
+		"action", action,
+		"error", err,
+	)
+}
+
+func orderKey(orderId string) string {
+	return "amadeus-go:orders:" + orderId
+}
+
+func idempotencyKey(tenant string, key string) string {
+	return "amadeus-go:idempotency:" + tenant + ":" + key
+}
+
+// orderStore holds the records of orders and the idempotency keys. A ttl of
+// 0 keeps a value for good.
+type orderStore interface {
+	// add sets key to value unless it is set already, in which case it
+	// returns what key holds and false
+	add(key string, value []byte, ttl time.Duration) ([]byte, bool, error)
+	set(key string, value []byte, ttl time.Duration) error
+	get(key string) ([]byte, bool, error)
+	del(key string) error
+}
+
+type redisOrderStore struct {
+	redisClient *redis.Client
+}
+
+func (s *redisOrderStore) add(key string, value []byte, ttl time.Duration) ([]byte, bool, error) {
+	// the key may expire between the two calls, hence the second try
+	for i := 0; i < 2; i++ {
+		added, err := s.redisClient.SetNX(key, value, ttl).Result()
+		if err != nil || added {
+			return nil, added, err
+		}
+
+		existing, ok, err := s.get(key)
+		if err != nil || ok {
+			return existing, false, err
+		}
+	}
+	return nil, false, redis.Nil
+}
+
+func (s *redisOrderStore) set(key string, value []byte, ttl time.Duration) error {
+	return s.redisClient.Set(key, value, ttl).Err()
+}
+
+func (s *redisOrderStore) get(key string) ([]byte, bool, error) {
+	b, err := s.redisClient.Get(key).Bytes()
+	if err == redis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return b, true, nil
+}
+
+func (s *redisOrderStore) del(key string) error {
+	return s.redisClient.Del(key).Err()
+}
+
+// memoryOrderSweepInterval is how often the memory store drops the entries
+// that expired without being looked at again
+const memoryOrderSweepInterval = time.Minute
+
+// memoryOrderStore keeps the orders of a single replica, for when there is
+// no Redis. They are lost on restart.
+type memoryOrderStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryOrderEntry
+	sweptAt time.Time
+}
+
+type memoryOrderEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func (s *memoryOrderStore) add(key string, value []byte, ttl time.Duration) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.live(key); ok {
+		return e.value, false, nil
+	}
+	s.put(key, value, ttl)
+	return nil, true, nil
+}
+
+func (s *memoryOrderStore) set(key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(key, value, ttl)
+	return nil
+}
+
+func (s *memoryOrderStore) get(key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.live(key)
+	return e.value, ok, nil
+}
+
+func (s *memoryOrderStore) del(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+func (s *memoryOrderStore) live(key string) (memoryOrderEntry, bool) {
+	e, ok := s.entries[key]
+	if ok && !e.expiresAt.IsZero() && time.Now().After(e.expiresAt) {
+		delete(s.entries, key)
+		return memoryOrderEntry{}, false
+	}
+	return e, ok
+}
+
+func (s *memoryOrderStore) put(key string, value []byte, ttl time.Duration) {
+	now := time.Now()
+	if now.Sub(s.sweptAt) >= memoryOrderSweepInterval {
+		for k, e := range s.entries {
+			if !e.expiresAt.IsZero() && now.After(e.expiresAt) {
+				delete(s.entries, k)
+			}
+		}
+		s.sweptAt = now
+	}
+
+	e := memoryOrderEntry{value: value}
+	if ttl > 0 {
+		e.expiresAt = now.Add(ttl)
+	}
+	s.entries[key] = e
+}
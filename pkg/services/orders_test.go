@@ -0,0 +1,297 @@
+package services
+
+import (
+	"amadeus-go/pkg/auth"
+
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+)
+
+func newTestOrderBook(idempotencyTTL time.Duration, recordTTL time.Duration) *orderBook {
+	return &orderBook{
+		store:          &memoryOrderStore{entries: make(map[string]memoryOrderEntry)},
+		idempotencyTTL: idempotencyTTL,
+		recordTTL:      recordTTL,
+		logger:         log.NewNopLogger(),
+	}
+}
+
+// fakeOrders books an order for every POST, unless script says otherwise,
+// and answers GETs with the order asked for.
+type fakeOrders struct {
+	mu     sync.Mutex
+	script []int
+	posts  int
+	gets   int
+}
+
+func (f *fakeOrders) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method == http.MethodGet {
+		f.gets++
+		_, _ = fmt.Fprintf(w, `{"data":{"type":"flight-order","id":%q}}`, r.URL.Path[len("/v1/booking/flight-orders/"):])
+		return
+	}
+
+	f.posts++
+	status := http.StatusCreated
+	if f.posts <= len(f.script) {
+		status = f.script[f.posts-1]
+	}
+	w.WriteHeader(status)
+	if status == http.StatusCreated {
+		_, _ = fmt.Fprintf(w, `{"data":{"type":"flight-order","id":"ORDER%d","associatedRecords":[{"reference":"REF%d"}]}}`, f.posts, f.posts)
+		return
+	}
+	_, _ = fmt.Fprintf(w, `{"errors":[{"status":%d,"title":"FAILED"}]}`, status)
+}
+
+func (f *fakeOrders) count() (int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.posts, f.gets
+}
+
+func newTestBooking(t *testing.T, upstream *fakeOrders) *amadeusService {
+	c, url := newTestClient(t, upstream, &fakeAuth{expiresIn: 1800})
+	return &amadeusService{
+		client: c,
+		orders: newTestOrderBook(time.Hour, time.Hour),
+		urls:   &serviceUrls{ApiBaseUrl: url, FlightOrders: "/v1/booking/flight-orders"},
+	}
+}
+
+func booking(key string, traveler string) *CreateFlightOrderRequest {
+	return &CreateFlightOrderRequest{
+		Travelers:      []*Traveler{{Id: "1", Name: &TravelerName{FirstName: traveler}}},
+		IdempotencyKey: key,
+	}
+}
+
+func TestCreateFlightOrderIdempotency(t *testing.T) {
+	acme, other := tenantContext("acme"), tenantContext("other")
+
+	// a booking, and what it must get back: an order ID or the kind of error
+	type step struct {
+		ctx  context.Context
+		req  *CreateFlightOrderRequest
+		want string
+	}
+
+	for _, tc := range []struct {
+		name      string
+		script    []int
+		steps     []step
+		wantPosts int
+	}{
+		{
+			name: "replayed",
+			steps: []step{
+				{acme, booking("k", "JANE"), "ORDER1"},
+				{acme, booking("k", "JANE"), "ORDER1"},
+			},
+			wantPosts: 1,
+		},
+		{
+			name: "key used for another booking",
+			steps: []step{
+				{acme, booking("k", "JANE"), "ORDER1"},
+				{acme, booking("k", "JOHN"), "validation"},
+			},
+			wantPosts: 1,
+		},
+		{
+			name:   "rejected booking frees the key",
+			script: []int{http.StatusBadRequest},
+			steps: []step{
+				{acme, booking("k", "JANE"), "validation"},
+				{acme, booking("k", "JANE"), "ORDER2"},
+			},
+			wantPosts: 2,
+		},
+		{
+			name:   "unknown outcome keeps the key",
+			script: []int{http.StatusInternalServerError},
+			steps: []step{
+				{acme, booking("k", "JANE"), "unavailable"},
+				{acme, booking("k", "JANE"), "conflict"},
+			},
+			wantPosts: 1,
+		},
+		{
+			name: "keys of each tenant",
+			steps: []step{
+				{acme, booking("k", "JANE"), "ORDER1"},
+				{other, booking("k", "JANE"), "ORDER2"},
+			},
+			wantPosts: 2,
+		},
+		{
+			name: "without a key",
+			steps: []step{
+				{acme, booking("", "JANE"), "ORDER1"},
+				{acme, booking("", "JANE"), "ORDER2"},
+			},
+			wantPosts: 2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			upstream := &fakeOrders{script: tc.script}
+			aSrv := newTestBooking(t, upstream)
+
+			for i, step := range tc.steps {
+				resp, err := aSrv.CreateFlightOrder(step.ctx, step.req)
+				got := ErrorKind(err)
+				if err == nil {
+					got = resp.Data.Id
+				}
+				if got != step.want {
+					t.Fatalf("booking %d: got %s (%v), want %s", i, got, err, step.want)
+				}
+			}
+			if posts, _ := upstream.count(); posts != tc.wantPosts {
+				t.Errorf("Amadeus got %d bookings, want %d", posts, tc.wantPosts)
+			}
+		})
+	}
+}
+
+func TestCreateFlightOrderInFlight(t *testing.T) {
+	upstream := &fakeOrders{}
+	aSrv := newTestBooking(t, upstream)
+	ctx := tenantContext("acme")
+
+	req := booking("k", "JANE")
+	hash, err := cacheKey("CreateFlightOrder", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := aSrv.orders.claim(ctx, "k", hash); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := aSrv.CreateFlightOrder(ctx, req); ErrorKind(err) != "conflict" {
+		t.Fatalf("got %v, want the booking in flight to be a conflict", err)
+	}
+	if posts, _ := upstream.count(); posts != 0 {
+		t.Errorf("Amadeus got %d bookings while the first was in flight", posts)
+	}
+}
+
+func TestCreateFlightOrderRecordsOwner(t *testing.T) {
+	aSrv := newTestBooking(t, &fakeOrders{})
+
+	resp, err := aSrv.CreateFlightOrder(tenantContext("acme"), booking("", "JANE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec, ok, err := aSrv.orders.load(resp.Data.Id)
+	if err != nil || !ok {
+		t.Fatalf("the order wasn't recorded: %v", err)
+	}
+	if rec.Tenant != "acme" || len(rec.References) != 1 || rec.References[0] != "REF1" {
+		t.Errorf("got record %+v", rec)
+	}
+}
+
+func TestIdempotencyKeyExpires(t *testing.T) {
+	o := newTestOrderBook(time.Millisecond*50, time.Hour)
+	ctx := tenantContext("acme")
+
+	if _, err := o.claim(ctx, "k", "hash"); err != nil {
+		t.Fatal(err)
+	}
+	o.unknown(ctx, "k", "hash", fmt.Errorf("timed out"))
+	if _, err := o.claim(ctx, "k", "hash"); ErrorKind(err) != "conflict" {
+		t.Fatalf("got %v, want the unknown booking to hold the key", err)
+	}
+
+	time.Sleep(time.Millisecond * 100)
+	if _, err := o.claim(ctx, "k", "hash"); err != nil {
+		t.Fatalf("the key is still held past its TTL: %v", err)
+	}
+}
+
+func TestOrderOwnership(t *testing.T) {
+	o := newTestOrderBook(time.Hour, time.Hour)
+	if err := o.record(tenantContext("acme"), "", "", &FlightOrder{Id: "1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		ctx     context.Context
+		orderId string
+		want    error
+	}{
+		{"owner", tenantContext("acme"), "1", nil},
+		{"another tenant", tenantContext("other"), "1", auth.ErrPermissionDenied},
+		{"admin", auth.NewContext(context.Background(), &auth.Tenant{ID: "ops", Admin: true}), "1", nil},
+		{"authentication off", context.Background(), "1", nil},
+		{"order without a record", tenantContext("acme"), "2", auth.ErrPermissionDenied},
+		{"admin on an order without a record", auth.NewContext(context.Background(), &auth.Tenant{ID: "ops", Admin: true}), "2", nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := o.check(tc.ctx, "GetFlightOrder", tc.orderId); err != tc.want {
+				t.Errorf("got %v, want %v", err, tc.want)
+			}
+		})
+	}
+
+	if err := o.check(tenantContext("acme"), "GetFlightOrder", ""); ErrorKind(err) != "validation" {
+		t.Errorf("got %v for no order ID, want a validation error", err)
+	}
+}
+
+func TestOrderRecordExpires(t *testing.T) {
+	o := newTestOrderBook(time.Hour, time.Millisecond*200)
+	ctx := tenantContext("acme")
+	if err := o.record(ctx, "", "", &FlightOrder{Id: "1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// cancelling saves the record again, without pushing back its expiry
+	time.Sleep(time.Millisecond * 100)
+	o.cancelled(ctx, "1")
+	rec, ok, err := o.load("1")
+	if err != nil || !ok || rec.CancelledAt == nil {
+		t.Fatalf("got record %+v, %t, %v, want it cancelled", rec, ok, err)
+	}
+
+	time.Sleep(time.Millisecond * 150)
+	if _, ok, _ := o.load("1"); ok {
+		t.Fatal("the record outlived its TTL")
+	}
+	if err := o.check(ctx, "GetFlightOrder", "1"); err != auth.ErrPermissionDenied {
+		t.Errorf("got %v, want an expired order left to admins", err)
+	}
+}
+
+func TestMemoryOrderStoreSweepsExpiredEntries(t *testing.T) {
+	s := &memoryOrderStore{entries: make(map[string]memoryOrderEntry)}
+	_ = s.set("expiring", []byte("1"), time.Millisecond)
+	_ = s.set("kept", []byte("2"), 0)
+
+	time.Sleep(time.Millisecond * 5)
+	s.mu.Lock()
+	s.sweptAt = time.Time{}
+	s.mu.Unlock()
+	_ = s.set("new", []byte("3"), time.Hour)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entries["expiring"]; ok {
+		t.Error("an expired entry nobody looked at again is still held")
+	}
+	if len(s.entries) != 2 {
+		t.Errorf("got %d entries, want 2", len(s.entries))
+	}
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const defaultRateLimitWait = time.Second * 2
+
+type rateConf struct {
+	TPS   float64 `json:"TPS"`
+	Burst int     `json:"BURST"`
+}
+
+type rateLimitConf struct {
+	rateConf
+	Endpoints map[string]rateConf `json:"ENDPOINTS"`
+	// MODE is either "wait" (the default), to queue calls for at most
+	// MAX_WAIT, or "fail" to reject them as soon as the bucket is empty
+	Mode    string   `json:"MODE"`
+	MaxWait duration `json:"MAX_WAIT"`
+}
+
+// rateLimiter keeps outbound calls within the transactions per second
+// Amadeus allows, with a token bucket for the whole service and optionally
+// one per route.
+type rateLimiter struct {
+	global   *rate.Limiter
+	routes   map[string]*rate.Limiter
+	failFast bool
+	maxWait  time.Duration
+}
+
+// newRateLimiter returns nil, meaning no limit, when no TPS is configured.
+func newRateLimiter(c rateLimitConf) *rateLimiter {
+	l := &rateLimiter{
+		routes:   make(map[string]*rate.Limiter),
+		failFast: c.Mode == "fail",
+		maxWait:  time.Duration(c.MaxWait),
+	}
+	if l.maxWait <= 0 {
+		l.maxWait = defaultRateLimitWait
+	}
+
+	if c.TPS > 0 {
+		l.global = newBucket(c.rateConf)
+	}
+	for route, rc := range c.Endpoints {
+		if rc.TPS > 0 {
+			l.routes[route] = newBucket(rc)
+		}
+	}
+
+	if l.global == nil && len(l.routes) == 0 {
+		return nil
+	}
+	return l
+}
+
+func newBucket(c rateConf) *rate.Limiter {
+	burst := c.Burst
+	if burst <= 0 {
+		burst = int(c.TPS)
+	}
+	if burst <= 0 {
+		burst = 1
+	}
+
+	return rate.NewLimiter(rate.Limit(c.TPS), burst)
+}
+
+// wait returns once route may make a call. It fails with a RateLimitError
+// when the call would have to wait in fail-fast mode, longer than MAX_WAIT,
+// or past the deadline of ctx.
+func (l *rateLimiter) wait(ctx context.Context, route string) error {
+	if l == nil {
+		return nil
+	}
+
+	var buckets []*rate.Limiter
+	if b, ok := l.routes[route]; ok {
+		buckets = append(buckets, b)
+	}
+	if l.global != nil {
+		buckets = append(buckets, l.global)
+	}
+
+	// reserve from every bucket at once, so a call rejected by one of them
+	// hands back what it took from the others
+	now := time.Now()
+	var (
+		reservations []*rate.Reservation
+		delay        time.Duration
+	)
+	cancel := func() {
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+	}
+	for _, b := range buckets {
+		r := b.ReserveN(now, 1)
+		if !r.OK() {
+			cancel()
+			return rateLimitError(route, 0)
+		}
+		reservations = append(reservations, r)
+
+		if d := r.DelayFrom(now); d > delay {
+			delay = d
+		}
+	}
+
+	if delay == 0 {
+		return nil
+	}
+	if l.failFast || delay > l.maxWait || !fitsDeadline(ctx, delay) {
+		cancel()
+		return rateLimitError(route, delay)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		cancel()
+		return ctx.Err()
+	}
+}
+
+func rateLimitError(route string, retryAfter time.Duration) error {
+	return &RateLimitError{
+		AmadeusError: &AmadeusError{Route: route, Cause: errors.New("local rate limit exceeded")},
+		RetryAfter:   retryAfter,
+	}
+}
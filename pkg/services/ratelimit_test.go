@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterOff(t *testing.T) {
+	for _, c := range []rateLimitConf{
+		{},
+		{Endpoints: map[string]rateConf{"AirlineCodeLookup": {}}},
+	} {
+		if l := newRateLimiter(c); l != nil {
+			t.Errorf("%+v: got a limiter, want none", c)
+		}
+	}
+
+	var l *rateLimiter
+	if err := l.wait(context.Background(), "AirlineCodeLookup"); err != nil {
+		t.Fatalf("no limiter refused a call: %v", err)
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		conf  rateLimitConf
+		route string
+		// calls made back to back, and whether the last one must be
+		// refused or have waited
+		calls       int
+		wantRefused bool
+		wantWait    bool
+	}{
+		{
+			name:  "within the burst",
+			conf:  rateLimitConf{rateConf: rateConf{TPS: 10, Burst: 3}},
+			route: "AirlineCodeLookup",
+			calls: 3,
+		},
+		{
+			name:     "queued past the burst",
+			conf:     rateLimitConf{rateConf: rateConf{TPS: 20, Burst: 1}},
+			route:    "AirlineCodeLookup",
+			calls:    2,
+			wantWait: true,
+		},
+		{
+			name:        "fail fast",
+			conf:        rateLimitConf{rateConf: rateConf{TPS: 20, Burst: 1}, Mode: "fail"},
+			route:       "AirlineCodeLookup",
+			calls:       2,
+			wantRefused: true,
+		},
+		{
+			name:        "longer than MAX_WAIT",
+			conf:        rateLimitConf{rateConf: rateConf{TPS: 1, Burst: 1}, MaxWait: duration(time.Millisecond * 100)},
+			route:       "AirlineCodeLookup",
+			calls:       2,
+			wantRefused: true,
+		},
+		{
+			name: "route bucket",
+			conf: rateLimitConf{
+				rateConf:  rateConf{TPS: 100, Burst: 100},
+				Endpoints: map[string]rateConf{"FlightOffersSearch": {TPS: 1, Burst: 1}},
+				Mode:      "fail",
+			},
+			route:       "FlightOffersSearch",
+			calls:       2,
+			wantRefused: true,
+		},
+		{
+			name: "other routes unaffected by a route bucket",
+			conf: rateLimitConf{
+				rateConf:  rateConf{TPS: 100, Burst: 100},
+				Endpoints: map[string]rateConf{"FlightOffersSearch": {TPS: 1, Burst: 1}},
+				Mode:      "fail",
+			},
+			route: "AirlineCodeLookup",
+			calls: 2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			l := newRateLimiter(tc.conf)
+
+			for i := 1; i < tc.calls; i++ {
+				if err := l.wait(context.Background(), tc.route); err != nil {
+					t.Fatalf("call %d refused: %v", i, err)
+				}
+			}
+
+			start := time.Now()
+			err := l.wait(context.Background(), tc.route)
+			waited := time.Since(start) > time.Millisecond*20
+
+			if tc.wantRefused {
+				rlErr, ok := err.(*RateLimitError)
+				if !ok {
+					t.Fatalf("got %v, want a RateLimitError", err)
+				}
+				if rlErr.RetryAfter <= 0 {
+					t.Error("the refusal doesn't say when to retry")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("the last call was refused: %v", err)
+			}
+			if waited != tc.wantWait {
+				t.Errorf("the last call waited: %t, want %t", waited, tc.wantWait)
+			}
+		})
+	}
+}
+
+func TestRateLimiterRespectsDeadline(t *testing.T) {
+	l := newRateLimiter(rateLimitConf{rateConf: rateConf{TPS: 1, Burst: 1}})
+	if err := l.wait(context.Background(), "AirlineCodeLookup"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the next token is a second away, past the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	start := time.Now()
+	if _, ok := l.wait(ctx, "AirlineCodeLookup").(*RateLimitError); !ok {
+		t.Fatal("a call that can't make its deadline wasn't refused")
+	}
+	if time.Since(start) > time.Millisecond*50 {
+		t.Error("the refused call waited")
+	}
+}
+
+func TestRateLimiterRefusalHandsBackTokens(t *testing.T) {
+	l := newRateLimiter(rateLimitConf{
+		rateConf:  rateConf{TPS: 1, Burst: 2},
+		Endpoints: map[string]rateConf{"FlightOffersSearch": {TPS: 1, Burst: 1}},
+		Mode:      "fail",
+	})
+
+	// the route bucket is empty after the first search, so the second one
+	// is refused, and doesn't keep the token it took from the global bucket
+	if err := l.wait(context.Background(), "FlightOffersSearch"); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.wait(context.Background(), "FlightOffersSearch"); err == nil {
+		t.Fatal("the second search wasn't refused")
+	}
+	if err := l.wait(context.Background(), "AirlineCodeLookup"); err != nil {
+		t.Fatalf("the refused search used up the global bucket: %v", err)
+	}
+}
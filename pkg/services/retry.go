@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryAttempts = 3
+	defaultRetryBase     = time.Millisecond * 200
+	defaultRetryMax      = time.Second * 5
+)
+
+type retryConf struct {
+	MaxAttempts int      `json:"MAX_ATTEMPTS"`
+	Base        duration `json:"BASE"`
+	Max         duration `json:"MAX"`
+}
+
+// retryPolicy decides how often and how far apart failed upstream calls are
+// tried again.
+type retryPolicy struct {
+	maxAttempts int
+	base        time.Duration
+	max         time.Duration
+}
+
+func newRetryPolicy(c retryConf) retryPolicy {
+	p := retryPolicy{
+		maxAttempts: c.MaxAttempts,
+		base:        time.Duration(c.Base),
+		max:         time.Duration(c.Max),
+	}
+	if p.maxAttempts <= 0 {
+		p.maxAttempts = defaultRetryAttempts
+	}
+	if p.base <= 0 {
+		p.base = defaultRetryBase
+	}
+	if p.max <= 0 {
+		p.max = defaultRetryMax
+	}
+
+	return p
+}
+
+// retryable tells whether req may be sent again after a failure that might
+// have reached Amadeus. Only GETs are safe to repeat blindly, including the
+// POSTs that stand in for one.
+func (p retryPolicy) retryable(req *http.Request) bool {
+	return req.Method == "GET" || req.Header.Get(methodOverrideHeader) == "GET"
+}
+
+// delay is how long to wait before the given attempt after err. A rejected
+// token is retried at once, and Amadeus's own Retry-After wins over backoff,
+// up to the same maximum.
+func (p retryPolicy) delay(attempt int, err error) time.Duration {
+	if classify(err) == classUnauthorized {
+		return 0
+	}
+	if uErr, ok := err.(*upstreamError); ok && uErr.retryAfter > 0 {
+		if uErr.retryAfter > p.max {
+			return p.max
+		}
+		return uErr.retryAfter
+	}
+
+	return Backoff(attempt, p.base, p.max)
+}
+
+// fitsDeadline tells whether waiting for wait still leaves ctx time to make
+// another call.
+func fitsDeadline(ctx context.Context, wait time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return !ok || time.Until(deadline) > wait
+}
+
+type errorClass int
+
+const (
+	classOther errorClass = iota
+	classNetwork
+	classServer
+	classRateLimited
+	classUnauthorized
+	classClient
+)
+
+// classify sorts an error returned by a single upstream call.
+func classify(err error) errorClass {
+	switch e := err.(type) {
+	case nil:
+		return classOther
+	case *tokenError:
+		return classOther
+	case *upstreamError:
+		switch {
+		case e.StatusCode == http.StatusTooManyRequests:
+			return classRateLimited
+		case e.StatusCode == http.StatusUnauthorized:
+			return classUnauthorized
+		case e.StatusCode >= 500:
+			return classServer
+		default:
+			return classClient
+		}
+	default:
+		// transport failures, including an attempt running out of time
+		return classNetwork
+	}
+}
+
+// upstreamError is a non-2xx answer from Amadeus, along with the errors it
+// listed in the body if it sent any.
+type upstreamError struct {
+	Route      string
+	StatusCode int
+	Body       *Response
+	retryAfter time.Duration
+}
+
+func (e *upstreamError) Error() string {
+	msg := fmt.Sprintf("amadeus %s responded %d %s", e.Route, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Body != nil && len(e.Body.Errors) > 0 {
+		first := e.Body.Errors[0]
+		msg += ": " + first.Title
+		if first.Detail != "" {
+			msg += " (" + first.Detail + ")"
+		}
+	}
+	return msg
+}
+
+// tokenError means no call was made because there was no token to make it
+// with; the token manager has already retried by then.
+type tokenError struct {
+	err error
+}
+
+func (e *tokenError) Error() string {
+	return "no access token: " + e.err.Error()
+}
+
+// parseRetryAfter reads a Retry-After header, given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at)
+	}
+
+	return 0
+}
@@ -0,0 +1,294 @@
+package services
+
+import (
+	"amadeus-go/pkg/instrumenting"
+
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// fakeAmadeus answers the calls to an Amadeus route with the statuses of
+// script in turn, repeating the last one, after holding each call for delay.
+// Calls authorised with a token in rejected are answered with a 401.
+type fakeAmadeus struct {
+	calls      int32
+	script     []int
+	retryAfter string
+	delay      time.Duration
+	rejected   string
+}
+
+func (f *fakeAmadeus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := int(atomic.AddInt32(&f.calls, 1))
+	if f.delay > 0 {
+		select {
+		case <-time.After(f.delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	if f.rejected != "" && r.Header.Get("Authorization") == "Bearer "+f.rejected {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errors":[{"status":401,"code":38192,"title":"Invalid access token"}]}`))
+		return
+	}
+
+	status := http.StatusOK
+	if len(f.script) > 0 {
+		status = f.script[len(f.script)-1]
+		if n <= len(f.script) {
+			status = f.script[n-1]
+		}
+	}
+	if f.retryAfter != "" {
+		w.Header().Set("Retry-After", f.retryAfter)
+	}
+	w.WriteHeader(status)
+	if status == http.StatusOK {
+		_, _ = w.Write([]byte(`{"data":[{"type":"airline","id":"BA"}]}`))
+		return
+	}
+	_, _ = fmt.Fprintf(w, `{"errors":[{"status":%d,"title":"FAILED"}]}`, status)
+}
+
+func (f *fakeAmadeus) count() int32 {
+	return atomic.LoadInt32(&f.calls)
+}
+
+// newTestClient returns a client of upstream, with tokens from auth, that
+// retries quickly.
+func newTestClient(t *testing.T, upstream http.Handler, auth *fakeAuth) (*upstreamClient, string) {
+	server := httptest.NewServer(upstream)
+	t.Cleanup(server.Close)
+
+	c := &upstreamClient{
+		httpClient: server.Client(),
+		tokens:     newTestTokenManager(t, auth),
+		retry:      newRetryPolicy(retryConf{Base: duration(time.Millisecond), Max: duration(time.Millisecond * 5)}),
+		metrics:    instrumenting.DiscardMetrics(),
+		tracer:     trace.NewNoopTracerProvider().Tracer(""),
+		userAgent:  defaultUserAgent,
+		timeout:    time.Second,
+		timeouts:   make(map[string]time.Duration),
+	}
+	return c, server.URL
+}
+
+func TestClassify(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  error
+		want errorClass
+	}{
+		{"none", nil, classOther},
+		{"no token", &tokenError{errors.New("down")}, classOther},
+		{"rate limited", &upstreamError{StatusCode: http.StatusTooManyRequests}, classRateLimited},
+		{"unauthorized", &upstreamError{StatusCode: http.StatusUnauthorized}, classUnauthorized},
+		{"server", &upstreamError{StatusCode: http.StatusBadGateway}, classServer},
+		{"client", &upstreamError{StatusCode: http.StatusBadRequest}, classClient},
+		{"network", &net.OpError{Op: "dial", Err: errors.New("refused")}, classNetwork},
+		{"timeout", context.DeadlineExceeded, classNetwork},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := classify(tc.err); got != tc.want {
+				t.Errorf("got class %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	p := newRetryPolicy(retryConf{})
+	for _, tc := range []struct {
+		method   string
+		override string
+		want     bool
+	}{
+		{http.MethodGet, "", true},
+		{http.MethodPost, "GET", true},
+		{http.MethodPost, "", false},
+		{http.MethodDelete, "", false},
+	} {
+		req, _ := http.NewRequest(tc.method, "http://amadeus", nil)
+		if tc.override != "" {
+			req.Header.Set(methodOverrideHeader, tc.override)
+		}
+		if got := p.retryable(req); got != tc.want {
+			t.Errorf("%s overriding %q: got %t, want %t", tc.method, tc.override, got, tc.want)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		min   time.Duration
+		max   time.Duration
+	}{
+		{"", 0, 0},
+		{"2", time.Second * 2, time.Second * 2},
+		{"soon", 0, 0},
+		{time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), time.Second * 58, time.Minute},
+	} {
+		if got := parseRetryAfter(tc.value); got < tc.min || got > tc.max {
+			t.Errorf("%q: got %s, want between %s and %s", tc.value, got, tc.min, tc.max)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	p := newRetryPolicy(retryConf{Base: duration(time.Millisecond), Max: duration(time.Second * 5)})
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		min  time.Duration
+		max  time.Duration
+	}{
+		{"rejected token", &upstreamError{StatusCode: http.StatusUnauthorized}, 0, 0},
+		{"backoff", &upstreamError{StatusCode: http.StatusBadGateway}, 0, time.Millisecond * 4},
+		{"Retry-After", &upstreamError{StatusCode: http.StatusTooManyRequests, retryAfter: time.Second}, time.Second, time.Second},
+		{"Retry-After capped", &upstreamError{StatusCode: http.StatusTooManyRequests, retryAfter: time.Hour}, time.Second * 5, time.Second * 5},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := p.delay(3, tc.err); got < tc.min || got > tc.max {
+				t.Errorf("got a delay of %s, want between %s and %s", got, tc.min, tc.max)
+			}
+		})
+	}
+}
+
+func TestSendRetries(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		method   string
+		upstream *fakeAmadeus
+		timeout  time.Duration
+		// the number of calls Amadeus should get, and the status of the
+		// failure returned, if any
+		wantCalls  int32
+		wantStatus int
+		wantErr    bool
+	}{
+		{
+			name:      "success",
+			method:    http.MethodGet,
+			upstream:  &fakeAmadeus{},
+			wantCalls: 1,
+		},
+		{
+			name:      "server error retried",
+			method:    http.MethodGet,
+			upstream:  &fakeAmadeus{script: []int{500, 200}},
+			wantCalls: 2,
+		},
+		{
+			name:      "rate limit retried",
+			method:    http.MethodGet,
+			upstream:  &fakeAmadeus{script: []int{429, 429, 200}, retryAfter: "0"},
+			wantCalls: 3,
+		},
+		{
+			name:       "attempts used up",
+			method:     http.MethodGet,
+			upstream:   &fakeAmadeus{script: []int{503}},
+			wantCalls:  defaultRetryAttempts,
+			wantStatus: 503,
+			wantErr:    true,
+		},
+		{
+			name:       "POST not retried",
+			method:     http.MethodPost,
+			upstream:   &fakeAmadeus{script: []int{500, 200}},
+			wantCalls:  1,
+			wantStatus: 500,
+			wantErr:    true,
+		},
+		{
+			name:       "client error not retried",
+			method:     http.MethodGet,
+			upstream:   &fakeAmadeus{script: []int{400, 200}},
+			wantCalls:  1,
+			wantStatus: 400,
+			wantErr:    true,
+		},
+		{
+			name:      "rejected token replaced",
+			method:    http.MethodPost,
+			upstream:  &fakeAmadeus{rejected: "t1"},
+			wantCalls: 2,
+		},
+		{
+			name:      "route timeout retried",
+			method:    http.MethodGet,
+			upstream:  &fakeAmadeus{delay: time.Millisecond * 200},
+			timeout:   time.Millisecond * 20,
+			wantCalls: defaultRetryAttempts,
+			wantErr:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c, url := newTestClient(t, tc.upstream, &fakeAuth{expiresIn: 1800})
+			if tc.timeout > 0 {
+				c.timeouts["AirlineCodeLookup"] = tc.timeout
+			}
+
+			req, err := http.NewRequest(tc.method, url+"/v1/reference-data/airlines", bytes.NewReader([]byte("{}")))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var resp *Response
+			err = c.send(context.Background(), "AirlineCodeLookup", req, &resp)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v", err)
+			}
+			if tc.wantStatus != 0 {
+				if uErr, ok := err.(*upstreamError); !ok || uErr.StatusCode != tc.wantStatus {
+					t.Errorf("got %v, want the %d of Amadeus", err, tc.wantStatus)
+				}
+			}
+			if err == nil && (resp == nil || len(resp.Data) != 1) {
+				t.Errorf("got response %+v", resp)
+			}
+			if got := tc.upstream.count(); got != tc.wantCalls {
+				t.Errorf("Amadeus got %d calls, want %d", got, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestSendGivesUpBeforeDeadline(t *testing.T) {
+	upstream := &fakeAmadeus{script: []int{429}, retryAfter: "1"}
+	c, url := newTestClient(t, upstream, &fakeAuth{expiresIn: 1800})
+	c.retry.max = time.Minute
+
+	// waiting a second for Amadeus would take the call past its deadline, so
+	// the 429 is returned at once
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*500)
+	defer cancel()
+	req, _ := http.NewRequest(http.MethodGet, url+"/v1/reference-data/airlines", nil)
+
+	start := time.Now()
+	err := c.send(ctx, "AirlineCodeLookup", req, nil)
+	if uErr, ok := err.(*upstreamError); !ok || uErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("got %v, want the 429 of Amadeus", err)
+	}
+	if took := time.Since(start); took > time.Millisecond*250 {
+		t.Errorf("took %s to give up", took)
+	}
+	if upstream.count() != 1 {
+		t.Errorf("Amadeus got %d calls, want 1", upstream.count())
+	}
+}
@@ -6,6 +6,7 @@ package services
 
 import (
 	"log"
+	"sync"
 	"time"
 
 	consul "github.com/hashicorp/consul/api"
@@ -15,12 +16,15 @@ type serviceReg struct {
 	Name        string
 	TTL         time.Duration
 	ConsulAgent *consul.Agent
+	stop        chan struct{}
+	once        sync.Once
 }
 
 func registerService(addr string, port int, ttl time.Duration) (*serviceReg, error) {
 	s := serviceReg{
 		Name: addr,
 		TTL:  ttl,
+		stop: make(chan struct{}),
 	}
 
 	c, err := consul.NewClient(consul.DefaultConfig())
@@ -48,9 +52,27 @@ func registerService(addr string, port int, ttl time.Duration) (*serviceReg, err
 	return &s, nil
 }
 
+// deregister stops the heartbeat and removes the service from Consul, so no
+// more requests are routed to it.
+func (s *serviceReg) deregister() error {
+	var err error
+	s.once.Do(func() {
+		close(s.stop)
+		err = s.ConsulAgent.ServiceDeregister(s.Name)
+	})
+	return err
+}
+
 func (s *serviceReg) updateTTL() {
 	ticker := time.NewTicker(s.TTL / 2)
-	for range ticker.C {
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.stop:
+			return
+		}
+
 		if agentErr := s.ConsulAgent.FailTTL("service:"+s.Name, ""); agentErr != nil {
 			log.Println(agentErr)
 		}
@@ -1,14 +1,22 @@
 package services
 
 import (
+	"amadeus-go/pkg/instrumenting"
+
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io/ioutil"
 	"net/http"
+	neturl "net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-kit/kit/log"
+	"github.com/go-redis/redis"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type AmadeusService interface {
@@ -24,14 +32,17 @@ type AmadeusService interface {
 	AirportNearestRelevant(context.Context, *AirportNearestRelevantRequest) (*Response, error)
 	AirportAndCitySearch(context.Context, *AirportAndCitySearchRequest) (*Response, error)
 	AirlineCodeLookup(context.Context, *AirlineCodeLookupRequest) (*Response, error)
+	FlightOffersSearch(context.Context, *FlightOffersSearchRequest) (*FlightOffersResponse, error)
+	FlightOffersPrice(context.Context, *FlightOffersPriceRequest) (*FlightOffersPriceResponse, error)
+	CreateFlightOrder(context.Context, *CreateFlightOrderRequest) (*FlightOrderResponse, error)
+	GetFlightOrder(context.Context, *GetFlightOrderRequest) (*FlightOrderResponse, error)
+	CancelFlightOrder(context.Context, *CancelFlightOrderRequest) (*CancelFlightOrderResponse, error)
+	SeatMapDisplay(context.Context, *SeatMapDisplayRequest) (*SeatMapResponse, error)
+	BrandedFaresUpsell(context.Context, *BrandedFaresUpsellRequest) (*FlightOffersResponse, error)
+	FlightAvailabilitiesSearch(context.Context, *FlightAvailabilitiesSearchRequest) (*FlightAvailabilitiesResponse, error)
 }
 
-func (aSrv amadeusService) FlightLowFareSearch(_ context.Context, request *FlightLowFareSearchRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
-	if err != nil {
-		return nil, err
-	}
-
+func (aSrv *amadeusService) FlightLowFareSearch(ctx context.Context, request *FlightLowFareSearchRequest) (response *Response, err error) {
 	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightLowFareSearch)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -46,23 +57,7 @@ func (aSrv amadeusService) FlightLowFareSearch(_ context.Context, request *Fligh
 	q.Add("returnDate", request.ReturnDate)
 	req.URL.RawQuery = q.Encode()
 
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
-
-	client := http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(b, &response)
+	err = aSrv.client.do(ctx, "FlightLowFareSearch", req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -70,12 +65,7 @@ func (aSrv amadeusService) FlightLowFareSearch(_ context.Context, request *Fligh
 	return
 }
 
-func (aSrv amadeusService) FlightInspirationSearch(_ context.Context, request *FlightInspirationSearchRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
-	if err != nil {
-		return nil, err
-	}
-
+func (aSrv *amadeusService) FlightInspirationSearch(ctx context.Context, request *FlightInspirationSearchRequest) (response *Response, err error) {
 	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightInspirationSearch)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -85,26 +75,10 @@ func (aSrv amadeusService) FlightInspirationSearch(_ context.Context, request *F
 	// this is the way to send body of mime-type: application/x-www-form-urlencoded
 	q := req.URL.Query()
 	q.Add("origin", request.Origin)
-	q.Add("maxPrice", string(request.MaxPrice))
+	q.Add("maxPrice", fmt.Sprintf("%d", request.MaxPrice))
 	req.URL.RawQuery = q.Encode()
 
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
-
-	client := http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(b, &response)
+	err = aSrv.client.do(ctx, "FlightInspirationSearch", req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -112,12 +86,7 @@ func (aSrv amadeusService) FlightInspirationSearch(_ context.Context, request *F
 	return
 }
 
-func (aSrv amadeusService) FlightCheapestDateSearch(_ context.Context, request *FlightCheapestDateSearchRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
-	if err != nil {
-		return nil, err
-	}
-
+func (aSrv *amadeusService) FlightCheapestDateSearch(ctx context.Context, request *FlightCheapestDateSearchRequest) (response *Response, err error) {
 	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightCheapestDateSearch)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -130,23 +99,7 @@ func (aSrv amadeusService) FlightCheapestDateSearch(_ context.Context, request *
 	q.Add("destination", string(request.Destination))
 	req.URL.RawQuery = q.Encode()
 
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
-
-	client := http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(b, &response)
+	err = aSrv.client.do(ctx, "FlightCheapestDateSearch", req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -154,12 +107,7 @@ func (aSrv amadeusService) FlightCheapestDateSearch(_ context.Context, request *
 	return
 }
 
-func (aSrv amadeusService) FlightMostSearchedDestinations(_ context.Context, request *FlightMostSearchedDestinationsRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
-	if err != nil {
-		return nil, err
-	}
-
+func (aSrv *amadeusService) FlightMostSearchedDestinations(ctx context.Context, request *FlightMostSearchedDestinationsRequest) (response *Response, err error) {
 	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightMostSearchedDestinations)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -173,23 +121,7 @@ func (aSrv amadeusService) FlightMostSearchedDestinations(_ context.Context, req
 	q.Add("marketCountryCode", request.MarketCountryCode)
 	req.URL.RawQuery = q.Encode()
 
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
-
-	client := http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(b, &response)
+	err = aSrv.client.do(ctx, "FlightMostSearchedDestinations", req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -197,12 +129,7 @@ func (aSrv amadeusService) FlightMostSearchedDestinations(_ context.Context, req
 	return
 }
 
-func (aSrv amadeusService) FlightMostSearchedByDestination(_ context.Context, request *FlightMostSearchedByDestinationRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
-	if err != nil {
-		return nil, err
-	}
-
+func (aSrv *amadeusService) FlightMostSearchedByDestination(ctx context.Context, request *FlightMostSearchedByDestinationRequest) (response *Response, err error) {
 	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightMostSearchedByDestination)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -217,23 +144,27 @@ func (aSrv amadeusService) FlightMostSearchedByDestination(_ context.Context, re
 	q.Add("marketCountryCode", request.MarketCountryCode)
 	req.URL.RawQuery = q.Encode()
 
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
-
-	client := http.Client{}
-	resp, err := client.Do(req)
+	err = aSrv.client.do(ctx, "FlightMostSearchedByDestination", req, &response)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	b, err := ioutil.ReadAll(resp.Body)
+	return
+}
+
+func (aSrv *amadeusService) FlightCheckInLinks(ctx context.Context, request *FlightCheckInLinksRequest) (response *Response, err error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightCheckInLists)
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(b, &response)
+	// this is the way to send body of mime-type: application/x-www-form-urlencoded
+	q := req.URL.Query()
+	q.Add("airlineCode", request.AirlineCode)
+	req.URL.RawQuery = q.Encode()
+
+	err = aSrv.client.do(ctx, "FlightCheckInLinks", req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -241,13 +172,8 @@ func (aSrv amadeusService) FlightMostSearchedByDestination(_ context.Context, re
 	return
 }
 
-func (aSrv amadeusService) FlightCheckInLinks(_ context.Context, request *FlightCheckInLinksRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
-	if err != nil {
-		return nil, err
-	}
-
-	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightCheckInLists)
+func (aSrv *amadeusService) FlightMostTraveledDestinations(ctx context.Context, request *FlightMostTraveledDestinationsRequest) (response *Response, err error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightMostTraveledDestinations)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
@@ -255,26 +181,32 @@ func (aSrv amadeusService) FlightCheckInLinks(_ context.Context, request *Flight
 
 	// this is the way to send body of mime-type: application/x-www-form-urlencoded
 	q := req.URL.Query()
-	q.Add("airlineCode", request.AirlineCode)
+	q.Add("originCityCode", request.OriginCityCode)
+	q.Add("period", string(request.Period))
 	req.URL.RawQuery = q.Encode()
 
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
-
-	client := http.Client{}
-	resp, err := client.Do(req)
+	err = aSrv.client.do(ctx, "FlightMostTraveledDestinations", req, &response)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	b, err := ioutil.ReadAll(resp.Body)
+	return
+}
+
+func (aSrv *amadeusService) FlightMostBookedDestinations(ctx context.Context, request *FlightMostBookedDestinationsRequest) (response *Response, err error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightMostBookedDestinations)
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(b, &response)
+	// this is the way to send body of mime-type: application/x-www-form-urlencoded
+	q := req.URL.Query()
+	q.Add("originCityCode", request.OriginCityCode)
+	q.Add("period", string(request.Period))
+	req.URL.RawQuery = q.Encode()
+
+	err = aSrv.client.do(ctx, "FlightMostBookedDestinations", req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -282,13 +214,8 @@ func (aSrv amadeusService) FlightCheckInLinks(_ context.Context, request *Flight
 	return
 }
 
-func (aSrv amadeusService) FlightMostTraveledDestinations(_ context.Context, request *FlightMostTraveledDestinationsRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
-	if err != nil {
-		return nil, err
-	}
-
-	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightMostTraveledDestinations)
+func (aSrv *amadeusService) FlightBusiestTravelingPeriod(ctx context.Context, request *FlightBusiestTravelingPeriodRequest) (response *Response, err error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightBusiestTravelingPeriod)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
@@ -296,27 +223,34 @@ func (aSrv amadeusService) FlightMostTraveledDestinations(_ context.Context, req
 
 	// this is the way to send body of mime-type: application/x-www-form-urlencoded
 	q := req.URL.Query()
-	q.Add("originCityCode", request.OriginCityCode)
+	q.Add("cityCode", request.CityCode)
 	q.Add("period", string(request.Period))
+	q.Add("direction", string(request.Direction))
 	req.URL.RawQuery = q.Encode()
 
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
-
-	client := http.Client{}
-	resp, err := client.Do(req)
+	err = aSrv.client.do(ctx, "FlightBusiestTravelingPeriod", req, &response)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	b, err := ioutil.ReadAll(resp.Body)
+	return
+}
+
+func (aSrv *amadeusService) AirportNearestRelevant(ctx context.Context, request *AirportNearestRelevantRequest) (response *Response, err error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.AirportNearestRelevant)
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(b, &response)
+	// this is the way to send body of mime-type: application/x-www-form-urlencoded
+	q := req.URL.Query()
+	q.Add("latitude", fmt.Sprintf("%f", request.Latitude))
+	q.Add("longitude", fmt.Sprintf("%f", request.Longitude))
+	q.Add("sort", request.Sort)
+	req.URL.RawQuery = q.Encode()
+
+	err = aSrv.client.do(ctx, "AirportNearestRelevant", req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -324,13 +258,8 @@ func (aSrv amadeusService) FlightMostTraveledDestinations(_ context.Context, req
 	return
 }
 
-func (aSrv amadeusService) FlightMostBookedDestinations(_ context.Context, request *FlightMostBookedDestinationsRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
-	if err != nil {
-		return nil, err
-	}
-
-	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightMostBookedDestinations)
+func (aSrv *amadeusService) AirportAndCitySearch(ctx context.Context, request *AirportAndCitySearchRequest) (response *Response, err error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.AirportAndCitySearch)
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
@@ -338,27 +267,32 @@ func (aSrv amadeusService) FlightMostBookedDestinations(_ context.Context, reque
 
 	// this is the way to send body of mime-type: application/x-www-form-urlencoded
 	q := req.URL.Query()
-	q.Add("originCityCode", request.OriginCityCode)
-	q.Add("period", string(request.Period))
+	q.Add("countryCode", request.CountryCode)
+	q.Add("subType", request.SubType)
+	q.Add("keyword", request.Keyword)
 	req.URL.RawQuery = q.Encode()
 
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
-
-	client := http.Client{}
-	resp, err := client.Do(req)
+	err = aSrv.client.do(ctx, "AirportAndCitySearch", req, &response)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	b, err := ioutil.ReadAll(resp.Body)
+	return
+}
+
+func (aSrv *amadeusService) AirlineCodeLookup(ctx context.Context, request *AirlineCodeLookupRequest) (response *Response, err error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.AirlineCodeLookup)
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(b, &response)
+	// this is the way to send body of mime-type: application/x-www-form-urlencoded
+	q := req.URL.Query()
+	q.Add("airlineCodes", request.AirlineCodes)
+	req.URL.RawQuery = q.Encode()
+
+	err = aSrv.client.do(ctx, "AirlineCodeLookup", req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -366,42 +300,284 @@ func (aSrv amadeusService) FlightMostBookedDestinations(_ context.Context, reque
 	return
 }
 
-func (aSrv amadeusService) FlightBusiestTravelingPeriod(_ context.Context, request *FlightBusiestTravelingPeriodRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
+// travelClasses are the cabins a search may be restricted to.
+var travelClasses = map[string]bool{"ECONOMY": true, "PREMIUM_ECONOMY": true, "BUSINESS": true, "FIRST": true}
+
+// maxSeatedTravelers is the most adults and children a search may be for;
+// infants sit on the laps of the adults.
+const maxSeatedTravelers = 9
+
+// validate refuses searches Amadeus would refuse, and those the POST form
+// couldn't be built for.
+func (r *FlightOffersSearchRequest) validate() error {
+	const route = "FlightOffersSearch"
+
+	switch {
+	case r.Adults < 1:
+		return invalidParameter(route, "adults", "at least one adult is required")
+	case r.Children < 0:
+		return invalidParameter(route, "children", "can't be negative")
+	case r.Infants < 0:
+		return invalidParameter(route, "infants", "can't be negative")
+	case r.Infants > r.Adults:
+		return invalidParameter(route, "infants", "each infant sits on the lap of an adult, so there can't be more infants than adults")
+	case r.Adults+r.Children > maxSeatedTravelers:
+		return invalidParameter(route, "adults", fmt.Sprintf("at most %d adults and children may travel together", maxSeatedTravelers))
+	case r.TravelClass != "" && !travelClasses[r.TravelClass]:
+		return invalidParameter(route, "travelClass", "must be ECONOMY, PREMIUM_ECONOMY, BUSINESS or FIRST")
+	}
+
+	if len(r.OriginDestinations) == 0 {
+		switch {
+		case r.OriginLocationCode == "":
+			return invalidParameter(route, "originLocationCode", "is required")
+		case r.DestinationLocationCode == "":
+			return invalidParameter(route, "destinationLocationCode", "is required")
+		case r.DepartureDate == "":
+			return invalidParameter(route, "departureDate", "is required")
+		}
+		return nil
+	}
+
+	for i, od := range r.OriginDestinations {
+		parameter := fmt.Sprintf("originDestinations[%d].", i)
+		switch {
+		case od == nil:
+			return invalidParameter(route, fmt.Sprintf("originDestinations[%d]", i), "is required")
+		case od.OriginLocationCode == "":
+			return invalidParameter(route, parameter+"originLocationCode", "is required")
+		case od.DestinationLocationCode == "":
+			return invalidParameter(route, parameter+"destinationLocationCode", "is required")
+		case od.DepartureDate == "":
+			return invalidParameter(route, parameter+"departureDate", "is required")
+		}
+	}
+	return nil
+}
+
+func (aSrv *amadeusService) FlightOffersSearch(ctx context.Context, request *FlightOffersSearchRequest) (response *FlightOffersResponse, err error) {
+	err = request.validate()
 	if err != nil {
 		return nil, err
 	}
 
-	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightBusiestTravelingPeriod)
-	req, err := http.NewRequest("GET", url, nil)
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightOffersSearch)
+
+	var req *http.Request
+	if len(request.OriginDestinations) > 0 {
+		// trips of more than two legs only fit in the POST form, which is
+		// still a search: Amadeus is told so, and it may be retried like a GET
+		b, err := json.Marshal(flightOffersSearchBody(request))
+		if err != nil {
+			return nil, err
+		}
+		req, err = http.NewRequest("POST", url, bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(methodOverrideHeader, "GET")
+	} else {
+		req, err = http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// optional parameters are only sent when set, as Amadeus rejects
+		// empty ones
+		q := req.URL.Query()
+		q.Add("originLocationCode", request.OriginLocationCode)
+		q.Add("destinationLocationCode", request.DestinationLocationCode)
+		q.Add("departureDate", request.DepartureDate)
+		q.Add("adults", strconv.Itoa(int(request.Adults)))
+		if request.ReturnDate != "" {
+			q.Add("returnDate", request.ReturnDate)
+		}
+		if request.Children > 0 {
+			q.Add("children", strconv.Itoa(int(request.Children)))
+		}
+		if request.Infants > 0 {
+			q.Add("infants", strconv.Itoa(int(request.Infants)))
+		}
+		if request.TravelClass != "" {
+			q.Add("travelClass", request.TravelClass)
+		}
+		if len(request.IncludedAirlineCodes) > 0 {
+			q.Add("includedAirlineCodes", strings.Join(request.IncludedAirlineCodes, ","))
+		}
+		if len(request.ExcludedAirlineCodes) > 0 {
+			q.Add("excludedAirlineCodes", strings.Join(request.ExcludedAirlineCodes, ","))
+		}
+		if request.NonStop {
+			q.Add("nonStop", "true")
+		}
+		if request.CurrencyCode != "" {
+			q.Add("currencyCode", request.CurrencyCode)
+		}
+		if request.MaxPrice > 0 {
+			q.Add("maxPrice", strconv.Itoa(int(request.MaxPrice)))
+		}
+		if request.Max > 0 {
+			q.Add("max", strconv.Itoa(int(request.Max)))
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	err = aSrv.client.do(ctx, "FlightOffersSearch", req, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	// this is the way to send body of mime-type: application/x-www-form-urlencoded
-	q := req.URL.Query()
-	q.Add("cityCode", request.CityCode)
-	q.Add("period", string(request.Period))
-	q.Add("direction", string(request.Direction))
-	req.URL.RawQuery = q.Encode()
+	return
+}
 
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
+// flightOffersSearchBody is the POST form of a search: the travelers are
+// numbered adults first, and each infant sits on the lap of one of them,
+// which validate made sure there are enough of.
+func flightOffersSearchBody(request *FlightOffersSearchRequest) interface{} {
+	type dateTimeRange struct {
+		Date string `json:"date"`
+		Time string `json:"time,omitempty"`
+	}
+	type originDestination struct {
+		Id                      string        `json:"id"`
+		OriginLocationCode      string        `json:"originLocationCode"`
+		DestinationLocationCode string        `json:"destinationLocationCode"`
+		DepartureDateTimeRange  dateTimeRange `json:"departureDateTimeRange"`
+	}
+	type traveler struct {
+		Id                string `json:"id"`
+		TravelerType      string `json:"travelerType"`
+		AssociatedAdultId string `json:"associatedAdultId,omitempty"`
+	}
+	type cabinRestriction struct {
+		Cabin                string   `json:"cabin"`
+		Coverage             string   `json:"coverage"`
+		OriginDestinationIds []string `json:"originDestinationIds"`
+	}
+	type carrierRestrictions struct {
+		IncludedCarrierCodes []string `json:"includedCarrierCodes,omitempty"`
+		ExcludedCarrierCodes []string `json:"excludedCarrierCodes,omitempty"`
+	}
+	type connectionRestriction struct {
+		MaxNumberOfConnections int `json:"maxNumberOfConnections"`
+	}
+	type flightFilters struct {
+		CabinRestrictions     []cabinRestriction     `json:"cabinRestrictions,omitempty"`
+		CarrierRestrictions   *carrierRestrictions   `json:"carrierRestrictions,omitempty"`
+		ConnectionRestriction *connectionRestriction `json:"connectionRestriction,omitempty"`
+	}
+	type searchCriteria struct {
+		MaxFlightOffers int32          `json:"maxFlightOffers,omitempty"`
+		MaxPrice        int32          `json:"maxPrice,omitempty"`
+		FlightFilters   *flightFilters `json:"flightFilters,omitempty"`
+	}
+
+	var ids []string
+	var originDestinations []originDestination
+	for i, od := range request.OriginDestinations {
+		id := od.Id
+		if id == "" {
+			id = strconv.Itoa(i + 1)
+		}
+		ids = append(ids, id)
+		originDestinations = append(originDestinations, originDestination{
+			Id:                      id,
+			OriginLocationCode:      od.OriginLocationCode,
+			DestinationLocationCode: od.DestinationLocationCode,
+			DepartureDateTimeRange:  dateTimeRange{Date: od.DepartureDate, Time: od.DepartureTime},
+		})
+	}
+
+	var travelers []traveler
+	add := func(travelerType string, associatedAdultId string) {
+		travelers = append(travelers, traveler{
+			Id:                strconv.Itoa(len(travelers) + 1),
+			TravelerType:      travelerType,
+			AssociatedAdultId: associatedAdultId,
+		})
+	}
+	for i := int32(0); i < request.Adults; i++ {
+		add("ADULT", "")
+	}
+	for i := int32(0); i < request.Children; i++ {
+		add("CHILD", "")
+	}
+	for i := int32(0); i < request.Infants; i++ {
+		add("HELD_INFANT", strconv.Itoa(int(i+1)))
+	}
+
+	var filters flightFilters
+	if request.TravelClass != "" {
+		filters.CabinRestrictions = []cabinRestriction{{
+			Cabin:                request.TravelClass,
+			Coverage:             "MOST_SEGMENTS",
+			OriginDestinationIds: ids,
+		}}
+	}
+	if len(request.IncludedAirlineCodes) > 0 || len(request.ExcludedAirlineCodes) > 0 {
+		filters.CarrierRestrictions = &carrierRestrictions{
+			IncludedCarrierCodes: request.IncludedAirlineCodes,
+			ExcludedCarrierCodes: request.ExcludedAirlineCodes,
+		}
+	}
+	if request.NonStop {
+		filters.ConnectionRestriction = &connectionRestriction{MaxNumberOfConnections: 0}
+	}
+
+	criteria := searchCriteria{
+		MaxFlightOffers: request.Max,
+		MaxPrice:        request.MaxPrice,
+	}
+	if filters.CabinRestrictions != nil || filters.CarrierRestrictions != nil || filters.ConnectionRestriction != nil {
+		criteria.FlightFilters = &filters
+	}
+
+	return struct {
+		CurrencyCode       string              `json:"currencyCode,omitempty"`
+		OriginDestinations []originDestination `json:"originDestinations"`
+		Travelers          []traveler          `json:"travelers"`
+		Sources            []string            `json:"sources"`
+		SearchCriteria     searchCriteria      `json:"searchCriteria"`
+	}{
+		CurrencyCode:       request.CurrencyCode,
+		OriginDestinations: originDestinations,
+		Travelers:          travelers,
+		Sources:            []string{"GDS"},
+		SearchCriteria:     criteria,
+	}
+}
 
-	client := http.Client{}
-	resp, err := client.Do(req)
+func (aSrv *amadeusService) FlightOffersPrice(ctx context.Context, request *FlightOffersPriceRequest) (response *FlightOffersPriceResponse, err error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightOffersPrice)
+
+	// the offers go back as they came from the search
+	body := struct {
+		Data struct {
+			Type         string         `json:"type"`
+			FlightOffers []*FlightOffer `json:"flightOffers"`
+		} `json:"data"`
+	}{}
+	body.Data.Type = "flight-offers-pricing"
+	body.Data.FlightOffers = request.FlightOffers
+	b, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	b, err := ioutil.ReadAll(resp.Body)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(b))
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Content-Type", "application/json")
 
-	err = json.Unmarshal(b, &response)
+	if len(request.Include) > 0 {
+		q := req.URL.Query()
+		q.Add("include", strings.Join(request.Include, ","))
+		req.URL.RawQuery = q.Encode()
+	}
+
+	err = aSrv.client.do(ctx, "FlightOffersPrice", req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -409,42 +585,94 @@ func (aSrv amadeusService) FlightBusiestTravelingPeriod(_ context.Context, reque
 	return
 }
 
-func (aSrv amadeusService) AirportNearestRelevant(_ context.Context, request *AirportNearestRelevantRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
+func (aSrv *amadeusService) CreateFlightOrder(ctx context.Context, request *CreateFlightOrderRequest) (response *FlightOrderResponse, err error) {
+	var requestHash string
+	if request.IdempotencyKey != "" {
+		requestHash, err = cacheKey("CreateFlightOrder", request)
+		if err != nil {
+			return nil, err
+		}
+
+		orderId, err := aSrv.orders.claim(ctx, request.IdempotencyKey, requestHash)
+		if err != nil {
+			return nil, err
+		}
+		if orderId != "" {
+			// booked already: the retry gets the order as it is now
+			return aSrv.getFlightOrder(ctx, "CreateFlightOrder", orderId)
+		}
+	}
+
+	body := struct {
+		Data *FlightOrder `json:"data"`
+	}{&FlightOrder{
+		Type:         "flight-order",
+		FlightOffers: request.FlightOffers,
+		Travelers:    request.Travelers,
+		Contacts:     request.Contacts,
+	}}
+	b, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 
-	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightBusiestTravelingPeriod)
-	req, err := http.NewRequest("GET", url, nil)
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightOrders)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(b))
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Content-Type", "application/json")
 
-	// this is the way to send body of mime-type: application/x-www-form-urlencoded
-	q := req.URL.Query()
-	q.Add("latitude", fmt.Sprintf("%f", request.Latitude))
-	q.Add("longitude", fmt.Sprintf("%f", request.Longitude))
-	q.Add("sort", request.Sort)
-	req.URL.RawQuery = q.Encode()
+	// a caller hanging up mustn't leave the booking half done: only the
+	// timeout of the route cuts it short
+	ctx = context.WithoutCancel(ctx)
 
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
-
-	client := http.Client{}
-	resp, err := client.Do(req)
+	err = aSrv.client.do(ctx, "CreateFlightOrder", req, &response)
+	if err == nil && (response == nil || response.Data == nil) {
+		err = &UnavailableError{&AmadeusError{Route: "CreateFlightOrder", Cause: errors.New("no order in the response")}}
+	}
 	if err != nil {
+		if request.IdempotencyKey != "" {
+			if rejected(err) {
+				aSrv.orders.release(ctx, request.IdempotencyKey)
+			} else {
+				aSrv.orders.unknown(ctx, request.IdempotencyKey, requestHash, err)
+			}
+		}
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	b, err := ioutil.ReadAll(resp.Body)
+	err = aSrv.orders.record(ctx, request.IdempotencyKey, requestHash, response.Data)
+	if err != nil {
+		response.Warnings = append(response.Warnings, &ErrorWarning{
+			Title:  "ORDER NOT RECORDED",
+			Detail: "the order is booked, but the service couldn't record who booked it: only an admin may look at or cancel it",
+		})
+	}
+	return response, nil
+}
+
+func (r *GetFlightOrderRequest) validate() error {
+	if r.FlightOrderId == "" {
+		return invalidParameter("GetFlightOrder", "flightOrderId", "is required")
+	}
+	return nil
+}
+
+// GetFlightOrder is only called for the orders of the caller: the quota
+// middleware checks that before the call is accounted.
+func (aSrv *amadeusService) GetFlightOrder(ctx context.Context, request *GetFlightOrderRequest) (*FlightOrderResponse, error) {
+	return aSrv.getFlightOrder(ctx, "GetFlightOrder", request.FlightOrderId)
+}
+
+func (aSrv *amadeusService) getFlightOrder(ctx context.Context, route string, orderId string) (response *FlightOrderResponse, err error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightOrders) + "/" + neturl.PathEscape(orderId)
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(b, &response)
+	err = aSrv.client.do(ctx, route, req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -452,42 +680,77 @@ func (aSrv amadeusService) AirportNearestRelevant(_ context.Context, request *Ai
 	return
 }
 
-func (aSrv amadeusService) AirportAndCitySearch(_ context.Context, request *AirportAndCitySearchRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
+func (r *CancelFlightOrderRequest) validate() error {
+	if r.FlightOrderId == "" {
+		return invalidParameter("CancelFlightOrder", "flightOrderId", "is required")
+	}
+	return nil
+}
+
+// CancelFlightOrder is only called for the orders of the caller, as
+// GetFlightOrder is.
+func (aSrv *amadeusService) CancelFlightOrder(ctx context.Context, request *CancelFlightOrderRequest) (*CancelFlightOrderResponse, error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightOrders) + "/" + neturl.PathEscape(request.FlightOrderId)
+	req, err := http.NewRequest("DELETE", url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightBusiestTravelingPeriod)
-	req, err := http.NewRequest("GET", url, nil)
+	// Amadeus answers with no body
+	err = aSrv.client.do(ctx, "CancelFlightOrder", req, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// this is the way to send body of mime-type: application/x-www-form-urlencoded
-	q := req.URL.Query()
-	q.Add("countryCode", request.CountryCode)
-	q.Add("subType", request.SubType)
-	q.Add("keyword", request.Keyword)
-	req.URL.RawQuery = q.Encode()
-
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
+	aSrv.orders.cancelled(ctx, request.FlightOrderId)
+	return &CancelFlightOrderResponse{FlightOrderId: request.FlightOrderId}, nil
+}
 
-	client := http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
+// validate takes the seat maps of either flight offers or an order.
+func (r *SeatMapDisplayRequest) validate() error {
+	switch {
+	case len(r.FlightOffers) > 0 && r.FlightOrderId != "":
+		return invalidParameter("SeatMapDisplay", "flightOrderId", "can't be given along with flight offers")
+	case len(r.FlightOffers) == 0 && r.FlightOrderId == "":
+		return invalidParameter("SeatMapDisplay", "flightOffers", "either flight offers or a flight order ID is required")
 	}
-	defer resp.Body.Close()
+	return nil
+}
 
-	b, err := ioutil.ReadAll(resp.Body)
+func (aSrv *amadeusService) SeatMapDisplay(ctx context.Context, request *SeatMapDisplayRequest) (response *SeatMapResponse, err error) {
+	err = request.validate()
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(b, &response)
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.SeatMapDisplay)
+
+	var req *http.Request
+	if request.FlightOrderId != "" {
+		// the quota middleware checked the order is the caller's
+		req, err = http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		q := req.URL.Query()
+		q.Add("flight-orderId", request.FlightOrderId)
+		req.URL.RawQuery = q.Encode()
+	} else {
+		// the offers go back as they came from the search
+		b, err := json.Marshal(struct {
+			Data []*FlightOffer `json:"data"`
+		}{request.FlightOffers})
+		if err != nil {
+			return nil, err
+		}
+		req, err = http.NewRequest("POST", url, bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	err = aSrv.client.do(ctx, "SeatMapDisplay", req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -495,40 +758,56 @@ func (aSrv amadeusService) AirportAndCitySearch(_ context.Context, request *Airp
 	return
 }
 
-func (aSrv amadeusService) AirlineCodeLookup(_ context.Context, request *AirlineCodeLookupRequest) (response *Response, err error) {
-	err = checkTokenExpiry(&aSrv)
+func (aSrv *amadeusService) BrandedFaresUpsell(ctx context.Context, request *BrandedFaresUpsellRequest) (response *FlightOffersResponse, err error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.BrandedFaresUpsell)
+
+	// the offers go back as they came from the search
+	body := struct {
+		Data struct {
+			Type         string         `json:"type"`
+			FlightOffers []*FlightOffer `json:"flightOffers"`
+		} `json:"data"`
+	}{}
+	body.Data.Type = "flight-offers-upselling"
+	body.Data.FlightOffers = request.FlightOffers
+	b, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 
-	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.AirlineCodeLookup)
-	req, err := http.NewRequest("GET", url, nil)
+	// upselling only looks offers up, so it may be retried like a GET
+	req, err := http.NewRequest("POST", url, bytes.NewReader(b))
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(methodOverrideHeader, "GET")
 
-	// this is the way to send body of mime-type: application/x-www-form-urlencoded
-	q := req.URL.Query()
-	q.Add("airlineCodes", request.AirlineCodes)
-	req.URL.RawQuery = q.Encode()
+	err = aSrv.client.do(ctx, "BrandedFaresUpsell", req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return
+}
 
-	bearer := getBearer(aSrv.token)
-	req.Header.Add("Authorization", bearer)
-	req.Header.Add("Accept", "application/json")
+func (aSrv *amadeusService) FlightAvailabilitiesSearch(ctx context.Context, request *FlightAvailabilitiesSearchRequest) (response *FlightAvailabilitiesResponse, err error) {
+	url := cleanUrl(aSrv.urls.ApiBaseUrl, aSrv.urls.FlightAvailabilitiesSearch)
 
-	client := http.Client{}
-	resp, err := client.Do(req)
+	b, err := json.Marshal(flightAvailabilitiesBody(request))
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	b, err := ioutil.ReadAll(resp.Body)
+	// Amadeus only takes availability searches as a POST
+	req, err := http.NewRequest("POST", url, bytes.NewReader(b))
 	if err != nil {
 		return nil, err
 	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(methodOverrideHeader, "GET")
 
-	err = json.Unmarshal(b, &response)
+	err = aSrv.client.do(ctx, "FlightAvailabilitiesSearch", req, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -536,40 +815,213 @@ func (aSrv amadeusService) AirlineCodeLookup(_ context.Context, request *Airline
 	return
 }
 
-func NewBasicService(port int, configFilename string, urlsFilename string, logger log.Logger) (AmadeusService, error) {
+// flightAvailabilitiesBody is the body of an availability search, whose
+// travelers are numbered adults first like those of flightOffersSearchBody.
+func flightAvailabilitiesBody(request *FlightAvailabilitiesSearchRequest) interface{} {
+	type dateTime struct {
+		Date string `json:"date"`
+		Time string `json:"time,omitempty"`
+	}
+	type originDestination struct {
+		Id                      string   `json:"id"`
+		OriginLocationCode      string   `json:"originLocationCode"`
+		DestinationLocationCode string   `json:"destinationLocationCode"`
+		DepartureDateTime       dateTime `json:"departureDateTime"`
+	}
+	type traveler struct {
+		Id           string `json:"id"`
+		TravelerType string `json:"travelerType"`
+	}
+	type carrierRestrictions struct {
+		IncludedCarrierCodes []string `json:"includedCarrierCodes,omitempty"`
+		ExcludedCarrierCodes []string `json:"excludedCarrierCodes,omitempty"`
+	}
+	type flightFilters struct {
+		CarrierRestrictions *carrierRestrictions `json:"carrierRestrictions,omitempty"`
+	}
+	type searchCriteria struct {
+		FlightFilters *flightFilters `json:"flightFilters,omitempty"`
+	}
+
+	var originDestinations []originDestination
+	for i, od := range request.OriginDestinations {
+		id := od.Id
+		if id == "" {
+			id = strconv.Itoa(i + 1)
+		}
+		originDestinations = append(originDestinations, originDestination{
+			Id:                      id,
+			OriginLocationCode:      od.OriginLocationCode,
+			DestinationLocationCode: od.DestinationLocationCode,
+			DepartureDateTime:       dateTime{Date: od.DepartureDate, Time: od.DepartureTime},
+		})
+	}
+
+	var travelers []traveler
+	for i := int32(0); i < request.Adults+request.Children; i++ {
+		travelerType := "ADULT"
+		if i >= request.Adults {
+			travelerType = "CHILD"
+		}
+		travelers = append(travelers, traveler{Id: strconv.Itoa(int(i + 1)), TravelerType: travelerType})
+	}
+
+	var criteria *searchCriteria
+	if len(request.IncludedAirlineCodes) > 0 || len(request.ExcludedAirlineCodes) > 0 {
+		criteria = &searchCriteria{FlightFilters: &flightFilters{
+			CarrierRestrictions: &carrierRestrictions{
+				IncludedCarrierCodes: request.IncludedAirlineCodes,
+				ExcludedCarrierCodes: request.ExcludedAirlineCodes,
+			},
+		}}
+	}
+
+	return struct {
+		OriginDestinations []originDestination `json:"originDestinations"`
+		Travelers          []traveler          `json:"travelers"`
+		Sources            []string            `json:"sources"`
+		SearchCriteria     *searchCriteria     `json:"searchCriteria,omitempty"`
+	}{
+		OriginDestinations: originDestinations,
+		Travelers:          travelers,
+		Sources:            []string{"GDS"},
+		SearchCriteria:     criteria,
+	}
+}
+
+// Lifecycle lets the process running the service check that it can still
+// reach Amadeus, look at how much of it tenants used, and shut it down
+// cleanly.
+type Lifecycle struct {
+	registerInfo *serviceReg
+	tokens       *tokenManager
+	usage        *usageTracker
+}
+
+// Healthy tells whether the service holds a valid access token, without which
+// every call to Amadeus fails.
+func (l *Lifecycle) Healthy() bool {
+	return l.tokens.valid()
+}
+
+// Usage reports the calls each tenant made, for the admin API.
+func (l *Lifecycle) Usage() UsageService {
+	return l.usage
+}
+
+// Deregister removes the service from Consul and stops its heartbeat.
+func (l *Lifecycle) Deregister() error {
+	if l.registerInfo == nil {
+		return nil
+	}
+	return l.registerInfo.deregister()
+}
+
+// Close deregisters the service if that wasn't done yet and stops the
+// background refresh of the access token.
+func (l *Lifecycle) Close() error {
+	l.tokens.close()
+	return l.Deregister()
+}
+
+func NewBasicService(port int, configFilename string, urlsFilename string, logger log.Logger, metrics *instrumenting.Metrics, tracer trace.Tracer) (AmadeusService, *Lifecycle, error) {
 	s, err := registerService("amadeus-go", port, time.Second*15)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	return newService(s, configFilename, urlsFilename, logger, metrics, tracer)
+}
+
+// NewUnregisteredService builds the service the way NewBasicService does,
+// without registering it in Consul, to run it on its own.
+func NewUnregisteredService(configFilename string, urlsFilename string, logger log.Logger, metrics *instrumenting.Metrics, tracer trace.Tracer) (AmadeusService, *Lifecycle, error) {
+	return newService(nil, configFilename, urlsFilename, logger, metrics, tracer)
+}
+
+// newService builds the service and its middlewares; s is nil when the
+// service isn't registered in Consul.
+func newService(s *serviceReg, configFilename string, urlsFilename string, logger log.Logger, metrics *instrumenting.Metrics, tracer trace.Tracer) (AmadeusService, *Lifecycle, error) {
 	urls, err := getServicesURLs(urlsFilename)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	token, err := getTokenFromAmadeus(configFilename, urls)
+	client, err := newUpstreamClient(configFilename)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	redisClient, err := newRedisClient(configFilename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := newTokenManager(configFilename, urls, client.httpClient, redisClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	client.tokens = tokens
+	client.metrics = metrics
+	client.tracer = tracer
+	tokens.metrics = metrics
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+	err = tokens.start(ctx)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	var srv AmadeusService
-	aSrv := amadeusService{
+	aSrv := &amadeusService{
 		urls:           urls,
-		token:          token,
+		client:         client,
+		redisClient:    redisClient,
 		registerInfo:   s,
 		configFilename: configFilename,
 		urlsFilename:   urlsFilename,
 	}
 
-	srv = loggingMiddleware(logger)(aSrv)
-	return srv, nil
+	cache, policies, err := getResponseCache(configFilename, redisClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage, err := newUsageTracker(configFilename, redisClient, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aSrv.orders, err = newOrderBook(configFilename, redisClient, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	srv = quotaMiddleware(usage, aSrv.orders)(aSrv)
+	srv = coalescingMiddleware(usage, client.budget, logger)(srv)
+	if cache != nil {
+		srv = cachingMiddleware(cache, policies, usage, metrics, logger)(srv)
+	}
+	srv = instrumentingMiddleware(metrics)(srv)
+	srv = loggingMiddleware(logger)(srv)
+	srv = tracingMiddleware(tracer)(srv)
+	return srv, &Lifecycle{registerInfo: s, tokens: tokens, usage: usage}, nil
 }
 
 // =============================================================================
 type serviceMiddleware func(service AmadeusService) AmadeusService
 
+// validator is implemented by the requests the service can refuse without
+// asking Amadeus.
+type validator interface {
+	validate() error
+}
+
 type amadeusService struct {
-	token          *amadeusToken
+	client         *upstreamClient
+	redisClient    *redis.Client
+	orders         *orderBook
 	urls           *serviceUrls
 	registerInfo   *serviceReg
 	configFilename string
@@ -591,4 +1043,10 @@ type serviceUrls struct {
 	AirportNearestRelevant          string
 	AirportAndCitySearch            string
 	AirlineCodeLookup               string
+	FlightOffersSearch              string
+	FlightOffersPrice               string
+	FlightOrders                    string
+	SeatMapDisplay                  string
+	BrandedFaresUpsell              string
+	FlightAvailabilitiesSearch      string
 }
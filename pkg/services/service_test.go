@@ -0,0 +1,118 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFlightOffersSearchValidate(t *testing.T) {
+	valid := func(change func(r *FlightOffersSearchRequest)) *FlightOffersSearchRequest {
+		r := &FlightOffersSearchRequest{
+			OriginLocationCode:      "MAD",
+			DestinationLocationCode: "JFK",
+			DepartureDate:           "2026-11-01",
+			Adults:                  2,
+		}
+		if change != nil {
+			change(r)
+		}
+		return r
+	}
+	legs := func(change func(r *FlightOffersSearchRequest)) *FlightOffersSearchRequest {
+		return valid(func(r *FlightOffersSearchRequest) {
+			r.OriginDestinations = []*OriginDestination{
+				{Id: "1", OriginLocationCode: "MAD", DestinationLocationCode: "JFK", DepartureDate: "2026-11-01"},
+				{Id: "2", OriginLocationCode: "JFK", DestinationLocationCode: "MAD", DepartureDate: "2026-11-08"},
+			}
+			if change != nil {
+				change(r)
+			}
+		})
+	}
+
+	for _, tc := range []struct {
+		name string
+		req  *FlightOffersSearchRequest
+		// the parameter it must be refused for, if any
+		wantParameter string
+	}{
+		{"valid", valid(nil), ""},
+		{"infant on each lap", valid(func(r *FlightOffersSearchRequest) { r.Infants = 2 }), ""},
+		{"nine seated travelers", valid(func(r *FlightOffersSearchRequest) { r.Adults, r.Children, r.Infants = 4, 5, 4 }), ""},
+		{"travel class", valid(func(r *FlightOffersSearchRequest) { r.TravelClass = "PREMIUM_ECONOMY" }), ""},
+		{"several legs", legs(nil), ""},
+		{"no adult", valid(func(r *FlightOffersSearchRequest) { r.Adults = 0 }), "adults"},
+		{"negative children", valid(func(r *FlightOffersSearchRequest) { r.Children = -1 }), "children"},
+		{"negative infants", valid(func(r *FlightOffersSearchRequest) { r.Infants = -1 }), "infants"},
+		{"more infants than adults", valid(func(r *FlightOffersSearchRequest) { r.Infants = 3 }), "infants"},
+		{"ten seated travelers", valid(func(r *FlightOffersSearchRequest) { r.Adults, r.Children = 5, 5 }), "adults"},
+		{"unknown travel class", valid(func(r *FlightOffersSearchRequest) { r.TravelClass = "LUXURY" }), "travelClass"},
+		{"no origin", valid(func(r *FlightOffersSearchRequest) { r.OriginLocationCode = "" }), "originLocationCode"},
+		{"no destination", valid(func(r *FlightOffersSearchRequest) { r.DestinationLocationCode = "" }), "destinationLocationCode"},
+		{"no departure date", valid(func(r *FlightOffersSearchRequest) { r.DepartureDate = "" }), "departureDate"},
+		{"leg without origin", legs(func(r *FlightOffersSearchRequest) { r.OriginDestinations[1].OriginLocationCode = "" }), "originDestinations[1].originLocationCode"},
+		{"leg without destination", legs(func(r *FlightOffersSearchRequest) { r.OriginDestinations[0].DestinationLocationCode = "" }), "originDestinations[0].destinationLocationCode"},
+		{"leg without date", legs(func(r *FlightOffersSearchRequest) { r.OriginDestinations[1].DepartureDate = "" }), "originDestinations[1].departureDate"},
+		{"missing leg", legs(func(r *FlightOffersSearchRequest) { r.OriginDestinations[0] = nil }), "originDestinations[0]"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.validate()
+			if tc.wantParameter == "" {
+				if err != nil {
+					t.Fatalf("a valid search was refused: %v", err)
+				}
+				return
+			}
+
+			vErr, ok := err.(*ValidationError)
+			if !ok {
+				t.Fatalf("got %v, want a ValidationError", err)
+			}
+			if len(vErr.Errors) != 1 || vErr.Errors[0].Source.Parameter != tc.wantParameter {
+				t.Errorf("got errors %+v, want one for %s", vErr.Errors, tc.wantParameter)
+			}
+		})
+	}
+}
+
+func TestFlightOffersSearchBodyTravelers(t *testing.T) {
+	b, err := json.Marshal(flightOffersSearchBody(&FlightOffersSearchRequest{
+		Adults:   2,
+		Children: 1,
+		Infants:  2,
+		OriginDestinations: []*OriginDestination{
+			{OriginLocationCode: "MAD", DestinationLocationCode: "JFK", DepartureDate: "2026-11-01"},
+		},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var body struct {
+		Travelers []struct {
+			Id                string `json:"id"`
+			TravelerType      string `json:"travelerType"`
+			AssociatedAdultId string `json:"associatedAdultId"`
+		} `json:"travelers"`
+	}
+	if err := json.Unmarshal(b, &body); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct{ id, travelerType, adult string }{
+		{"1", "ADULT", ""},
+		{"2", "ADULT", ""},
+		{"3", "CHILD", ""},
+		{"4", "HELD_INFANT", "1"},
+		{"5", "HELD_INFANT", "2"},
+	}
+	if len(body.Travelers) != len(want) {
+		t.Fatalf("got travelers %+v", body.Travelers)
+	}
+	for i, w := range want {
+		got := body.Travelers[i]
+		if got.Id != w.id || got.TravelerType != w.travelerType || got.AssociatedAdultId != w.adult {
+			t.Errorf("traveler %d: got %+v, want %+v", i, got, w)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// putStale stores a response for BA that was cached age ago.
+func putStale(t *testing.T, mw cachemw, age time.Duration) {
+	key, err := cacheKey("AirlineCodeLookup", &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(cacheEntry{Response: airline("BA"), StoredAt: time.Now().Add(-age)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mw.cache.Set(key, b, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func stale(resp *Response) bool {
+	for _, w := range resp.Warnings {
+		if w.Title == "STALE RESPONSE" {
+			return true
+		}
+	}
+	return false
+}
+
+func TestFallsBack(t *testing.T) {
+	cause := errors.New("cause")
+	for _, tc := range []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"unavailable", &UnavailableError{&AmadeusError{Cause: cause}}, true},
+		{"rate limited by Amadeus", &RateLimitError{AmadeusError: &AmadeusError{Status: http.StatusTooManyRequests, Cause: cause}}, true},
+		{"rate limited locally", rateLimitError("AirlineCodeLookup", time.Second), false},
+		{"over quota", &RateLimitError{AmadeusError: &AmadeusError{Status: http.StatusTooManyRequests, Cause: &quotaError{"a", "daily quota of 1 calls"}}}, false},
+		{"invalid", &ValidationError{&AmadeusError{Status: http.StatusBadRequest, Cause: cause}}, false},
+		{"not found", &NotFoundError{&AmadeusError{Status: http.StatusNotFound, Cause: cause}}, false},
+		{"given up", context.Canceled, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fallsBack(tc.err); got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStaleFallback(t *testing.T) {
+	unavailable := &UnavailableError{&AmadeusError{Route: "AirlineCodeLookup", Cause: errors.New("down")}}
+	invalid := &ValidationError{&AmadeusError{Route: "AirlineCodeLookup", Status: http.StatusBadRequest, Cause: errors.New("bad")}}
+
+	for _, tc := range []struct {
+		name      string
+		age       time.Duration
+		err       error
+		wantStale bool
+		wantErr   bool
+	}{
+		{name: "fresh", age: time.Minute},
+		{name: "refreshed", age: time.Hour * 2},
+		{name: "unavailable", age: time.Hour * 2, err: unavailable, wantStale: true},
+		{name: "refused", age: time.Hour * 2, err: invalid, wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sv := &fakeService{lookup: func(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+				if tc.err != nil {
+					return nil, tc.err
+				}
+				return airline("BA"), nil
+			}}
+			mw, _ := newTestCache(sv, cachePolicy{ttl: time.Hour, maxStale: time.Hour * 24})
+			putStale(t, mw, tc.age)
+
+			resp, err := mw.AirlineCodeLookup(context.Background(), &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if stale(resp) != tc.wantStale {
+				t.Errorf("got warnings %v, want stale %t", resp.Warnings, tc.wantStale)
+			}
+		})
+	}
+}
+
+func TestStaleWhileRevalidate(t *testing.T) {
+	refreshed := make(chan struct{})
+	sv := &fakeService{lookup: func(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+		defer close(refreshed)
+		if _, ok := ctx.Deadline(); !ok {
+			t.Error("the refresh has no deadline")
+		}
+		return airline("BA"), nil
+	}}
+	mw, _ := newTestCache(sv, cachePolicy{ttl: time.Hour, maxStale: time.Hour * 24, revalidate: true})
+	putStale(t, mw, time.Hour*2)
+
+	// the request that finds the entry stale gets it at once, even though
+	// it gives up right after
+	ctx, cancel := context.WithCancel(context.Background())
+	resp, err := mw.AirlineCodeLookup(ctx, &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+	cancel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stale(resp) {
+		t.Fatalf("got warnings %v, want a stale response", resp.Warnings)
+	}
+
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("the entry wasn't refreshed")
+	}
+
+	// once the refresh is stored, the next request gets it from the cache
+	deadline := time.Now().Add(time.Second)
+	for {
+		resp, err = mw.AirlineCodeLookup(context.Background(), &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !stale(resp) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the refreshed entry wasn't stored")
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	if sv.count() != 1 {
+		t.Errorf("%d calls got to the service, want the one refresh", sv.count())
+	}
+}
@@ -0,0 +1,361 @@
+package services
+
+import (
+	"amadeus-go/pkg/instrumenting"
+
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	defaultRefreshMargin = time.Minute * 5
+	defaultAuthRetryBase = time.Millisecond * 500
+	defaultAuthRetryMax  = time.Second * 30
+	defaultAuthAttempts  = 5
+)
+
+type amadeusToken struct {
+	Type        string `json:"type"`
+	Username    string `json:"username"`
+	AppName     string `json:"application_name"`
+	ClientId    string `json:"client_id"`
+	TokenType   string `json:"token_type"`
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	State       string `json:"state"`
+	Scope       string `json:"scope"`
+}
+
+type tokenConf struct {
+	RefreshMargin duration `json:"REFRESH_MARGIN"`
+	RetryBase     duration `json:"RETRY_BASE"`
+	RetryMax      duration `json:"RETRY_MAX"`
+	MaxAttempts   int      `json:"MAX_ATTEMPTS"`
+	SharedMargin  duration `json:"SHARED_MARGIN"`
+}
+
+// tokenManager owns the OAuth2 client-credentials token of the service. The
+// token is refreshed in the background a little before it expires, and
+// callers that find it expired anyway share a single refresh between them.
+// With a shared cache, replicas take the token from Redis when it is there.
+type tokenManager struct {
+	authUrl     string
+	auth        authentication
+	httpClient  *http.Client
+	shared      *sharedTokenCache
+	metrics     *instrumenting.Metrics
+	margin      time.Duration
+	retryBase   time.Duration
+	retryMax    time.Duration
+	maxAttempts int
+
+	mu        sync.RWMutex
+	token     *amadeusToken
+	lifetime  time.Duration
+	expiresAt time.Time
+	rejected  string
+
+	group singleflight.Group
+	stop  chan struct{}
+	once  sync.Once
+}
+
+func newTokenManager(configFilename string, urls *serviceUrls, httpClient *http.Client, redisClient *redis.Client) (*tokenManager, error) {
+	var conf struct {
+		authentication
+		Token tokenConf `json:"TOKEN"`
+	}
+
+	err := readConf(configFilename, &conf)
+	if err != nil {
+		return nil, err
+	}
+
+	t := conf.Token
+	if t.RefreshMargin == 0 {
+		t.RefreshMargin = duration(defaultRefreshMargin)
+	}
+	if t.RetryBase == 0 {
+		t.RetryBase = duration(defaultAuthRetryBase)
+	}
+	if t.RetryMax == 0 {
+		t.RetryMax = duration(defaultAuthRetryMax)
+	}
+	if t.MaxAttempts == 0 {
+		t.MaxAttempts = defaultAuthAttempts
+	}
+	if t.SharedMargin == 0 {
+		t.SharedMargin = duration(defaultSharedMargin)
+	}
+
+	tm := &tokenManager{
+		authUrl:     cleanUrl(urls.ApiBaseUrl, urls.AuthUrl),
+		auth:        conf.authentication,
+		httpClient:  httpClient,
+		margin:      time.Duration(t.RefreshMargin),
+		retryBase:   time.Duration(t.RetryBase),
+		retryMax:    time.Duration(t.RetryMax),
+		maxAttempts: t.MaxAttempts,
+		stop:        make(chan struct{}),
+	}
+	if redisClient != nil {
+		tm.shared = newSharedTokenCache(redisClient, conf.ApiKey, time.Duration(t.SharedMargin))
+	}
+
+	return tm, nil
+}
+
+// start fetches the first token and keeps it fresh until close is called.
+func (tm *tokenManager) start(ctx context.Context) error {
+	err := tm.refresh(ctx)
+	if err != nil {
+		return err
+	}
+
+	go tm.loop()
+	return nil
+}
+
+func (tm *tokenManager) close() {
+	tm.once.Do(func() {
+		close(tm.stop)
+	})
+}
+
+// bearer returns the value of the Authorization header for the next upstream
+// call, refreshing the token first if it has already expired.
+func (tm *tokenManager) bearer(ctx context.Context) (string, error) {
+	tm.mu.RLock()
+	token, expiresAt := tm.token, tm.expiresAt
+	tm.mu.RUnlock()
+
+	if token == nil || !time.Now().Before(expiresAt) {
+		err := tm.refresh(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		tm.mu.RLock()
+		token = tm.token
+		tm.mu.RUnlock()
+	}
+
+	return getBearer(token), nil
+}
+
+// valid tells whether there is a token that hasn't expired yet.
+func (tm *tokenManager) valid() bool {
+	tm.mu.RLock()
+	defer tm.mu.RUnlock()
+
+	return tm.token != nil && time.Now().Before(tm.expiresAt)
+}
+
+// invalidate drops the current token, e.g. after Amadeus rejected it, so the
+// next call to bearer fetches a new one. The rejected token is not taken from
+// the shared cache again either.
+func (tm *tokenManager) invalidate() {
+	tm.mu.Lock()
+	if tm.token != nil {
+		tm.rejected = tm.token.AccessToken
+	}
+	tm.expiresAt = time.Time{}
+	tm.mu.Unlock()
+}
+
+// refresh fetches a new token, retrying with backoff. Concurrent callers wait
+// for the same fetch instead of each hitting the auth endpoint.
+func (tm *tokenManager) refresh(ctx context.Context) error {
+	ch := tm.group.DoChan("token", func() (interface{}, error) {
+		err := tm.fetchWithRetry()
+		result := "success"
+		if err != nil {
+			result = "failure"
+		}
+		tm.metrics.TokenRefreshes.With("result", result).Add(1)
+		return nil, err
+	})
+
+	select {
+	case res := <-ch:
+		return res.Err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (tm *tokenManager) fetchWithRetry() error {
+	var err error
+	for attempt := 0; attempt < tm.maxAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(Backoff(attempt, tm.retryBase, tm.retryMax)):
+			case <-tm.stop:
+				return err
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+		var (
+			token    *amadeusToken
+			lifetime time.Duration
+		)
+		token, lifetime, err = tm.obtain(ctx)
+		cancel()
+		if err == nil {
+			tm.mu.Lock()
+			tm.token = token
+			tm.lifetime = lifetime
+			tm.expiresAt = time.Now().Add(lifetime)
+			tm.mu.Unlock()
+			return nil
+		}
+	}
+
+	return err
+}
+
+// obtain returns a token and how long it is valid for, taking it from the
+// shared cache when there is a usable one there. Otherwise whoever holds the
+// lock fetches it from Amadeus while the rest wait for it to show up in the
+// cache. Redis being unavailable is not fatal, the token is fetched directly.
+func (tm *tokenManager) obtain(ctx context.Context) (*amadeusToken, time.Duration, error) {
+	if tm.shared != nil {
+		deadline := time.Now().Add(tm.shared.lockTTL)
+		for time.Now().Before(deadline) {
+			token, ttl, err := tm.shared.read()
+			if err != nil {
+				break
+			}
+			if tm.usable(token, ttl) {
+				return token, ttl, nil
+			}
+
+			locked, err := tm.shared.lock()
+			if err != nil {
+				break
+			}
+			if locked {
+				defer tm.shared.unlock()
+
+				token, err := tm.fetch(ctx)
+				if err != nil {
+					return nil, 0, err
+				}
+				_ = tm.shared.write(token)
+
+				return token, time.Duration(token.ExpiresIn) * time.Second, nil
+			}
+
+			select {
+			case <-time.After(lockPollInterval):
+			case <-ctx.Done():
+				return nil, 0, ctx.Err()
+			}
+		}
+	}
+
+	token, err := tm.fetch(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return token, time.Duration(token.ExpiresIn) * time.Second, nil
+}
+
+// usable tells whether a token found in the shared cache is worth adopting
+// rather than fetching a new one.
+func (tm *tokenManager) usable(token *amadeusToken, ttl time.Duration) bool {
+	tm.mu.RLock()
+	rejected := tm.rejected
+	tm.mu.RUnlock()
+
+	return token != nil && token.AccessToken != rejected && ttl > tm.margin
+}
+
+func (tm *tokenManager) fetch(ctx context.Context) (*amadeusToken, error) {
+	// this is the way to send body of mime-type: x-www-form-urlencoded
+	body := url.Values{}
+	body.Set("client_id", tm.auth.ApiKey)
+	body.Set("client_secret", tm.auth.ApiSecret)
+	body.Set("grant_type", "client_credentials")
+
+	req, err := http.NewRequest("POST", tm.authUrl, strings.NewReader(body.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := tm.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		tm.metrics.ObserveUpstream("AuthUrl", 0)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	tm.metrics.ObserveUpstream("AuthUrl", resp.StatusCode)
+
+	r, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		uErr := &upstreamError{Route: "AuthUrl", StatusCode: resp.StatusCode}
+		var body Response
+		if json.Unmarshal(r, &body) == nil {
+			uErr.Body = &body
+		}
+		return nil, uErr
+	}
+
+	var token amadeusToken
+	err = json.Unmarshal(r, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (tm *tokenManager) loop() {
+	for {
+		// short-lived tokens are refreshed halfway through instead
+		tm.mu.RLock()
+		margin := tm.margin
+		if margin > tm.lifetime/2 {
+			margin = tm.lifetime / 2
+		}
+		wait := time.Until(tm.expiresAt) - margin
+		tm.mu.RUnlock()
+
+		if wait < tm.retryBase {
+			wait = tm.retryBase
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-tm.stop:
+			return
+		}
+
+		// on failure the token is left as it is; callers refresh on their own
+		// once it expires, and the loop tries again shortly
+		err := tm.refresh(context.Background())
+		if err != nil {
+			select {
+			case <-time.After(tm.retryMax):
+			case <-tm.stop:
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"amadeus-go/pkg/instrumenting"
+
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeAuth stands in for /v1/security/oauth2/token. It hands out tokens
+// t1, t2... valid for expiresIn seconds, answers the first failures calls
+// with a 500 and, while hold is open, keeps calls waiting.
+type fakeAuth struct {
+	calls     int32
+	failures  int32
+	expiresIn int64
+	hold      chan struct{}
+}
+
+func (f *fakeAuth) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	n := atomic.AddInt32(&f.calls, 1)
+	if f.hold != nil {
+		<-f.hold
+	}
+
+	if r.Method != http.MethodPost || r.FormValue("grant_type") != "client_credentials" || r.FormValue("client_id") != "key" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if n <= atomic.LoadInt32(&f.failures) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"errors":[{"status":500,"title":"INTERNAL ERROR"}]}`))
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, `{"token_type":"Bearer","access_token":"t%d","expires_in":%d}`, n, f.expiresIn)
+}
+
+func (f *fakeAuth) count() int32 {
+	return atomic.LoadInt32(&f.calls)
+}
+
+func newTestTokenManager(t *testing.T, auth *fakeAuth) *tokenManager {
+	server := httptest.NewServer(auth)
+	t.Cleanup(server.Close)
+
+	tm := &tokenManager{
+		authUrl:     server.URL + "/v1/security/oauth2/token",
+		auth:        authentication{ApiKey: "key", ApiSecret: "secret"},
+		httpClient:  server.Client(),
+		metrics:     instrumenting.DiscardMetrics(),
+		margin:      defaultRefreshMargin,
+		retryBase:   time.Millisecond,
+		retryMax:    time.Millisecond * 5,
+		maxAttempts: 3,
+		stop:        make(chan struct{}),
+	}
+	t.Cleanup(tm.close)
+	return tm
+}
+
+func TestTokenRefreshedBeforeExpiry(t *testing.T) {
+	auth := &fakeAuth{expiresIn: 2}
+	tm := newTestTokenManager(t, auth)
+
+	if err := tm.start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if bearer, _ := tm.bearer(context.Background()); bearer != "Bearer t1" {
+		t.Fatalf("got %q, want the first token", bearer)
+	}
+
+	// a two second token is refreshed halfway through, before it expires
+	deadline := time.Now().Add(time.Second * 2)
+	for auth.count() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond * 50)
+	}
+	if auth.count() != 2 {
+		t.Fatalf("the token was fetched %d times, want 2", auth.count())
+	}
+	if !tm.valid() {
+		t.Fatal("the token expired before it was refreshed")
+	}
+	if bearer, _ := tm.bearer(context.Background()); bearer != "Bearer t2" {
+		t.Fatalf("got %q, want the refreshed token", bearer)
+	}
+}
+
+func TestTokenConcurrentBearersShareOneFetch(t *testing.T) {
+	auth := &fakeAuth{expiresIn: 1800, hold: make(chan struct{})}
+	tm := newTestTokenManager(t, auth)
+
+	const callers = 20
+	var wg sync.WaitGroup
+	bearers := make([]string, callers)
+	errs := make([]error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			bearers[i], errs[i] = tm.bearer(context.Background())
+		}(i)
+	}
+
+	// let every caller get to the fetch before it answers
+	for auth.count() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(time.Millisecond * 50)
+	close(auth.hold)
+	wg.Wait()
+
+	if auth.count() != 1 {
+		t.Fatalf("the token was fetched %d times, want once", auth.count())
+	}
+	for i := 0; i < callers; i++ {
+		if errs[i] != nil || bearers[i] != "Bearer t1" {
+			t.Fatalf("caller %d got %q, %v", i, bearers[i], errs[i])
+		}
+	}
+}
+
+func TestTokenFetchRetriesServerErrors(t *testing.T) {
+	auth := &fakeAuth{expiresIn: 1800, failures: 2}
+	tm := newTestTokenManager(t, auth)
+
+	bearer, err := tm.bearer(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bearer != "Bearer t3" || auth.count() != 3 {
+		t.Fatalf("got %q after %d calls, want the third token", bearer, auth.count())
+	}
+
+	// past the last attempt, the failure is returned
+	auth = &fakeAuth{expiresIn: 1800, failures: 100}
+	tm = newTestTokenManager(t, auth)
+	_, err = tm.bearer(context.Background())
+	if uErr, ok := err.(*upstreamError); !ok || uErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("got %v, want the 500 of the auth endpoint", err)
+	}
+	if auth.count() != int32(tm.maxAttempts) {
+		t.Fatalf("the token was fetched %d times, want %d", auth.count(), tm.maxAttempts)
+	}
+}
+
+func TestTokenFetchBacksOff(t *testing.T) {
+	auth := &fakeAuth{expiresIn: 1800, failures: 100}
+	tm := newTestTokenManager(t, auth)
+	tm.retryBase = time.Hour
+	tm.retryMax = time.Hour
+
+	// with an hour between attempts, only the first one is made before the
+	// manager is closed
+	done := make(chan error)
+	go func() { done <- tm.fetchWithRetry() }()
+	time.Sleep(time.Millisecond * 100)
+	tm.close()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("the fetch succeeded against a failing endpoint")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("closing the manager didn't end the backoff")
+	}
+	if auth.count() != 1 {
+		t.Fatalf("the token was fetched %d times while backing off, want once", auth.count())
+	}
+
+	for attempt := 1; attempt <= 10; attempt++ {
+		ceiling := time.Millisecond << uint(attempt-1)
+		if ceiling > time.Millisecond*50 {
+			ceiling = time.Millisecond * 50
+		}
+		if d := Backoff(attempt, time.Millisecond, time.Millisecond*50); d < 0 || d > ceiling {
+			t.Fatalf("Backoff(%d) = %v, want at most %v", attempt, d, ceiling)
+		}
+	}
+}
+
+func TestTokenInvalidate(t *testing.T) {
+	auth := &fakeAuth{expiresIn: 1800}
+	tm := newTestTokenManager(t, auth)
+
+	if bearer, _ := tm.bearer(context.Background()); bearer != "Bearer t1" {
+		t.Fatalf("got %q, want the first token", bearer)
+	}
+	if bearer, _ := tm.bearer(context.Background()); bearer != "Bearer t1" || auth.count() != 1 {
+		t.Fatalf("got %q after %d calls, want the first token again", bearer, auth.count())
+	}
+
+	tm.invalidate()
+	if tm.valid() {
+		t.Fatal("the token is still valid after invalidate")
+	}
+	if !tm.usable(&amadeusToken{AccessToken: "t2"}, time.Hour) || tm.usable(&amadeusToken{AccessToken: "t1"}, time.Hour) {
+		t.Fatal("the rejected token would be taken from the shared cache again")
+	}
+
+	if bearer, _ := tm.bearer(context.Background()); bearer != "Bearer t2" || auth.count() != 2 {
+		t.Fatalf("got %q after %d calls, want a new token", bearer, auth.count())
+	}
+}
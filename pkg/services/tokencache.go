@@ -0,0 +1,112 @@
+package services
+
+import (
+	"amadeus-go/pkg/services/caching"
+
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+const (
+	defaultSharedMargin = time.Minute
+	defaultLockTTL      = time.Second * 10
+	lockPollInterval    = time.Millisecond * 200
+)
+
+type redisConf struct {
+	Addr     string `json:"ADDR"`
+	Password string `json:"PASSWORD"`
+	DB       int    `json:"DB"`
+}
+
+// newRedisClient connects to the Redis in the REDIS section of the config
+// file. It returns nil, and no error, when no address is configured.
+func newRedisClient(configFilename string) (*redis.Client, error) {
+	var conf struct {
+		Redis redisConf `json:"REDIS"`
+	}
+
+	err := readConf(configFilename, &conf)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Redis.Addr == "" {
+		return nil, nil
+	}
+
+	return caching.InitRedis(conf.Redis.Addr, conf.Redis.Password, conf.Redis.DB)
+}
+
+// sharedTokenCache keeps the access token in Redis so every replica of the
+// service uses the same one, and holds a lock while one replica fetches a new
+// token so the others don't hit the auth endpoint at the same time.
+type sharedTokenCache struct {
+	redisClient *redis.Client
+	key         string
+	lockKey     string
+	owner       string
+	margin      time.Duration
+	lockTTL     time.Duration
+}
+
+func newSharedTokenCache(redisClient *redis.Client, apiKey string, margin time.Duration) *sharedTokenCache {
+	hostname, _ := os.Hostname()
+	key := "amadeus-go:token:" + apiKey
+	return &sharedTokenCache{
+		redisClient: redisClient,
+		key:         key,
+		lockKey:     key + ":lock",
+		owner:       fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		margin:      margin,
+		lockTTL:     defaultLockTTL,
+	}
+}
+
+// read returns the shared token and how long it is still valid for, or a nil
+// token when there is none.
+func (c *sharedTokenCache) read() (*amadeusToken, time.Duration, error) {
+	value, ttl, err := caching.ReadFromRedis(c.redisClient, c.key)
+	if err == redis.Nil {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var token amadeusToken
+	err = json.Unmarshal([]byte(value), &token)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &token, ttl, nil
+}
+
+// write stores token for expires_in minus the safety margin, so no replica
+// picks up a token that is about to be rejected.
+func (c *sharedTokenCache) write(token *amadeusToken) error {
+	ttl := time.Duration(token.ExpiresIn)*time.Second - c.margin
+	if ttl <= 0 {
+		return fmt.Errorf("token lifetime of %ds is shorter than the cache margin", token.ExpiresIn)
+	}
+
+	b, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	return caching.WriteToRedis(c.redisClient, c.key, string(b), ttl)
+}
+
+func (c *sharedTokenCache) lock() (bool, error) {
+	return caching.AcquireLock(c.redisClient, c.lockKey, c.owner, c.lockTTL)
+}
+
+func (c *sharedTokenCache) unlock() error {
+	return caching.ReleaseLock(c.redisClient, c.lockKey, c.owner)
+}
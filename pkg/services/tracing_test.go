@@ -0,0 +1,110 @@
+package services
+
+import (
+	"amadeus-go/pkg/tracing"
+
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedAmadeus answers with statuses in turn, remembering the traceparent
+// header of each call.
+type tracedAmadeus struct {
+	fakeAmadeus
+
+	mu           sync.Mutex
+	traceparents []string
+}
+
+func (f *tracedAmadeus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.traceparents = append(f.traceparents, r.Header.Get("traceparent"))
+	f.mu.Unlock()
+	f.fakeAmadeus.ServeHTTP(w, r)
+}
+
+func TestClientSpans(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		script []int
+		// the HTTP status of each span, and whether the last one is an error
+		wantStatuses []int64
+		wantError    bool
+	}{
+		{"success", []int{http.StatusOK}, []int64{200}, false},
+		{"retried", []int{http.StatusServiceUnavailable, http.StatusOK}, []int64{503, 200}, false},
+		{"failed", []int{http.StatusBadRequest}, []int64{400}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+			upstream := &tracedAmadeus{fakeAmadeus: fakeAmadeus{script: tc.script}}
+			c, url := newTestClient(t, upstream, &fakeAuth{expiresIn: 1800})
+			c.tracer = tracing.Tracer(provider)
+
+			ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+			req, err := http.NewRequest(http.MethodGet, url+"/v1/reference-data/airlines", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var resp Response
+			err = c.do(ctx, "AirlineCodeLookup", req, &resp)
+			parent.End()
+			if (err != nil) != tc.wantError {
+				t.Fatalf("got %v", err)
+			}
+
+			var spans []tracetest.SpanStub
+			for _, span := range exporter.GetSpans() {
+				if span.Name == "amadeus.AirlineCodeLookup" {
+					spans = append(spans, span)
+				}
+			}
+			if len(spans) != len(tc.wantStatuses) {
+				t.Fatalf("got %d client spans, want %d", len(spans), len(tc.wantStatuses))
+			}
+
+			upstream.mu.Lock()
+			defer upstream.mu.Unlock()
+			for i, span := range spans {
+				if span.SpanKind != trace.SpanKindClient {
+					t.Errorf("span %d is a %s span, want a client one", i, span.SpanKind)
+				}
+				if span.Parent.SpanID() != parent.SpanContext().SpanID() {
+					t.Errorf("span %d isn't a child of the caller's span", i)
+				}
+
+				attributes := make(map[string]interface{})
+				for _, kv := range span.Attributes {
+					attributes[string(kv.Key)] = kv.Value.AsInterface()
+				}
+				if attributes["amadeus.route"] != "AirlineCodeLookup" {
+					t.Errorf("span %d has route %v", i, attributes["amadeus.route"])
+				}
+				if attributes["http.status_code"] != tc.wantStatuses[i] {
+					t.Errorf("span %d has status %v, want %d", i, attributes["http.status_code"], tc.wantStatuses[i])
+				}
+
+				// Amadeus is told about the span of each call
+				want := fmt.Sprintf("00-%s-%s-01", span.SpanContext.TraceID(), span.SpanContext.SpanID())
+				if upstream.traceparents[i] != want {
+					t.Errorf("call %d sent traceparent %q, want %q", i, upstream.traceparents[i], want)
+				}
+			}
+
+			last := spans[len(spans)-1]
+			if (last.Status.Code == codes.Error) != tc.wantError {
+				t.Errorf("the last span has status %s", last.Status.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,469 @@
+package services
+
+import (
+	"amadeus-go/pkg/auth"
+	"amadeus-go/pkg/logging"
+
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/go-redis/redis"
+)
+
+const (
+	// anonymousTenant is who calls are accounted to when authentication is off
+	anonymousTenant = "anonymous"
+
+	periodDay   = "day"
+	periodMonth = "month"
+
+	dayLayout   = "2006-01-02"
+	monthLayout = "2006-01"
+
+	upstreamField  = "upstream"
+	cacheHitsField = "cache_hits"
+	coalescedField = "coalesced"
+)
+
+// UsageService reports how much of the Amadeus account each tenant used.
+type UsageService interface {
+	GetUsage(context.Context, *GetUsageRequest) (*Usage, error)
+}
+
+type GetUsageRequest struct {
+	Tenant string
+	// Period is "day" (the default) or "month"
+	Period string
+	// Date is the day (2006-01-02) or month (2006-01) to report, in UTC; the
+	// current one when empty
+	Date string
+}
+
+// Usage counts the calls a tenant made in a period: the ones that went to
+// Amadeus, and separately the ones served from the cache and the ones that
+// shared an identical call in flight for another caller. Quota is the limit
+// on upstream calls for the period, 0 when there is none.
+type Usage struct {
+	Tenant         string
+	Period         string
+	Date           string
+	UpstreamCalls  int64
+	CacheHits      int64
+	CoalescedCalls int64
+	Quota          int64
+	Methods        []*MethodUsage
+}
+
+type MethodUsage struct {
+	Method         string
+	UpstreamCalls  int64
+	CacheHits      int64
+	CoalescedCalls int64
+}
+
+type quota struct {
+	Daily   int64 `json:"DAILY"`
+	Monthly int64 `json:"MONTHLY"`
+}
+
+type quotaConf struct {
+	quota
+	Tenants map[string]quota `json:"TENANTS"`
+}
+
+// usageTracker counts the calls of each tenant per day and per month, and
+// refuses upstream calls past the tenant's quota.
+type usageTracker struct {
+	store  usageStore
+	quotas quotaConf
+	logger log.Logger
+}
+
+func newUsageTracker(configFilename string, redisClient *redis.Client, logger log.Logger) (*usageTracker, error) {
+	var conf struct {
+		Quotas quotaConf `json:"QUOTAS"`
+	}
+
+	err := readConf(configFilename, &conf)
+	if err != nil {
+		return nil, err
+	}
+
+	var store usageStore
+	if redisClient != nil {
+		store = &redisUsageStore{redisClient}
+	} else {
+		store = &memoryUsageStore{counters: make(map[string]map[string]int64)}
+	}
+
+	return &usageTracker{store: store, quotas: conf.Quotas, logger: logger}, nil
+}
+
+func (u *usageTracker) quotaFor(tenant string) quota {
+	if q, ok := u.quotas.Tenants[tenant]; ok {
+		return q
+	}
+	return u.quotas.quota
+}
+
+// reserve accounts an upstream call of method to the tenant in ctx, or
+// refuses it with a RateLimitError if that would exceed the tenant's quota.
+// The counters being unavailable doesn't stop the call.
+func (u *usageTracker) reserve(ctx context.Context, method string) error {
+	tenant := tenantOf(ctx)
+	q := u.quotaFor(tenant)
+	now := time.Now().UTC()
+
+	day, month := usageKeys(tenant, now)
+	counts, err := u.store.incr(day, month, method+":"+upstreamField, upstreamField, 1)
+	if err != nil {
+		u.report(ctx, tenant, method, "reserve", err)
+		return nil
+	}
+
+	err = q.exceeded(tenant, method, counts, now)
+	if err != nil {
+		// the refused call doesn't count
+		u.release(ctx, method)
+	}
+	return err
+}
+
+// release hands back the upstream call reserved for a call of method that
+// didn't go to Amadeus after all.
+func (u *usageTracker) release(ctx context.Context, method string) {
+	tenant := tenantOf(ctx)
+	day, month := usageKeys(tenant, time.Now().UTC())
+	if _, err := u.store.incr(day, month, method+":"+upstreamField, upstreamField, -1); err != nil {
+		u.report(ctx, tenant, method, "release", err)
+	}
+}
+
+// check refuses a call of method with a RateLimitError if the tenant in ctx
+// has no upstream call left in its quota, without accounting anything. It is
+// for calls that ride on the upstream call of another caller.
+func (u *usageTracker) check(ctx context.Context, method string) error {
+	tenant := tenantOf(ctx)
+	q := u.quotaFor(tenant)
+	if q.Daily <= 0 && q.Monthly <= 0 {
+		return nil
+	}
+	now := time.Now().UTC()
+
+	day, month := usageKeys(tenant, now)
+	var counts [2]int64
+	for i, key := range []string{day, month} {
+		fields, err := u.store.get(key)
+		if err != nil {
+			u.report(ctx, tenant, method, "check", err)
+			return nil
+		}
+		// as if this call went upstream too
+		counts[i] = fields[upstreamField] + 1
+	}
+	return q.exceeded(tenant, method, counts, now)
+}
+
+// hit accounts a call of method served from the cache to the tenant in ctx.
+func (u *usageTracker) hit(ctx context.Context, method string) {
+	u.count(ctx, method, cacheHitsField)
+}
+
+// coalesced accounts a call of method that shared the upstream call of
+// another caller to the tenant in ctx.
+func (u *usageTracker) coalesced(ctx context.Context, method string) {
+	u.count(ctx, method, coalescedField)
+}
+
+func (u *usageTracker) count(ctx context.Context, method string, field string) {
+	tenant := tenantOf(ctx)
+	day, month := usageKeys(tenant, time.Now().UTC())
+	if _, err := u.store.incr(day, month, method+":"+field, field, 1); err != nil {
+		u.report(ctx, tenant, method, field, err)
+	}
+}
+
+// exceeded returns the RateLimitError refusing a call of method if the day
+// and month counts of upstream calls of tenant go over q.
+func (q quota) exceeded(tenant string, method string, counts [2]int64, now time.Time) error {
+	var exceeded string
+	var retryAfter time.Duration
+	switch {
+	case q.Daily > 0 && counts[0] > q.Daily:
+		exceeded = fmt.Sprintf("daily quota of %d calls", q.Daily)
+		retryAfter = now.Truncate(time.Hour * 24).Add(time.Hour * 24).Sub(now)
+	case q.Monthly > 0 && counts[1] > q.Monthly:
+		exceeded = fmt.Sprintf("monthly quota of %d calls", q.Monthly)
+		retryAfter = time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC).Sub(now)
+	default:
+		return nil
+	}
+
+	return &RateLimitError{
+		AmadeusError: &AmadeusError{
+			Route:  method,
+			Status: http.StatusTooManyRequests,
+			Cause:  &quotaError{tenant, exceeded},
+		},
+		RetryAfter: retryAfter,
+	}
+}
+
+// GetUsage reports the usage of a tenant. Tenants may only look at their own
+// usage, which is also what they get when they don't name one; admins may
+// look at anyone's.
+func (u *usageTracker) GetUsage(ctx context.Context, req *GetUsageRequest) (*Usage, error) {
+	caller, authenticated := auth.FromContext(ctx)
+
+	tenant := req.Tenant
+	if tenant == "" {
+		tenant = tenantOf(ctx)
+	}
+	if authenticated && !caller.Admin && tenant != caller.ID {
+		return nil, auth.ErrPermissionDenied
+	}
+
+	period, date := req.Period, req.Date
+	if period == "" {
+		period = periodDay
+	}
+
+	var layout string
+	var limit func(quota) int64
+	switch period {
+	case periodDay:
+		layout, limit = dayLayout, func(q quota) int64 { return q.Daily }
+	case periodMonth:
+		layout, limit = monthLayout, func(q quota) int64 { return q.Monthly }
+	default:
+		return nil, usageValidationError("period", "must be day or month")
+	}
+
+	if date == "" {
+		date = time.Now().UTC().Format(layout)
+	} else if _, err := time.Parse(layout, date); err != nil {
+		return nil, usageValidationError("date", "must be formatted as "+layout)
+	}
+
+	fields, err := u.store.get(usageKey(tenant, period, date))
+	if err != nil {
+		return nil, &UnavailableError{&AmadeusError{Route: "GetUsage", Cause: err}}
+	}
+
+	usage := &Usage{
+		Tenant:         tenant,
+		Period:         period,
+		Date:           date,
+		UpstreamCalls:  fields[upstreamField],
+		CacheHits:      fields[cacheHitsField],
+		CoalescedCalls: fields[coalescedField],
+		Quota:          limit(u.quotaFor(tenant)),
+	}
+
+	methods := make(map[string]*MethodUsage)
+	for field, n := range fields {
+		i := strings.LastIndex(field, ":")
+		if i < 0 {
+			continue
+		}
+
+		method := field[:i]
+		m, ok := methods[method]
+		if !ok {
+			m = &MethodUsage{Method: method}
+			methods[method] = m
+			usage.Methods = append(usage.Methods, m)
+		}
+		switch field[i+1:] {
+		case upstreamField:
+			m.UpstreamCalls = n
+		case cacheHitsField:
+			m.CacheHits = n
+		case coalescedField:
+			m.CoalescedCalls = n
+		}
+	}
+	sort.Slice(usage.Methods, func(i, j int) bool {
+		return usage.Methods[i].Method < usage.Methods[j].Method
+	})
+
+	return usage, nil
+}
+
+func (u *usageTracker) report(ctx context.Context, tenant string, method string, action string, err error) {
+	_ = level.Warn(logging.WithContext(u.logger, ctx)).Log(
+		"layer", "usage",
+		"tenant", tenant,
+		"method", method,
+		"action", action,
+		"error", err,
+	)
+}
+
+// quotaError is the cause of the RateLimitError of a call refused for going
+// over the quota of its tenant.
+type quotaError struct {
+	tenant   string
+	exceeded string
+}
+
+func (e *quotaError) Error() string {
+	return fmt.Sprintf("tenant %s used up its %s", e.tenant, e.exceeded)
+}
+
+// overQuota tells whether err refused a call for going over the quota of its
+// tenant, rather than over a rate limit.
+func overQuota(err error) bool {
+	rlErr, ok := err.(*RateLimitError)
+	if !ok {
+		return false
+	}
+	_, ok = rlErr.Cause.(*quotaError)
+	return ok
+}
+
+func tenantOf(ctx context.Context) string {
+	if tenant, ok := auth.FromContext(ctx); ok {
+		return tenant.ID
+	}
+	return anonymousTenant
+}
+
+func usageKey(tenant string, period string, date string) string {
+	return "amadeus-go:usage:" + tenant + ":" + period + ":" + date
+}
+
+// usageKeys returns the keys of the day and the month of now.
+func usageKeys(tenant string, now time.Time) (string, string) {
+	return usageKey(tenant, periodDay, now.Format(dayLayout)), usageKey(tenant, periodMonth, now.Format(monthLayout))
+}
+
+type contextKey int
+
+const sentKey contextKey = iota
+
+// withSent returns a copy of ctx in which the client flags that it sent a
+// request to Amadeus, and a func telling whether it did.
+func withSent(ctx context.Context) (context.Context, func() bool) {
+	sent := new(int32)
+	return context.WithValue(ctx, sentKey, sent), func() bool { return atomic.LoadInt32(sent) != 0 }
+}
+
+// markSent flags in ctx that a request went to Amadeus.
+func markSent(ctx context.Context) {
+	if sent, ok := ctx.Value(sentKey).(*int32); ok {
+		atomic.StoreInt32(sent, 1)
+	}
+}
+
+func usageValidationError(parameter string, detail string) error {
+	return &ValidationError{&AmadeusError{
+		Route:  "GetUsage",
+		Status: http.StatusBadRequest,
+		Errors: []*ErrorWarning{{
+			Status: http.StatusBadRequest,
+			Title:  "INVALID FORMAT",
+			Detail: parameter + ": " + detail,
+			Source: &Source{Parameter: parameter},
+		}},
+	}}
+}
+
+// usageStore keeps the counters of a period as fields of one key: a field
+// per method and a total.
+type usageStore interface {
+	// incr adds n to the method field and to the total of both the day and
+	// the month key, and returns the new day and month totals
+	incr(day string, month string, methodField string, totalField string, n int64) ([2]int64, error)
+	get(key string) (map[string]int64, error)
+}
+
+// counters are kept a little longer than the period they're for, so the
+// last one can still be looked at
+const (
+	dayUsageTTL   = time.Hour * 24 * 40
+	monthUsageTTL = time.Hour * 24 * 400
+)
+
+type redisUsageStore struct {
+	redisClient *redis.Client
+}
+
+func (s *redisUsageStore) incr(day string, month string, methodField string, totalField string, n int64) ([2]int64, error) {
+	var dayTotal, monthTotal *redis.IntCmd
+	_, err := s.redisClient.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(day, methodField, n)
+		dayTotal = pipe.HIncrBy(day, totalField, n)
+		pipe.Expire(day, dayUsageTTL)
+		pipe.HIncrBy(month, methodField, n)
+		monthTotal = pipe.HIncrBy(month, totalField, n)
+		pipe.Expire(month, monthUsageTTL)
+		return nil
+	})
+	if err != nil {
+		return [2]int64{}, err
+	}
+
+	return [2]int64{dayTotal.Val(), monthTotal.Val()}, nil
+}
+
+func (s *redisUsageStore) get(key string) (map[string]int64, error) {
+	values, err := s.redisClient.HGetAll(key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]int64)
+	for field, v := range values {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err == nil {
+			fields[field] = n
+		}
+	}
+	return fields, nil
+}
+
+// memoryUsageStore keeps the counters of a single replica, for when there is
+// no Redis. They are lost on restart.
+type memoryUsageStore struct {
+	mu       sync.Mutex
+	counters map[string]map[string]int64
+}
+
+func (s *memoryUsageStore) incr(day string, month string, methodField string, totalField string, n int64) ([2]int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var totals [2]int64
+	for i, key := range []string{day, month} {
+		fields, ok := s.counters[key]
+		if !ok {
+			fields = make(map[string]int64)
+			s.counters[key] = fields
+		}
+		fields[methodField] += n
+		fields[totalField] += n
+		totals[i] = fields[totalField]
+	}
+	return totals, nil
+}
+
+func (s *memoryUsageStore) get(key string) (map[string]int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fields := make(map[string]int64)
+	for field, n := range s.counters[key] {
+		fields[field] = n
+	}
+	return fields, nil
+}
@@ -0,0 +1,346 @@
+package services
+
+import (
+	"amadeus-go/pkg/auth"
+
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"golang.org/x/sync/singleflight"
+)
+
+func tenantContext(id string) context.Context {
+	return auth.NewContext(context.Background(), &auth.Tenant{ID: id})
+}
+
+func usageOf(t *testing.T, u *usageTracker, tenant string) *Usage {
+	t.Helper()
+	usage, err := u.GetUsage(tenantContext(tenant), &GetUsageRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return usage
+}
+
+// sendingService is an Amadeus service whose lookups say they went upstream,
+// unless it is told to fail before sending them.
+func sendingService(notSent error) *fakeService {
+	return &fakeService{lookup: func(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+		if notSent != nil {
+			return nil, notSent
+		}
+		markSent(ctx)
+		return airline(req.AirlineCodes), nil
+	}}
+}
+
+func TestQuota(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		quotas quotaConf
+		tenant string
+		// calls made, and how many must get through
+		calls   int
+		allowed int
+	}{
+		{"no quota", quotaConf{}, "acme", 5, 5},
+		{"daily", quotaConf{quota: quota{Daily: 3}}, "acme", 5, 3},
+		{"monthly", quotaConf{quota: quota{Monthly: 2}}, "acme", 5, 2},
+		{"tenant's own", quotaConf{quota: quota{Daily: 1}, Tenants: map[string]quota{"acme": {Daily: 4}}}, "acme", 5, 4},
+		{"default for other tenants", quotaConf{quota: quota{Daily: 1}, Tenants: map[string]quota{"acme": {Daily: 4}}}, "other", 5, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			u := newTestUsage(tc.quotas)
+			ctx := tenantContext(tc.tenant)
+
+			allowed := 0
+			for i := 0; i < tc.calls; i++ {
+				err := u.reserve(ctx, "AirlineCodeLookup")
+				if err == nil {
+					allowed++
+					continue
+				}
+				if !overQuota(err) {
+					t.Fatalf("got %v, want the quota used up", err)
+				}
+				if err.(*RateLimitError).RetryAfter <= 0 {
+					t.Error("the refusal doesn't say when the quota frees up")
+				}
+			}
+			if allowed != tc.allowed {
+				t.Errorf("%d calls got through, want %d", allowed, tc.allowed)
+			}
+			// refused calls don't count
+			if usage := usageOf(t, u, tc.tenant); usage.UpstreamCalls != int64(tc.allowed) {
+				t.Errorf("got %d upstream calls, want %d", usage.UpstreamCalls, tc.allowed)
+			}
+		})
+	}
+}
+
+func TestQuotaCheckCountsNothing(t *testing.T) {
+	u := newTestUsage(quotaConf{quota: quota{Daily: 1}})
+	ctx := tenantContext("acme")
+
+	if err := u.check(ctx, "AirlineCodeLookup"); err != nil {
+		t.Fatalf("a tenant with quota left was refused: %v", err)
+	}
+	if usage := usageOf(t, u, "acme"); usage.UpstreamCalls != 0 {
+		t.Fatalf("the check counted %d upstream calls", usage.UpstreamCalls)
+	}
+
+	if err := u.reserve(ctx, "AirlineCodeLookup"); err != nil {
+		t.Fatal(err)
+	}
+	if err := u.check(ctx, "AirlineCodeLookup"); !overQuota(err) {
+		t.Fatalf("got %v, want the quota used up", err)
+	}
+}
+
+func TestQuotaChargesOnlySentCalls(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		sv       *fakeService
+		wantErr  bool
+		wantUsed int64
+	}{
+		{"sent", sendingService(nil), false, 1},
+		{"refused by the rate limiter", sendingService(rateLimitError("AirlineCodeLookup", time.Second)), true, 0},
+		{"given up before sending", sendingService(context.DeadlineExceeded), true, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			u := newTestUsage(quotaConf{})
+			mw := quotamw{u, nil, tc.sv}
+
+			_, err := mw.AirlineCodeLookup(tenantContext("acme"), &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got %v", err)
+			}
+			if usage := usageOf(t, u, "acme"); usage.UpstreamCalls != tc.wantUsed {
+				t.Errorf("got %d upstream calls, want %d", usage.UpstreamCalls, tc.wantUsed)
+			}
+		})
+	}
+}
+
+func TestClientFlagsSentCalls(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		auth     *fakeAuth
+		limiter  *rateLimiter
+		wantSent bool
+	}{
+		{"sent", &fakeAuth{expiresIn: 1800}, nil, true},
+		{"refused by the rate limiter", &fakeAuth{expiresIn: 1800}, newRateLimiter(rateLimitConf{rateConf: rateConf{TPS: 1, Burst: 1}, Mode: "fail"}), false},
+		{"no token", &fakeAuth{expiresIn: 1800, failures: 100}, nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			upstream := &fakeAmadeus{}
+			c, url := newTestClient(t, upstream, tc.auth)
+			c.limiter = tc.limiter
+			if tc.limiter != nil {
+				// use up the burst
+				_ = tc.limiter.wait(context.Background(), "AirlineCodeLookup")
+			}
+
+			req, err := http.NewRequest(http.MethodGet, url+"/v1/reference-data/airlines", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ctx, sent := withSent(context.Background())
+			var resp Response
+			_ = c.do(ctx, "AirlineCodeLookup", req, &resp)
+
+			if sent() != tc.wantSent {
+				t.Errorf("the call was flagged as sent: %t, want %t", sent(), tc.wantSent)
+			}
+			if got := upstream.count() > 0; got != tc.wantSent {
+				t.Errorf("the call reached Amadeus: %t, want %t", got, tc.wantSent)
+			}
+		})
+	}
+}
+
+// orderService books nothing, but looks orders up upstream.
+type orderService struct {
+	AmadeusService
+	calls int32
+}
+
+func (s *orderService) GetFlightOrder(ctx context.Context, req *GetFlightOrderRequest) (*FlightOrderResponse, error) {
+	atomic.AddInt32(&s.calls, 1)
+	markSent(ctx)
+	return &FlightOrderResponse{}, nil
+}
+
+func TestOrderOwnershipCheckedBeforeQuota(t *testing.T) {
+	orders := newTestOrderBook(time.Hour, time.Hour)
+	b, _ := json.Marshal(orderRecord{Id: "1", Tenant: "acme"})
+	if err := orders.store.set(orderKey("1"), b, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	u := newTestUsage(quotaConf{quota: quota{Daily: 1}})
+	sv := &orderService{}
+	mw := quotamw{u, orders, sv}
+
+	// neither invalid lookups nor probing the orders of another tenant use up
+	// any of the caller's quota
+	if _, err := mw.GetFlightOrder(tenantContext("other"), &GetFlightOrderRequest{}); ErrorKind(err) != "validation" {
+		t.Fatalf("got %v, want a validation error", err)
+	}
+	for _, id := range []string{"1", "2", "3"} {
+		if _, err := mw.GetFlightOrder(tenantContext("other"), &GetFlightOrderRequest{FlightOrderId: id}); err != auth.ErrPermissionDenied {
+			t.Fatalf("order %s: got %v, want permission denied", id, err)
+		}
+	}
+	if atomic.LoadInt32(&sv.calls) != 0 {
+		t.Fatal("a refused lookup went upstream")
+	}
+	if usage := usageOf(t, u, "other"); usage.UpstreamCalls != 0 {
+		t.Fatalf("refused lookups counted %d upstream calls", usage.UpstreamCalls)
+	}
+
+	if _, err := mw.GetFlightOrder(tenantContext("acme"), &GetFlightOrderRequest{FlightOrderId: "1"}); err != nil {
+		t.Fatalf("the tenant that booked the order was refused: %v", err)
+	}
+	if usage := usageOf(t, u, "acme"); usage.UpstreamCalls != 1 {
+		t.Errorf("got %d upstream calls, want 1", usage.UpstreamCalls)
+	}
+}
+
+func TestCoalescedCallsNeedQuota(t *testing.T) {
+	u := newTestUsage(quotaConf{Tenants: map[string]quota{"spent": {Daily: 1}}})
+	if err := u.reserve(tenantContext("spent"), "AirlineCodeLookup"); err != nil {
+		t.Fatal(err)
+	}
+
+	sv, started, release := blockedService()
+	lookup := sv.lookup
+	sv.lookup = func(ctx context.Context, req *AirlineCodeLookupRequest) (*Response, error) {
+		markSent(ctx)
+		return lookup(ctx, req)
+	}
+	mw := coalescemw{u, func(string) time.Duration { return time.Second }, log.NewNopLogger(), quotamw{u, nil, sv}, &singleflight.Group{}}
+
+	var wg sync.WaitGroup
+	leader := make(chan error, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := mw.AirlineCodeLookup(tenantContext("acme"), &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+		leader <- err
+	}()
+	<-started
+
+	// a tenant without quota left can't ride on the call in flight
+	if _, err := mw.AirlineCodeLookup(tenantContext("spent"), &AirlineCodeLookupRequest{AirlineCodes: "BA"}); !overQuota(err) {
+		t.Fatalf("got %v, want the quota used up", err)
+	}
+
+	follower := make(chan error, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := mw.AirlineCodeLookup(tenantContext("other"), &AirlineCodeLookupRequest{AirlineCodes: "BA"})
+		follower <- err
+	}()
+	time.Sleep(time.Millisecond * 20)
+	close(release)
+	wg.Wait()
+
+	for _, err := range []error{<-leader, <-follower} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := sv.count(); n != 1 {
+		t.Fatalf("Amadeus was called %d times, want once", n)
+	}
+
+	if usage := usageOf(t, u, "acme"); usage.UpstreamCalls != 1 || usage.CoalescedCalls != 0 {
+		t.Errorf("the leader got %d upstream and %d coalesced calls, want 1 and 0", usage.UpstreamCalls, usage.CoalescedCalls)
+	}
+	usage := usageOf(t, u, "other")
+	if usage.UpstreamCalls != 0 || usage.CoalescedCalls != 1 {
+		t.Errorf("the follower got %d upstream and %d coalesced calls, want 0 and 1", usage.UpstreamCalls, usage.CoalescedCalls)
+	}
+	if len(usage.Methods) != 1 || usage.Methods[0].CoalescedCalls != 1 {
+		t.Errorf("got methods %+v, want the coalesced lookup", usage.Methods)
+	}
+}
+
+func TestUsageStoreFailureLetsCallsThrough(t *testing.T) {
+	u := newTestUsage(quotaConf{quota: quota{Daily: 1}})
+	u.store = brokenUsageStore{}
+	ctx := tenantContext("acme")
+
+	for i := 0; i < 3; i++ {
+		if err := u.reserve(ctx, "AirlineCodeLookup"); err != nil {
+			t.Fatalf("call %d refused: %v", i, err)
+		}
+		if err := u.check(ctx, "AirlineCodeLookup"); err != nil {
+			t.Fatalf("check %d refused: %v", i, err)
+		}
+	}
+	if _, err := u.GetUsage(ctx, &GetUsageRequest{}); err == nil {
+		t.Fatal("the usage was reported without its counters")
+	}
+}
+
+type brokenUsageStore struct{}
+
+func (brokenUsageStore) incr(string, string, string, string, int64) ([2]int64, error) {
+	return [2]int64{}, errors.New("down")
+}
+
+func (brokenUsageStore) get(string) (map[string]int64, error) {
+	return nil, errors.New("down")
+}
+
+func TestGetUsage(t *testing.T) {
+	u := newTestUsage(quotaConf{quota: quota{Daily: 10, Monthly: 100}})
+	_ = u.reserve(tenantContext("acme"), "AirlineCodeLookup")
+	u.hit(tenantContext("acme"), "AirlineCodeLookup")
+
+	admin := auth.NewContext(context.Background(), &auth.Tenant{ID: "ops", Admin: true})
+	for _, tc := range []struct {
+		name      string
+		ctx       context.Context
+		req       *GetUsageRequest
+		wantErr   error
+		wantCalls int64
+		wantQuota int64
+	}{
+		{"own", tenantContext("acme"), &GetUsageRequest{}, nil, 1, 10},
+		{"own month", tenantContext("acme"), &GetUsageRequest{Period: "month"}, nil, 1, 100},
+		{"another tenant's", tenantContext("other"), &GetUsageRequest{Tenant: "acme"}, auth.ErrPermissionDenied, 0, 0},
+		{"admin", admin, &GetUsageRequest{Tenant: "acme"}, nil, 1, 10},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			usage, err := u.GetUsage(tc.ctx, tc.req)
+			if err != tc.wantErr {
+				t.Fatalf("got %v, want %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if usage.UpstreamCalls != tc.wantCalls || usage.CacheHits != 1 || usage.Quota != tc.wantQuota {
+				t.Errorf("got %+v", usage)
+			}
+		})
+	}
+
+	for _, req := range []*GetUsageRequest{{Period: "week"}, {Date: "2026-13"}} {
+		if _, err := u.GetUsage(tenantContext("acme"), req); ErrorKind(err) != "validation" {
+			t.Errorf("%+v: got %v, want a validation error", req, err)
+		}
+	}
+}
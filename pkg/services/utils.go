@@ -1,95 +1,98 @@
 package services
 
 import (
+	"amadeus-go/pkg/services/caching"
+
 	"encoding/json"
-	"io/ioutil"
-	"net/http"
-	"net/url"
+	"errors"
+	"fmt"
+	"math/rand"
 	"os"
-	"strings"
 	"time"
+
+	"github.com/go-redis/redis"
 	//_ "github.com/jinzhu/gorm/dialects/postgres"
 )
 
+const defaultCacheSize = 1024
+
 type authentication struct {
 	ApiKey    string `json:"API_KEY"`
 	ApiSecret string `json:"API_SECRET"`
 }
 
-type amadeusToken struct {
-	Type           string        `json:"type"`
-	Username       string        `json:"username"`
-	AppName        string        `json:"application_name"`
-	ClientId       string        `json:"client_id"`
-	TokenType      string        `json:"token_type"`
-	AccessToken    string        `json:"access_token"`
-	ExpiresIn      time.Duration `json:"expires_in"`
-	State          string        `json:"state"`
-	Scope          string        `json:"scope"`
-	TokenFetchTime time.Duration
-}
-
-func getTokenFromAmadeus(configFilename string, urls *serviceUrls) (*amadeusToken, error) {
-	var (
-		auth  authentication
-		err   error
-		token amadeusToken
-	)
+// duration lets config files spell timeouts the way time.ParseDuration reads
+// them, e.g. "1m30s", instead of as a raw count of nanoseconds
+type duration time.Duration
 
-	err = readConf(configFilename, &auth)
+func (d *duration) UnmarshalJSON(b []byte) error {
+	var s string
+	err := json.Unmarshal(b, &s)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// this is the way to send body of mime-type: x-www-form-urlencoded
-	body := url.Values{}
-	body.Set("client_id", auth.ApiKey)
-	body.Set("client_secret", auth.ApiSecret)
-	body.Set("grant_type", "client_credentials")
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
 
-	contentType := "application/x-www-form-urlencoded"
-	urlStr := cleanUrl(urls.ApiBaseUrl, urls.AuthUrl)
-	resp, err := http.Post(urlStr, contentType, strings.NewReader(body.Encode()))
+	*d = duration(v)
+	return nil
+}
 
-	// fetch token when time expires
-	now := time.Now().Unix()
+type cacheConf struct {
+	Backend              string              `json:"BACKEND"`
+	Size                 int                 `json:"SIZE"`
+	TTL                  map[string]duration `json:"TTL"`
+	MaxStale             map[string]duration `json:"MAX_STALE"`
+	StaleWhileRevalidate []string            `json:"STALE_WHILE_REVALIDATE"`
+}
 
-	defer resp.Body.Close()
-	if err != nil {
-		return nil, err
+// getResponseCache builds the response cache from the CACHE section of the
+// config file, returning a nil cache when it has no TTL for any method.
+func getResponseCache(configFilename string, redisClient *redis.Client) (caching.Cache, map[string]cachePolicy, error) {
+	var conf struct {
+		Cache cacheConf `json:"CACHE"`
 	}
 
-	r, err := ioutil.ReadAll(resp.Body)
+	err := readConf(configFilename, &conf)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	err = json.Unmarshal(r, &token)
-	if err != nil {
-		return nil, err
+	policies := make(map[string]cachePolicy)
+	for method, ttl := range conf.Cache.TTL {
+		policies[method] = cachePolicy{
+			ttl:      time.Duration(ttl),
+			maxStale: time.Duration(conf.Cache.MaxStale[method]),
+		}
 	}
-
-	token.TokenFetchTime = time.Duration(now)
-	return &token, nil
-}
-
-func checkTokenExpiry(sv *amadeusService) error {
-	now := time.Duration(time.Now().Unix())
-	tokenExpiry := (*sv).token.ExpiresIn + (*sv).token.TokenFetchTime
-
-	if now > tokenExpiry {
-		var urls serviceUrls
-		configFilename := (*sv).configFilename
-
-		token, err := getTokenFromAmadeus(configFilename, &urls)
-		if err != nil {
-			return err
+	for _, method := range conf.Cache.StaleWhileRevalidate {
+		if policy, ok := policies[method]; ok {
+			policy.revalidate = true
+			policies[method] = policy
 		}
-
-		(*sv).token = token
+	}
+	if len(policies) == 0 {
+		return nil, nil, nil
 	}
 
-	return nil
+	switch conf.Cache.Backend {
+	case "", "memory":
+		size := conf.Cache.Size
+		if size <= 0 {
+			size = defaultCacheSize
+		}
+		return caching.NewLRUCache(size), policies, nil
+	case "redis":
+		if redisClient == nil {
+			return nil, nil, errors.New("the redis cache backend needs a REDIS address")
+		}
+		return caching.NewRedisCache(redisClient, "amadeus-go:response:"), policies, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown cache backend %q", conf.Cache.Backend)
+	}
 }
 
 func getServicesURLs(urlsFilename string) (*serviceUrls, error) {
@@ -111,6 +114,18 @@ func getBearer(token *amadeusToken) string {
 	return token.TokenType + " " + token.AccessToken
 }
 
+// Backoff returns how long to wait before the given retry attempt (starting
+// at 1): exponential in the attempt, capped at max, with full jitter. Calls to
+// Amadeus and the client of this service back off alike.
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	d := base << uint(attempt-1)
+	if d <= 0 || d > max {
+		d = max
+	}
+
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
 func readConf(filename string, config interface{}) error {
 	file, err := os.Open(filename)
 	if err != nil {
@@ -120,30 +135,3 @@ func readConf(filename string, config interface{}) error {
 
 	return json.NewDecoder(file).Decode(&config)
 }
-
-/* TODO put this somewhere later (don't remove them before that)
-err := initRedis()
-if err != nil {
-	panic(err)
-}
-
-func getToken(redisClient *redis.Client) (*amadeusToken, error) {
-	token, err := readConfRedis(redisClient)
-	if err != nil {
-		log.Fatalln("<read config from redis>", err)
-
-		token, err = getTokenFromAmadeus()
-		if err != nil {
-			return nil, err
-		}
-
-		err = writeToRedis(redisClient, token)
-		if err != nil {
-			log.Fatalln("<write to redis>", err)
-		}
-	}
-
-	return token, nil
-}
-
-*/
@@ -0,0 +1,64 @@
+/*
+	Tracing follows a call through the gRPC handler, the endpoint and service
+	layers and down to the Amadeus API with OpenTelemetry, and exports the
+	spans over OTLP.
+*/
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "amadeus-go"
+
+// Propagator carries the trace context across processes, in the W3C
+// traceparent and tracestate headers, along with any baggage.
+var Propagator propagation.TextMapPropagator = propagation.NewCompositeTextMapPropagator(
+	propagation.TraceContext{},
+	propagation.Baggage{},
+)
+
+// NewOTLPExporter sends spans to the OTLP collector listening for gRPC at
+// endpoint, in plain text when insecure is set.
+func NewOTLPExporter(ctx context.Context, endpoint string, insecure bool) (sdktrace.SpanExporter, error) {
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	return otlptracegrpc.New(ctx, opts...)
+}
+
+// NewTracerProvider batches spans to exporter. Traces started here are
+// sampled at ratio; the rest follow the decision of the caller that sent
+// them.
+func NewTracerProvider(exporter sdktrace.SpanExporter, ratio float64) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(instrumentationName))),
+	)
+}
+
+// Tracer returns the tracer the layers of the service start their spans with.
+func Tracer(provider trace.TracerProvider) trace.Tracer {
+	return provider.Tracer(instrumentationName)
+}
+
+// End marks span as failed when err is not nil, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
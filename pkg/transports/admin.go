@@ -0,0 +1,83 @@
+package transports
+
+import (
+	pbAdmin "amadeus-go/api/amadeus/admin"
+	"amadeus-go/pkg/endpoints"
+	sv "amadeus-go/pkg/services"
+
+	"context"
+	"errors"
+
+	"github.com/go-kit/kit/log"
+	grpcTransport "github.com/go-kit/kit/transport/grpc"
+)
+
+type grpcAdminServer struct {
+	GetUsageHandler grpcTransport.Handler
+}
+
+func (s *grpcAdminServer) GetUsage(ctx context.Context, req *pbAdmin.GetUsageRequest) (*pbAdmin.GetUsageResponse, error) {
+	_, resp, err := s.GetUsageHandler.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	response := resp.(*pbAdmin.GetUsageResponse)
+	return response, nil
+}
+
+func NewGRPCAdminServer(endpoints *endpoints.AdminEndpointSet, logger log.Logger) pbAdmin.AdminServiceServer {
+	options := []grpcTransport.ServerOption{
+		grpcTransport.ServerBefore(credentialsFromMetadata),
+	}
+
+	return &grpcAdminServer{
+		GetUsageHandler: grpcTransport.NewServer(
+			endpoints.GetUsageEndpoint,
+			decodeGetUsageRequest,
+			encodeGetUsageResponse,
+			options...,
+		),
+	}
+}
+
+// =============================================================================
+func decodeGetUsageRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req, ok := grpcReq.(*pbAdmin.GetUsageRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <GetUsageRequest>")
+	}
+	return &sv.GetUsageRequest{
+		Tenant: req.Tenant,
+		Period: req.Period,
+		Date:   req.Date,
+	}, nil
+}
+
+func encodeGetUsageResponse(_ context.Context, response interface{}) (interface{}, error) {
+	usage, ok := response.(*sv.Usage)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <Usage>")
+	}
+
+	var methods []*pbAdmin.MethodUsage
+	for _, m := range usage.Methods {
+		methods = append(methods, &pbAdmin.MethodUsage{
+			Method:         m.Method,
+			UpstreamCalls:  m.UpstreamCalls,
+			CacheHits:      m.CacheHits,
+			CoalescedCalls: m.CoalescedCalls,
+		})
+	}
+
+	return &pbAdmin.GetUsageResponse{
+		Tenant:         usage.Tenant,
+		Period:         usage.Period,
+		Date:           usage.Date,
+		UpstreamCalls:  usage.UpstreamCalls,
+		CacheHits:      usage.CacheHits,
+		Quota:          usage.Quota,
+		Methods:        methods,
+		CoalescedCalls: usage.CoalescedCalls,
+	}, nil
+}
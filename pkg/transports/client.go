@@ -0,0 +1,1419 @@
+package transports
+
+import (
+	pbFunc "amadeus-go/api/amadeus/func"
+	pbType "amadeus-go/api/amadeus/type"
+	"amadeus-go/pkg/endpoints"
+	"amadeus-go/pkg/logging"
+	srv "amadeus-go/pkg/services"
+
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	grpcTransport "github.com/go-kit/kit/transport/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	serviceName = "amadeus.func.AmadeusService"
+
+	defaultClientTimeout   = time.Second * 30
+	defaultClientRetries   = 2
+	defaultClientRetryBase = time.Millisecond * 100
+	defaultClientRetryMax  = time.Second * 5
+)
+
+type ClientSettings struct {
+	// Timeout is the deadline of a call whose context has none
+	Timeout time.Duration
+	// Retries is how many more times a call that failed with Unavailable or
+	// ResourceExhausted is made; negative disables retries. Cancellations,
+	// and bookings without an idempotency key, are never retried
+	Retries int
+	// RetryBase and RetryMax bound the backoff between two attempts
+	RetryBase time.Duration
+	RetryMax  time.Duration
+	// TLS is used by Dial to secure the connection; nil means plaintext
+	TLS *TLSSettings
+	// Credentials are the API key or JWT sent with every call
+	Credentials string
+}
+
+// Dial connects to the service at addr with the options every client of the
+// service shares. extra options are applied after them.
+func Dial(ctx context.Context, addr string, settings ClientSettings, extra ...grpc.DialOption) (*grpc.ClientConn, error) {
+	security := grpc.WithInsecure()
+	if settings.TLS != nil {
+		creds, err := ClientCredentials(*settings.TLS)
+		if err != nil {
+			return nil, err
+		}
+		security = grpc.WithTransportCredentials(creds)
+	}
+
+	opts := []grpc.DialOption{
+		security,
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                time.Second * 30,
+			Timeout:             time.Second * 10,
+			PermitWithoutStream: true,
+		}),
+	}
+
+	return grpc.DialContext(ctx, addr, append(opts, extra...)...)
+}
+
+// NewGRPCClient returns an AmadeusService whose methods call the service over
+// conn. Errors come back as the typed errors of the services package, so they
+// can be told apart just like on the server side.
+func NewGRPCClient(conn *grpc.ClientConn, logger log.Logger, settings ClientSettings) srv.AmadeusService {
+	if settings.Timeout == 0 {
+		settings.Timeout = defaultClientTimeout
+	}
+	if settings.Retries == 0 {
+		settings.Retries = defaultClientRetries
+	}
+	if settings.RetryBase == 0 {
+		settings.RetryBase = defaultClientRetryBase
+	}
+	if settings.RetryMax == 0 {
+		settings.RetryMax = defaultClientRetryMax
+	}
+
+	makeTypedEndpoint := func(method string, enc grpcTransport.EncodeRequestFunc, dec grpcTransport.DecodeResponseFunc, reply interface{}) endpoint.Endpoint {
+		var e endpoint.Endpoint
+		e = grpcTransport.NewClient(
+			conn,
+			serviceName,
+			method,
+			enc,
+			dec,
+			reply,
+			grpcTransport.ClientBefore(credentialsToMetadata(settings.Credentials), requestIDToMetadata),
+		).Endpoint()
+		e = clientErrorMiddleware(method)(e)
+		e = clientRetryMiddleware(method, settings)(e)
+		e = clientDeadlineMiddleware(settings.Timeout)(e)
+		e = clientLoggingMiddleware(logger, method)(e)
+		return e
+	}
+	makeEndpoint := func(method string, enc grpcTransport.EncodeRequestFunc) endpoint.Endpoint {
+		return makeTypedEndpoint(method, enc, decodeResponse, pbType.Response{})
+	}
+
+	return endpoints.AmadeusEndpointSet{
+		FlightLowFareSearchEndpoint:             makeEndpoint("FlightLowFareSearch", encodeFlightLowFareSearchRequest),
+		FlightInspirationSearchEndpoint:         makeEndpoint("FlightInspirationSearch", encodeFlightInspirationSearchRequest),
+		FlightCheapestDateSearchEndpoint:        makeEndpoint("FlightCheapestDateSearch", encodeFlightCheapestDateSearchRequest),
+		FlightMostSearchedDestinationsEndpoint:  makeEndpoint("FlightMostSearchedDestinations", encodeFlightMostSearchedDestinationsRequest),
+		FlightMostSearchedByDestinationEndpoint: makeEndpoint("FlightMostSearchedByDestination", encodeFlightMostSearchedByDestinationRequest),
+		FlightCheckInLinksEndpoint:              makeEndpoint("FlightCheckInLinks", encodeFlightCheckInLinksRequest),
+		FlightMostTraveledDestinationsEndpoint:  makeEndpoint("FlightMostTraveledDestinations", encodeFlightMostTraveledDestinationsRequest),
+		FlightMostBookedDestinationsEndpoint:    makeEndpoint("FlightMostBookedDestinations", encodeFlightMostBookedDestinationsRequest),
+		FlightBusiestTravelingPeriodEndpoint:    makeEndpoint("FlightBusiestTravelingPeriod", encodeFlightBusiestTravelingPeriodRequest),
+		AirportNearestRelevantEndpoint:          makeEndpoint("AirportNearestRelevant", encodeAirportNearestRelevantRequest),
+		AirportAndCitySearchEndpoint:            makeEndpoint("AirportAndCitySearch", encodeAirportAndCitySearchRequest),
+		AirlineCodeLookupEndpoint:               makeEndpoint("AirlineCodeLookup", encodeAirlineCodeLookupRequest),
+		FlightOffersSearchEndpoint:              makeTypedEndpoint("FlightOffersSearch", encodeFlightOffersSearchRequest, decodeFlightOffersResponse, pbType.FlightOffersResponse{}),
+		FlightOffersPriceEndpoint:               makeTypedEndpoint("FlightOffersPrice", encodeFlightOffersPriceRequest, decodeFlightOffersPriceResponse, pbType.FlightOffersPriceResponse{}),
+		CreateFlightOrderEndpoint:               makeTypedEndpoint("CreateFlightOrder", encodeCreateFlightOrderRequest, decodeFlightOrderResponse, pbType.FlightOrderResponse{}),
+		GetFlightOrderEndpoint:                  makeTypedEndpoint("GetFlightOrder", encodeGetFlightOrderRequest, decodeFlightOrderResponse, pbType.FlightOrderResponse{}),
+		CancelFlightOrderEndpoint:               makeTypedEndpoint("CancelFlightOrder", encodeCancelFlightOrderRequest, decodeCancelFlightOrderResponse, pbType.CancelFlightOrderResponse{}),
+		SeatMapDisplayEndpoint:                  makeTypedEndpoint("SeatMapDisplay", encodeSeatMapDisplayRequest, decodeSeatMapResponse, pbType.SeatMapResponse{}),
+		BrandedFaresUpsellEndpoint:              makeTypedEndpoint("BrandedFaresUpsell", encodeBrandedFaresUpsellRequest, decodeFlightOffersResponse, pbType.FlightOffersResponse{}),
+		FlightAvailabilitiesSearchEndpoint:      makeTypedEndpoint("FlightAvailabilitiesSearch", encodeFlightAvailabilitiesSearchRequest, decodeFlightAvailabilitiesResponse, pbType.FlightAvailabilitiesResponse{}),
+	}
+}
+
+func credentialsToMetadata(credentials string) grpcTransport.ClientRequestFunc {
+	return func(ctx context.Context, md *metadata.MD) context.Context {
+		if credentials != "" {
+			md.Set("authorization", "Bearer "+credentials)
+		}
+		return ctx
+	}
+}
+
+// requestIDToMetadata passes the ID of the request the call is made for on to
+// the server, when there is one.
+func requestIDToMetadata(ctx context.Context, md *metadata.MD) context.Context {
+	if id := logging.RequestID(ctx); id != "" {
+		md.Set("x-request-id", id)
+	}
+	return ctx
+}
+
+// =============================================================================
+func encodeFlightLowFareSearchRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightLowFareSearchRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightLowFareSearchRequest>")
+	}
+	return &pbFunc.FlightLowFareSearchRequest{
+		Origin:        req.Origin,
+		DepartureDate: req.DepartureDate,
+		Destination:   req.Destination,
+		ReturnDate:    req.ReturnDate,
+	}, nil
+}
+
+func encodeFlightInspirationSearchRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightInspirationSearchRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightInspirationSearchRequest>")
+	}
+	return &pbFunc.FlightInspirationSearchRequest{
+		Origin:   req.Origin,
+		MaxPrice: req.MaxPrice,
+	}, nil
+}
+
+func encodeFlightCheapestDateSearchRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightCheapestDateSearchRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightCheapestDateSearchRequest>")
+	}
+	return &pbFunc.FlightCheapestDateSearchRequest{
+		Destination: req.Destination,
+		Origin:      req.Origin,
+	}, nil
+}
+
+func encodeFlightMostSearchedDestinationsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightMostSearchedDestinationsRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightMostSearchedDestinationsRequest>")
+	}
+	return &pbFunc.FlightMostSearchedDestinationsRequest{
+		MarketCountryCode: req.MarketCountryCode,
+		SearchPeriod:      req.SearchPeriod,
+		OriginCityCode:    req.OriginCityCode,
+	}, nil
+}
+
+func encodeFlightMostSearchedByDestinationRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightMostSearchedByDestinationRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightMostSearchedByDestinationRequest>")
+	}
+	return &pbFunc.FlightMostSearchedByDestinationRequest{
+		MarketCountryCode:   req.MarketCountryCode,
+		SearchPeriod:        req.SearchPeriod,
+		OriginCityCode:      req.OriginCityCode,
+		DestinationCityCode: req.DestinationCityCode,
+	}, nil
+}
+
+func encodeFlightCheckInLinksRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightCheckInLinksRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightCheckInLinksRequest>")
+	}
+	return &pbFunc.FlightCheckInLinksRequest{
+		AirlineCode: req.AirlineCode,
+	}, nil
+}
+
+func encodeFlightMostTraveledDestinationsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightMostTraveledDestinationsRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightMostTraveledDestinationsRequest>")
+	}
+	return &pbFunc.FlightMostTraveledDestinationsRequest{
+		OriginCityCode: req.OriginCityCode,
+		Period:         req.Period,
+	}, nil
+}
+
+func encodeFlightMostBookedDestinationsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightMostBookedDestinationsRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightMostBookedDestinationsRequest>")
+	}
+	return &pbFunc.FlightMostBookedDestinationsRequest{
+		OriginCityCode: req.OriginCityCode,
+		Period:         req.Period,
+	}, nil
+}
+
+func encodeFlightBusiestTravelingPeriodRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightBusiestTravelingPeriodRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightBusiestTravelingPeriodRequest>")
+	}
+	return &pbFunc.FlightBusiestTravelingPeriodRequest{
+		CityCode:  req.CityCode,
+		Period:    req.Period,
+		Direction: req.Direction,
+	}, nil
+}
+
+func encodeAirportNearestRelevantRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.AirportNearestRelevantRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <AirportNearestRelevantRequest>")
+	}
+	return &pbFunc.AirportNearestRelevantRequest{
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Sort:      req.Sort,
+	}, nil
+}
+
+func encodeAirportAndCitySearchRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.AirportAndCitySearchRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <AirportAndCitySearchRequest>")
+	}
+	return &pbFunc.AirportAndCitySearchRequest{
+		Keyword:     req.Keyword,
+		SubType:     req.SubType,
+		CountryCode: req.CountryCode,
+	}, nil
+}
+
+func encodeAirlineCodeLookupRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.AirlineCodeLookupRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <AirlineCodeLookupRequest>")
+	}
+	return &pbFunc.AirlineCodeLookupRequest{
+		AirlineCodes: req.AirlineCodes,
+	}, nil
+}
+
+func encodeFlightOffersSearchRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightOffersSearchRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightOffersSearchRequest>")
+	}
+
+	return &pbFunc.FlightOffersSearchRequest{
+		OriginLocationCode:      req.OriginLocationCode,
+		DestinationLocationCode: req.DestinationLocationCode,
+		DepartureDate:           req.DepartureDate,
+		ReturnDate:              req.ReturnDate,
+		Adults:                  req.Adults,
+		Children:                req.Children,
+		Infants:                 req.Infants,
+		TravelClass:             req.TravelClass,
+		IncludedAirlineCodes:    req.IncludedAirlineCodes,
+		ExcludedAirlineCodes:    req.ExcludedAirlineCodes,
+		NonStop:                 req.NonStop,
+		CurrencyCode:            req.CurrencyCode,
+		MaxPrice:                req.MaxPrice,
+		Max:                     req.Max,
+		OriginDestinations:      encodeOriginDestinations(req.OriginDestinations),
+	}, nil
+}
+
+func encodeOriginDestinations(ods []*srv.OriginDestination) []*pbFunc.OriginDestination {
+	var out []*pbFunc.OriginDestination
+	for _, od := range ods {
+		if od == nil {
+			continue
+		}
+		out = append(out, &pbFunc.OriginDestination{
+			Id:                      od.Id,
+			OriginLocationCode:      od.OriginLocationCode,
+			DestinationLocationCode: od.DestinationLocationCode,
+			DepartureDate:           od.DepartureDate,
+			DepartureTime:           od.DepartureTime,
+		})
+	}
+	return out
+}
+
+func encodeFlightOffersPriceRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightOffersPriceRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightOffersPriceRequest>")
+	}
+
+	var offers []*pbType.FlightOffer
+	for _, offer := range req.FlightOffers {
+		if offer != nil {
+			offers = append(offers, encodeFlightOffer(offer))
+		}
+	}
+
+	return &pbFunc.FlightOffersPriceRequest{
+		FlightOffers: offers,
+		Include:      req.Include,
+	}, nil
+}
+
+func encodeCreateFlightOrderRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.CreateFlightOrderRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <CreateFlightOrderRequest>")
+	}
+
+	var offers []*pbType.FlightOffer
+	for _, offer := range req.FlightOffers {
+		if offer != nil {
+			offers = append(offers, encodeFlightOffer(offer))
+		}
+	}
+
+	return &pbFunc.CreateFlightOrderRequest{
+		FlightOffers:   offers,
+		Travelers:      encodeTravelers(req.Travelers),
+		Contacts:       encodeContacts(req.Contacts),
+		IdempotencyKey: req.IdempotencyKey,
+	}, nil
+}
+
+func encodeGetFlightOrderRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.GetFlightOrderRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <GetFlightOrderRequest>")
+	}
+	return &pbFunc.GetFlightOrderRequest{
+		FlightOrderId: req.FlightOrderId,
+	}, nil
+}
+
+func encodeCancelFlightOrderRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.CancelFlightOrderRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <CancelFlightOrderRequest>")
+	}
+	return &pbFunc.CancelFlightOrderRequest{
+		FlightOrderId: req.FlightOrderId,
+	}, nil
+}
+
+func encodeSeatMapDisplayRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.SeatMapDisplayRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <SeatMapDisplayRequest>")
+	}
+
+	var offers []*pbType.FlightOffer
+	for _, offer := range req.FlightOffers {
+		if offer != nil {
+			offers = append(offers, encodeFlightOffer(offer))
+		}
+	}
+
+	return &pbFunc.SeatMapDisplayRequest{
+		FlightOffers:  offers,
+		FlightOrderId: req.FlightOrderId,
+	}, nil
+}
+
+func encodeBrandedFaresUpsellRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.BrandedFaresUpsellRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <BrandedFaresUpsellRequest>")
+	}
+
+	var offers []*pbType.FlightOffer
+	for _, offer := range req.FlightOffers {
+		if offer != nil {
+			offers = append(offers, encodeFlightOffer(offer))
+		}
+	}
+
+	return &pbFunc.BrandedFaresUpsellRequest{
+		FlightOffers: offers,
+	}, nil
+}
+
+func encodeFlightAvailabilitiesSearchRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req, ok := request.(*srv.FlightAvailabilitiesSearchRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightAvailabilitiesSearchRequest>")
+	}
+	return &pbFunc.FlightAvailabilitiesSearchRequest{
+		OriginDestinations:   encodeOriginDestinations(req.OriginDestinations),
+		Adults:               req.Adults,
+		Children:             req.Children,
+		IncludedAirlineCodes: req.IncludedAirlineCodes,
+		ExcludedAirlineCodes: req.ExcludedAirlineCodes,
+	}, nil
+}
+
+// decodeResponse is the reverse of encodeResponse. Any message may be left
+// out of the protobuf, so each one is checked before it's read.
+func decodeResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*pbType.Response)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <Response>")
+	}
+
+	var datas []*srv.Data
+	for _, data := range resp.Data {
+		if data == nil {
+			continue
+		}
+
+		var offerItems []*srv.OfferItem
+		for _, offers := range data.OfferItems {
+			if offers == nil {
+				continue
+			}
+
+			var services []*srv.Service
+			for _, service := range offers.Services {
+				if service == nil {
+					continue
+				}
+
+				var segments []*srv.Segment
+				for _, segment := range service.Segments {
+					if segment == nil {
+						continue
+					}
+					segments = append(segments, &srv.Segment{
+						FlightSegment:         decodeFlightSegment(segment.FlightSegment),
+						PricingDetailPerAdult: decodePricingDetail(segment.PricingDetailPerAdult),
+					})
+				}
+
+				services = append(services, &srv.Service{
+					Segments: segments,
+				})
+			}
+
+			offerItems = append(offerItems, &srv.OfferItem{
+				Services:      services,
+				Price:         decodePrice(offers.Price),
+				PricePerAdult: decodePrice(offers.PricePerAdult),
+			})
+		}
+
+		var geoCode *srv.GeoCode
+		if data.GeoCode != nil {
+			geoCode = &srv.GeoCode{
+				Latitude:  data.GeoCode.Latitude,
+				Longitude: data.GeoCode.Longitude,
+			}
+		}
+
+		var distance *srv.Distance
+		if data.Distance != nil {
+			distance = &srv.Distance{
+				Unit:  data.Distance.Unit,
+				Value: data.Distance.Value,
+			}
+		}
+
+		var self *srv.Self
+		if data.Self != nil {
+			self = &srv.Self{
+				Href:    data.Self.Href,
+				Methods: append([]string(nil), data.Self.Methods...),
+			}
+		}
+
+		var address *srv.Address
+		if data.Address != nil {
+			address = &srv.Address{
+				CityName:    data.Address.CityName,
+				CityCode:    data.Address.CityCode,
+				CountryName: data.Address.CountryName,
+				CountryCode: data.Address.CountryCode,
+				StateCode:   data.Address.StateCode,
+				RegionCode:  data.Address.RegionCode,
+			}
+		}
+
+		var analytics *srv.Analytics
+		if data.Analytics != nil {
+			analytics = &srv.Analytics{
+				Flights:   decodeScore(data.Analytics.Flights),
+				Travelers: decodeScore(data.Analytics.Travelers),
+				Searches:  decodeScore(data.Analytics.Searches),
+			}
+		}
+
+		var params map[string]*srv.ParamDetail
+		if data.Parameters != nil {
+			params = make(map[string]*srv.ParamDetail)
+			for k, v := range data.Parameters {
+				if v == nil {
+					continue
+				}
+				params[k] = &srv.ParamDetail{
+					Type:        v.Type,
+					Description: v.Description,
+					Format:      v.Format,
+				}
+			}
+		}
+
+		datas = append(datas, &srv.Data{
+			Id:             data.Id,
+			Type:           data.Type,
+			OfferItems:     offerItems,
+			Destination:    data.Destination,
+			SubType:        data.SubType,
+			Analytics:      analytics,
+			Period:         data.Period,
+			Name:           data.Name,
+			DetailedName:   data.DetailedName,
+			TimeZoneOffset: data.TimeZoneOffset,
+			IataCode:       data.IataCode,
+			GeoCode:        geoCode,
+			Address:        address,
+			Distance:       distance,
+			Relevance:      data.Relevance,
+			Origin:         data.Origin,
+			DepartureDate:  data.DepartureDate,
+			ReturnDate:     data.ReturnDate,
+			Price:          decodePrice(data.Price),
+			Links:          decodeLinks(data.Links),
+			Self:           self,
+			Href:           data.Href,
+			Channel:        data.Channel,
+			Parameters:     params,
+			IcaoCode:       data.IcaoCode,
+			BusinessName:   data.BusinessName,
+			CommonName:     data.CommonName,
+		})
+	}
+
+	var dictionaries *srv.Dictionaries
+	if resp.Dictionaries != nil {
+		dictionaries = &srv.Dictionaries{
+			Aircrafts:  make(map[string]string),
+			Locations:  make(map[string]map[string]string),
+			Carriers:   make(map[string]string),
+			Currencies: make(map[string]string),
+		}
+		for k, v := range resp.Dictionaries.Aircrafts {
+			dictionaries.Aircrafts[k] = v
+		}
+		for k, v := range resp.Dictionaries.Locations {
+			detail := make(map[string]string)
+			if v != nil {
+				for subK, subV := range v.Detail {
+					detail[subK] = subV
+				}
+			}
+			dictionaries.Locations[k] = detail
+		}
+		for k, v := range resp.Dictionaries.Carriers {
+			dictionaries.Carriers[k] = v
+		}
+		for k, v := range resp.Dictionaries.Currencies {
+			dictionaries.Currencies[k] = v
+		}
+	}
+
+	return &srv.Response{
+		Data:         datas,
+		Dictionaries: dictionaries,
+		Meta:         decodeMeta(resp.Meta),
+		Warnings:     decodeErrorWarnings(resp.Warnings),
+		Errors:       decodeErrorWarnings(resp.Errors),
+	}, nil
+}
+
+// decodeFlightOffersResponse is the reverse of encodeFlightOffersResponse.
+func decodeFlightOffersResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*pbType.FlightOffersResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <FlightOffersResponse>")
+	}
+
+	var offers []*srv.FlightOffer
+	for _, offer := range resp.Data {
+		if offer != nil {
+			offers = append(offers, decodeFlightOffer(offer))
+		}
+	}
+
+	return &srv.FlightOffersResponse{
+		Data:         offers,
+		Dictionaries: decodeOfferDictionaries(resp.Dictionaries),
+		Meta:         decodeMeta(resp.Meta),
+		Warnings:     decodeErrorWarnings(resp.Warnings),
+		Errors:       decodeErrorWarnings(resp.Errors),
+	}, nil
+}
+
+func decodeOfferDictionaries(d *pbType.OfferDictionaries) *srv.OfferDictionaries {
+	if d == nil {
+		return nil
+	}
+
+	dictionaries := &srv.OfferDictionaries{
+		Locations:  make(map[string]*srv.LocationValue),
+		Aircraft:   make(map[string]string),
+		Currencies: make(map[string]string),
+		Carriers:   make(map[string]string),
+	}
+	for k, v := range d.Locations {
+		if v != nil {
+			dictionaries.Locations[k] = &srv.LocationValue{CityCode: v.CityCode, CountryCode: v.CountryCode}
+		}
+	}
+	for k, v := range d.Aircraft {
+		dictionaries.Aircraft[k] = v
+	}
+	for k, v := range d.Currencies {
+		dictionaries.Currencies[k] = v
+	}
+	for k, v := range d.Carriers {
+		dictionaries.Carriers[k] = v
+	}
+	return dictionaries
+}
+
+// decodeFlightOffersPriceResponse is the reverse of
+// encodeFlightOffersPriceResponse.
+func decodeFlightOffersPriceResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*pbType.FlightOffersPriceResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <FlightOffersPriceResponse>")
+	}
+
+	var pricing *srv.FlightOffersPricing
+	if d := resp.Data; d != nil {
+		pricing = &srv.FlightOffersPricing{Type: d.Type}
+		for _, offer := range d.FlightOffers {
+			if offer != nil {
+				pricing.FlightOffers = append(pricing.FlightOffers, decodeFlightOffer(offer))
+			}
+		}
+		if r := d.BookingRequirements; r != nil {
+			pricing.BookingRequirements = &srv.BookingRequirements{
+				EmailAddressRequired:      r.EmailAddressRequired,
+				MobilePhoneNumberRequired: r.MobilePhoneNumberRequired,
+				InvoiceAddressRequired:    r.InvoiceAddressRequired,
+				MailingAddressRequired:    r.MailingAddressRequired,
+			}
+			for _, t := range r.TravelerRequirements {
+				if t == nil {
+					continue
+				}
+				pricing.BookingRequirements.TravelerRequirements = append(pricing.BookingRequirements.TravelerRequirements, &srv.TravelerRequirements{
+					TravelerId:                   t.TravelerId,
+					GenderRequired:               t.GenderRequired,
+					DocumentRequired:             t.DocumentRequired,
+					DocumentIssuanceCityRequired: t.DocumentIssuanceCityRequired,
+					DateOfBirthRequired:          t.DateOfBirthRequired,
+					RedressRequiredIfAny:         t.RedressRequiredIfAny,
+					ResidenceRequired:            t.ResidenceRequired,
+				})
+			}
+		}
+	}
+
+	var included *srv.PricingIncluded
+	if i := resp.Included; i != nil {
+		included = &srv.PricingIncluded{
+			CreditCardFees:    make(map[string]*srv.CreditCardFee),
+			Bags:              make(map[string]*srv.BagOffer),
+			DetailedFareRules: make(map[string]*srv.DetailedFareRules),
+		}
+		for k, v := range i.CreditCardFees {
+			if v != nil {
+				included.CreditCardFees[k] = &srv.CreditCardFee{
+					Brand:         v.Brand,
+					Amount:        v.Amount,
+					Currency:      v.Currency,
+					FlightOfferId: v.FlightOfferId,
+				}
+			}
+		}
+		for k, v := range i.Bags {
+			if v == nil {
+				continue
+			}
+			bag := &srv.BagOffer{
+				Quantity:            v.Quantity,
+				Weight:              v.Weight,
+				WeightUnit:          v.WeightUnit,
+				Name:                v.Name,
+				BookableByItinerary: v.BookableByItinerary,
+				SegmentIds:          v.SegmentIds,
+				TravelerIds:         v.TravelerIds,
+			}
+			if v.Price != nil {
+				bag.Price = &srv.BagPrice{Amount: v.Price.Amount, CurrencyCode: v.Price.CurrencyCode}
+			}
+			included.Bags[k] = bag
+		}
+		for k, v := range i.DetailedFareRules {
+			if v == nil {
+				continue
+			}
+			rules := &srv.DetailedFareRules{
+				FareBasis: v.FareBasis,
+				Name:      v.Name,
+				SegmentId: v.SegmentId,
+			}
+			if v.FareNotes != nil {
+				rules.FareNotes = &srv.FareNotes{}
+				for _, d := range v.FareNotes.Descriptions {
+					if d != nil {
+						rules.FareNotes.Descriptions = append(rules.FareNotes.Descriptions, &srv.FareDescription{
+							DescriptionType: d.DescriptionType,
+							Text:            d.Text,
+						})
+					}
+				}
+			}
+			included.DetailedFareRules[k] = rules
+		}
+	}
+
+	return &srv.FlightOffersPriceResponse{
+		Data:         pricing,
+		Included:     included,
+		Dictionaries: decodeOfferDictionaries(resp.Dictionaries),
+		Warnings:     decodeErrorWarnings(resp.Warnings),
+		Errors:       decodeErrorWarnings(resp.Errors),
+	}, nil
+}
+
+// decodeFlightOrderResponse is the reverse of encodeFlightOrderResponse.
+func decodeFlightOrderResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*pbType.FlightOrderResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <FlightOrderResponse>")
+	}
+
+	var order *srv.FlightOrder
+	if resp.Data != nil {
+		order = decodeFlightOrder(resp.Data)
+	}
+
+	return &srv.FlightOrderResponse{
+		Data:         order,
+		Dictionaries: decodeOfferDictionaries(resp.Dictionaries),
+		Warnings:     decodeErrorWarnings(resp.Warnings),
+		Errors:       decodeErrorWarnings(resp.Errors),
+	}, nil
+}
+
+func decodeCancelFlightOrderResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*pbType.CancelFlightOrderResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <CancelFlightOrderResponse>")
+	}
+	return &srv.CancelFlightOrderResponse{FlightOrderId: resp.FlightOrderId}, nil
+}
+
+func decodeFlightOrder(order *pbType.FlightOrder) *srv.FlightOrder {
+	out := &srv.FlightOrder{
+		Type:            order.Type,
+		Id:              order.Id,
+		QueuingOfficeId: order.QueuingOfficeId,
+		Travelers:       decodeTravelers(order.Travelers),
+		Contacts:        decodeContacts(order.Contacts),
+	}
+	for _, r := range order.AssociatedRecords {
+		if r != nil {
+			out.AssociatedRecords = append(out.AssociatedRecords, &srv.AssociatedRecord{
+				Reference:        r.Reference,
+				CreationDate:     r.CreationDate,
+				OriginSystemCode: r.OriginSystemCode,
+				FlightOfferId:    r.FlightOfferId,
+			})
+		}
+	}
+	for _, offer := range order.FlightOffers {
+		if offer != nil {
+			out.FlightOffers = append(out.FlightOffers, decodeFlightOffer(offer))
+		}
+	}
+	return out
+}
+
+func decodeTravelers(travelers []*pbType.Traveler) []*srv.Traveler {
+	var out []*srv.Traveler
+	for _, t := range travelers {
+		if t == nil {
+			continue
+		}
+
+		traveler := &srv.Traveler{
+			Id:          t.Id,
+			DateOfBirth: t.DateOfBirth,
+			Gender:      t.Gender,
+			Name:        decodeTravelerName(t.Name),
+			Contact:     decodeContact(t.Contact),
+		}
+		for _, d := range t.Documents {
+			if d != nil {
+				traveler.Documents = append(traveler.Documents, &srv.TravelerDocument{
+					DocumentType:     d.DocumentType,
+					Number:           d.Number,
+					ExpiryDate:       d.ExpiryDate,
+					IssuanceCountry:  d.IssuanceCountry,
+					IssuanceDate:     d.IssuanceDate,
+					IssuanceLocation: d.IssuanceLocation,
+					Nationality:      d.Nationality,
+					BirthPlace:       d.BirthPlace,
+					ValidityCountry:  d.ValidityCountry,
+					Holder:           d.Holder,
+				})
+			}
+		}
+		out = append(out, traveler)
+	}
+	return out
+}
+
+func decodeTravelerName(n *pbType.TravelerName) *srv.TravelerName {
+	if n == nil {
+		return nil
+	}
+	return &srv.TravelerName{FirstName: n.FirstName, LastName: n.LastName}
+}
+
+func decodeContacts(contacts []*pbType.Contact) []*srv.Contact {
+	var out []*srv.Contact
+	for _, c := range contacts {
+		if c != nil {
+			out = append(out, decodeContact(c))
+		}
+	}
+	return out
+}
+
+func decodeContact(c *pbType.Contact) *srv.Contact {
+	if c == nil {
+		return nil
+	}
+
+	out := &srv.Contact{
+		AddresseeName: decodeTravelerName(c.AddresseeName),
+		CompanyName:   c.CompanyName,
+		Purpose:       c.Purpose,
+		EmailAddress:  c.EmailAddress,
+	}
+	for _, p := range c.Phones {
+		if p != nil {
+			out.Phones = append(out.Phones, &srv.Phone{
+				DeviceType:         p.DeviceType,
+				CountryCallingCode: p.CountryCallingCode,
+				Number:             p.Number,
+			})
+		}
+	}
+	if a := c.Address; a != nil {
+		out.Address = &srv.PostalAddress{
+			Lines:       a.Lines,
+			PostalCode:  a.PostalCode,
+			CityName:    a.CityName,
+			CountryCode: a.CountryCode,
+		}
+	}
+	return out
+}
+
+// decodeSeatMapResponse is the reverse of encodeSeatMapResponse.
+func decodeSeatMapResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*pbType.SeatMapResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <SeatMapResponse>")
+	}
+
+	var seatMaps []*srv.SeatMap
+	for _, m := range resp.Data {
+		if m != nil {
+			seatMaps = append(seatMaps, decodeSeatMap(m))
+		}
+	}
+
+	var dictionaries *srv.SeatMapDictionaries
+	if d := resp.Dictionaries; d != nil {
+		dictionaries = &srv.SeatMapDictionaries{
+			Locations:          make(map[string]*srv.LocationValue),
+			Facility:           make(map[string]string),
+			SeatCharacteristic: make(map[string]string),
+		}
+		for k, v := range d.Locations {
+			if v != nil {
+				dictionaries.Locations[k] = &srv.LocationValue{CityCode: v.CityCode, CountryCode: v.CountryCode}
+			}
+		}
+		for k, v := range d.Facility {
+			dictionaries.Facility[k] = v
+		}
+		for k, v := range d.SeatCharacteristic {
+			dictionaries.SeatCharacteristic[k] = v
+		}
+	}
+
+	return &srv.SeatMapResponse{
+		Data:         seatMaps,
+		Dictionaries: dictionaries,
+		Meta:         decodeMeta(resp.Meta),
+		Warnings:     decodeErrorWarnings(resp.Warnings),
+		Errors:       decodeErrorWarnings(resp.Errors),
+	}, nil
+}
+
+func decodeSeatMap(m *pbType.SeatMap) *srv.SeatMap {
+	out := &srv.SeatMap{
+		Type:          m.Type,
+		Id:            m.Id,
+		Departure:     decodeFlightEndPoint(m.Departure),
+		Arrival:       decodeFlightEndPoint(m.Arrival),
+		CarrierCode:   m.CarrierCode,
+		Number:        m.Number,
+		Class:         m.Class,
+		FlightOfferId: m.FlightOfferId,
+		SegmentId:     m.SegmentId,
+	}
+	if m.Aircraft != nil {
+		out.Aircraft = &srv.Aircraft{Code: m.Aircraft.Code}
+	}
+	if m.Operating != nil {
+		out.Operating = &srv.Operating{
+			CarrierCode: m.Operating.CarrierCode,
+			Number:      m.Operating.Number,
+		}
+	}
+	for _, c := range m.AvailableSeatsCounters {
+		if c != nil {
+			out.AvailableSeatsCounters = append(out.AvailableSeatsCounters, &srv.AvailableSeatsCounter{
+				TravelerId: c.TravelerId,
+				Value:      c.Value,
+			})
+		}
+	}
+
+	for _, d := range m.Decks {
+		if d == nil {
+			continue
+		}
+
+		deck := &srv.Deck{DeckType: d.DeckType}
+		if c := d.DeckConfiguration; c != nil {
+			deck.DeckConfiguration = &srv.DeckConfiguration{
+				Width:         c.Width,
+				Length:        c.Length,
+				StartSeatRow:  c.StartSeatRow,
+				EndSeatRow:    c.EndSeatRow,
+				StartWingsX:   c.StartWingsX,
+				EndWingsX:     c.EndWingsX,
+				StartWingsRow: c.StartWingsRow,
+				EndWingsRow:   c.EndWingsRow,
+				ExitRowsX:     c.ExitRowsX,
+			}
+		}
+		for _, f := range d.Facilities {
+			if f != nil {
+				deck.Facilities = append(deck.Facilities, &srv.Facility{
+					Code:        f.Code,
+					Column:      f.Column,
+					Row:         f.Row,
+					Position:    f.Position,
+					Coordinates: decodeCoordinates(f.Coordinates),
+				})
+			}
+		}
+		for _, s := range d.Seats {
+			if s == nil {
+				continue
+			}
+
+			seat := &srv.Seat{
+				Cabin:                s.Cabin,
+				Number:               s.Number,
+				CharacteristicsCodes: s.CharacteristicsCodes,
+				Coordinates:          decodeCoordinates(s.Coordinates),
+			}
+			for _, tp := range s.TravelerPricing {
+				if tp != nil {
+					seat.TravelerPricing = append(seat.TravelerPricing, &srv.SeatTravelerPricing{
+						TravelerId:             tp.TravelerId,
+						SeatAvailabilityStatus: tp.SeatAvailabilityStatus,
+						Price:                  decodeOfferPrice(tp.Price),
+					})
+				}
+			}
+			deck.Seats = append(deck.Seats, seat)
+		}
+		out.Decks = append(out.Decks, deck)
+	}
+	return out
+}
+
+func decodeCoordinates(c *pbType.Coordinates) *srv.Coordinates {
+	if c == nil {
+		return nil
+	}
+	return &srv.Coordinates{X: c.X, Y: c.Y}
+}
+
+// decodeFlightAvailabilitiesResponse is the reverse of
+// encodeFlightAvailabilitiesResponse.
+func decodeFlightAvailabilitiesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*pbType.FlightAvailabilitiesResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <FlightAvailabilitiesResponse>")
+	}
+
+	var availabilities []*srv.FlightAvailability
+	for _, a := range resp.Data {
+		if a != nil {
+			availabilities = append(availabilities, decodeFlightAvailability(a))
+		}
+	}
+
+	return &srv.FlightAvailabilitiesResponse{
+		Data:         availabilities,
+		Dictionaries: decodeOfferDictionaries(resp.Dictionaries),
+		Meta:         decodeMeta(resp.Meta),
+		Warnings:     decodeErrorWarnings(resp.Warnings),
+		Errors:       decodeErrorWarnings(resp.Errors),
+	}, nil
+}
+
+func decodeFlightAvailability(a *pbType.FlightAvailability) *srv.FlightAvailability {
+	out := &srv.FlightAvailability{
+		Type:                     a.Type,
+		Id:                       a.Id,
+		OriginDestinationId:      a.OriginDestinationId,
+		Source:                   a.Source,
+		InstantTicketingRequired: a.InstantTicketingRequired,
+		PaymentCardRequired:      a.PaymentCardRequired,
+		Duration:                 a.Duration,
+	}
+	for _, segment := range a.Segments {
+		if segment == nil {
+			continue
+		}
+
+		s := &srv.AvailabilitySegment{
+			Id:              segment.Id,
+			NumberOfStops:   segment.NumberOfStops,
+			BlacklistedInEU: segment.BlacklistedInEU,
+			Departure:       decodeFlightEndPoint(segment.Departure),
+			Arrival:         decodeFlightEndPoint(segment.Arrival),
+			CarrierCode:     segment.CarrierCode,
+			Number:          segment.Number,
+		}
+		if segment.Aircraft != nil {
+			s.Aircraft = &srv.Aircraft{Code: segment.Aircraft.Code}
+		}
+		if segment.Operating != nil {
+			s.Operating = &srv.Operating{
+				CarrierCode: segment.Operating.CarrierCode,
+				Number:      segment.Operating.Number,
+			}
+		}
+		for _, c := range segment.AvailabilityClasses {
+			if c != nil {
+				s.AvailabilityClasses = append(s.AvailabilityClasses, &srv.AvailabilityClass{
+					NumberOfBookableSeats: c.NumberOfBookableSeats,
+					Class:                 c.Class,
+					ClosedStatus:          c.ClosedStatus,
+				})
+			}
+		}
+		out.Segments = append(out.Segments, s)
+	}
+	return out
+}
+
+func decodeFlightOffer(offer *pbType.FlightOffer) *srv.FlightOffer {
+	out := &srv.FlightOffer{
+		Type:                     offer.Type,
+		Id:                       offer.Id,
+		Source:                   offer.Source,
+		InstantTicketingRequired: offer.InstantTicketingRequired,
+		NonHomogeneous:           offer.NonHomogeneous,
+		OneWay:                   offer.OneWay,
+		LastTicketingDate:        offer.LastTicketingDate,
+		NumberOfBookableSeats:    offer.NumberOfBookableSeats,
+		Price:                    decodeOfferPrice(offer.Price),
+		ValidatingAirlineCodes:   offer.ValidatingAirlineCodes,
+	}
+	if o := offer.PricingOptions; o != nil {
+		out.PricingOptions = &srv.PricingOptions{
+			FareType:                o.FareType,
+			IncludedCheckedBagsOnly: o.IncludedCheckedBagsOnly,
+		}
+	}
+
+	for _, itinerary := range offer.Itineraries {
+		if itinerary == nil {
+			continue
+		}
+
+		var segments []*srv.OfferSegment
+		for _, segment := range itinerary.Segments {
+			if segment != nil {
+				segments = append(segments, decodeOfferSegment(segment))
+			}
+		}
+		out.Itineraries = append(out.Itineraries, &srv.Itinerary{
+			Duration: itinerary.Duration,
+			Segments: segments,
+		})
+	}
+
+	for _, tp := range offer.TravelerPricings {
+		if tp == nil {
+			continue
+		}
+
+		var details []*srv.FareDetailsBySegment
+		for _, d := range tp.FareDetailsBySegment {
+			if d == nil {
+				continue
+			}
+
+			detail := &srv.FareDetailsBySegment{
+				SegmentId:        d.SegmentId,
+				Cabin:            d.Cabin,
+				FareBasis:        d.FareBasis,
+				BrandedFare:      d.BrandedFare,
+				BrandedFareLabel: d.BrandedFareLabel,
+				Class:            d.Class,
+			}
+			for _, a := range d.Amenities {
+				if a == nil {
+					continue
+				}
+
+				amenity := &srv.Amenity{
+					Description:  a.Description,
+					IsChargeable: a.IsChargeable,
+					AmenityType:  a.AmenityType,
+				}
+				if a.AmenityProvider != nil {
+					amenity.AmenityProvider = &srv.AmenityProvider{Name: a.AmenityProvider.Name}
+				}
+				detail.Amenities = append(detail.Amenities, amenity)
+			}
+			if b := d.IncludedCheckedBags; b != nil {
+				detail.IncludedCheckedBags = &srv.BaggageAllowance{
+					Quantity:   b.Quantity,
+					Weight:     b.Weight,
+					WeightUnit: b.WeightUnit,
+				}
+			}
+			details = append(details, detail)
+		}
+		out.TravelerPricings = append(out.TravelerPricings, &srv.TravelerPricing{
+			TravelerId:           tp.TravelerId,
+			FareOption:           tp.FareOption,
+			TravelerType:         tp.TravelerType,
+			AssociatedAdultId:    tp.AssociatedAdultId,
+			Price:                decodeOfferPrice(tp.Price),
+			FareDetailsBySegment: details,
+		})
+	}
+	return out
+}
+
+func decodeOfferSegment(segment *pbType.OfferSegment) *srv.OfferSegment {
+	out := &srv.OfferSegment{
+		Departure:       decodeFlightEndPoint(segment.Departure),
+		Arrival:         decodeFlightEndPoint(segment.Arrival),
+		CarrierCode:     segment.CarrierCode,
+		Number:          segment.Number,
+		Duration:        segment.Duration,
+		Id:              segment.Id,
+		NumberOfStops:   segment.NumberOfStops,
+		BlacklistedInEU: segment.BlacklistedInEU,
+	}
+	if segment.Aircraft != nil {
+		out.Aircraft = &srv.Aircraft{Code: segment.Aircraft.Code}
+	}
+	if segment.Operating != nil {
+		out.Operating = &srv.Operating{
+			CarrierCode: segment.Operating.CarrierCode,
+			Number:      segment.Operating.Number,
+		}
+	}
+	return out
+}
+
+func decodeFlightEndPoint(e *pbType.FlightEndPoint) *srv.FlightEndPoint {
+	if e == nil {
+		return nil
+	}
+	return &srv.FlightEndPoint{
+		IataCode: e.IataCode,
+		Terminal: e.Terminal,
+		At:       e.At,
+	}
+}
+
+func decodeOfferPrice(p *pbType.OfferPrice) *srv.OfferPrice {
+	if p == nil {
+		return nil
+	}
+
+	price := &srv.OfferPrice{
+		Currency:        p.Currency,
+		Total:           p.Total,
+		Base:            p.Base,
+		GrandTotal:      p.GrandTotal,
+		RefundableTaxes: p.RefundableTaxes,
+	}
+	for _, fee := range p.Fees {
+		if fee != nil {
+			price.Fees = append(price.Fees, &srv.Fee{Amount: fee.Amount, Type: fee.Type})
+		}
+	}
+	for _, tax := range p.Taxes {
+		if tax != nil {
+			price.Taxes = append(price.Taxes, &srv.Tax{Amount: tax.Amount, Code: tax.Code})
+		}
+	}
+	for _, service := range p.AdditionalServices {
+		if service != nil {
+			price.AdditionalServices = append(price.AdditionalServices, &srv.Fee{Amount: service.Amount, Type: service.Type})
+		}
+	}
+	return price
+}
+
+func decodeMeta(m *pbType.Meta) *srv.Meta {
+	if m == nil {
+		return nil
+	}
+
+	meta := &srv.Meta{
+		Links:    decodeLinks(m.Links),
+		Currency: m.Currency,
+		Count:    m.Count,
+	}
+	if d := m.Defaults; d != nil {
+		meta.Defaults = &srv.Defaults{
+			Adults:        d.Adults,
+			NonStop:       d.NonStop,
+			DepartureDate: d.DepartureDate,
+			OneWay:        d.OneWay,
+			Duration:      d.Duration,
+			ViewBy:        d.ViewBy,
+		}
+	}
+	return meta
+}
+
+func decodeFlightSegment(fs *pbType.FlightSegment) *srv.FlightSegment {
+	if fs == nil {
+		return nil
+	}
+
+	segment := &srv.FlightSegment{
+		Duration:    fs.Duration,
+		Number:      fs.Number,
+		CarrierCode: fs.CarrierCode,
+	}
+	if fs.Aircraft != nil {
+		segment.Aircraft = &srv.Aircraft{Code: fs.Aircraft.Code}
+	}
+	if fs.Arrival != nil {
+		segment.Arrival = &srv.DepartureArrival{
+			At:       fs.Arrival.At,
+			IataCode: fs.Arrival.IataCode,
+			Terminal: fs.Arrival.Terminal,
+		}
+	}
+	if fs.Departure != nil {
+		segment.Departure = &srv.DepartureArrival{
+			At:       fs.Departure.At,
+			IataCode: fs.Departure.IataCode,
+			Terminal: fs.Departure.Terminal,
+		}
+	}
+	if fs.Operating != nil {
+		segment.Operating = &srv.Operating{
+			CarrierCode: fs.Operating.CarrierCode,
+			Number:      fs.Operating.Number,
+		}
+	}
+	return segment
+}
+
+func decodePricingDetail(p *pbType.PricingDetailPerAdult) *srv.PricingDetailPerAdult {
+	if p == nil {
+		return nil
+	}
+	return &srv.PricingDetailPerAdult{
+		Availability: p.Availability,
+		FareBasis:    p.FareBasis,
+		FareClass:    p.FareClass,
+		TravelClass:  p.TravelClass,
+	}
+}
+
+func decodePrice(p *pbType.Price) *srv.Price {
+	if p == nil {
+		return nil
+	}
+	return &srv.Price{
+		Total:      p.Total,
+		TotalTaxes: p.TotalTaxes,
+	}
+}
+
+func decodeLinks(l *pbType.Links) *srv.Links {
+	if l == nil {
+		return nil
+	}
+	return &srv.Links{
+		Self:               l.Self,
+		Next:               l.Next,
+		Last:               l.Last,
+		FlightDates:        l.FlightDates,
+		FlightOffers:       l.FlightOffers,
+		FlightDestinations: l.FlightDestinations,
+	}
+}
+
+func decodeScore(s *pbType.Score) *srv.Score {
+	if s == nil {
+		return nil
+	}
+
+	score := &srv.Score{Score: s.Score}
+	if n := s.NumberOfSearches; n != nil {
+		score.NumberOfSearches = &srv.NumberOfSearches{
+			PerTripDuration:  make(map[string]string),
+			PerDaysInAdvance: make(map[string]string),
+		}
+		for k, v := range n.PerTripDuration {
+			score.NumberOfSearches.PerTripDuration[k] = v
+		}
+		for k, v := range n.PerDaysInAdvance {
+			score.NumberOfSearches.PerDaysInAdvance[k] = v
+		}
+	}
+	return score
+}
+
+func decodeErrorWarnings(ews []*pbType.ErrorWarning) []*srv.ErrorWarning {
+	var out []*srv.ErrorWarning
+	for _, ew := range ews {
+		if ew == nil {
+			continue
+		}
+
+		e := &srv.ErrorWarning{
+			Title:  ew.Title,
+			Status: ew.Status,
+			Code:   ew.Code,
+			Detail: ew.Detail,
+		}
+		if ew.Source != nil {
+			e.Source = &srv.Source{
+				Example:   ew.Source.Example,
+				Parameter: ew.Source.Parameter,
+				Pointer:   ew.Source.Pointer,
+			}
+		}
+		out = append(out, e)
+	}
+	return out
+}
@@ -0,0 +1,160 @@
+package transports
+
+import (
+	"amadeus-go/pkg/auth"
+	sv "amadeus-go/pkg/services"
+
+	"context"
+	"net/http"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// encodeError turns an error of the service into the gRPC status a client
+// can act on. Errors that already are a status are passed on untouched.
+func encodeError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var st *status.Status
+	switch e := err.(type) {
+	case *sv.ValidationError:
+		st = status.New(codes.InvalidArgument, e.Error())
+		if details := badRequest(e.Errors); details != nil {
+			st = withDetails(st, details)
+		}
+	case *sv.NotFoundError:
+		st = status.New(codes.NotFound, e.Error())
+	case *sv.ConflictError:
+		st = status.New(codes.Aborted, e.Error())
+	case *sv.AuthError:
+		// these are the credentials of this service, not of the caller
+		st = status.New(codes.Internal, e.Error())
+	case *sv.RateLimitError:
+		st = status.New(codes.ResourceExhausted, e.Error())
+		if e.RetryAfter > 0 {
+			st = withDetails(st, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryAfter)})
+		}
+	case *sv.UnavailableError:
+		st = status.New(codes.Unavailable, e.Error())
+	case *sv.AmadeusError:
+		st = status.New(codes.FailedPrecondition, e.Error())
+	default:
+		switch err {
+		case auth.ErrUnauthenticated:
+			st = status.New(codes.Unauthenticated, err.Error())
+		case auth.ErrPermissionDenied:
+			st = status.New(codes.PermissionDenied, err.Error())
+		case context.Canceled:
+			st = status.New(codes.Canceled, err.Error())
+		case context.DeadlineExceeded:
+			st = status.New(codes.DeadlineExceeded, err.Error())
+		default:
+			st = status.New(codes.Unknown, err.Error())
+		}
+	}
+
+	return st.Err()
+}
+
+// decodeError is the reverse of encodeError, for the client: it turns the
+// status of a failed call to method back into the typed error of the
+// services package. Statuses that don't stand for one are returned as is.
+func decodeError(method string, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	base := &sv.AmadeusError{Route: method, Cause: err}
+	switch st.Code() {
+	case codes.InvalidArgument:
+		base.Status = http.StatusBadRequest
+		for _, d := range st.Details() {
+			if br, ok := d.(*errdetails.BadRequest); ok {
+				for _, v := range br.FieldViolations {
+					base.Errors = append(base.Errors, &sv.ErrorWarning{
+						Status: http.StatusBadRequest,
+						Title:  v.Description,
+						Source: &sv.Source{Parameter: v.Field},
+					})
+				}
+			}
+		}
+		return &sv.ValidationError{AmadeusError: base}
+	case codes.NotFound:
+		base.Status = http.StatusNotFound
+		return &sv.NotFoundError{AmadeusError: base}
+	case codes.Aborted:
+		base.Status = http.StatusConflict
+		return &sv.ConflictError{AmadeusError: base}
+	case codes.ResourceExhausted:
+		base.Status = http.StatusTooManyRequests
+		rErr := &sv.RateLimitError{AmadeusError: base}
+		for _, d := range st.Details() {
+			if ri, ok := d.(*errdetails.RetryInfo); ok && ri.RetryDelay != nil {
+				rErr.RetryAfter = ri.RetryDelay.AsDuration()
+			}
+		}
+		return rErr
+	case codes.Unavailable:
+		return &sv.UnavailableError{AmadeusError: base}
+	case codes.Unauthenticated:
+		return auth.ErrUnauthenticated
+	case codes.PermissionDenied:
+		return auth.ErrPermissionDenied
+	case codes.Canceled:
+		return context.Canceled
+	case codes.DeadlineExceeded:
+		return context.DeadlineExceeded
+	default:
+		return err
+	}
+}
+
+// badRequest lists the parameters Amadeus complained about, or returns nil
+// when none of the errors points at one.
+func badRequest(errs []*sv.ErrorWarning) *errdetails.BadRequest {
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, e := range errs {
+		if e == nil || e.Source == nil {
+			continue
+		}
+
+		field := e.Source.Parameter
+		if field == "" {
+			field = e.Source.Pointer
+		}
+		if field == "" {
+			continue
+		}
+
+		description := e.Title
+		if e.Detail != "" {
+			description += ": " + e.Detail
+		}
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: description,
+		})
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return &errdetails.BadRequest{FieldViolations: violations}
+}
+
+// withDetails attaches details to st, leaving st as it is if they can't be
+// marshalled.
+func withDetails(st *status.Status, details proto.Message) *status.Status {
+	if detailed, err := st.WithDetails(details); err == nil {
+		return detailed
+	}
+	return st
+}
@@ -0,0 +1,144 @@
+package transports
+
+import (
+	"amadeus-go/pkg/auth"
+	sv "amadeus-go/pkg/services"
+
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEncodeError(t *testing.T) {
+	base := func() *sv.AmadeusError {
+		return &sv.AmadeusError{Route: "FlightOffersSearch", Cause: errors.New("failed")}
+	}
+	invalid := &sv.ValidationError{AmadeusError: &sv.AmadeusError{
+		Route:  "FlightOffersSearch",
+		Status: http.StatusBadRequest,
+		Errors: []*sv.ErrorWarning{
+			{Status: 400, Title: "INVALID FORMAT", Detail: "must be an IATA code", Source: &sv.Source{Parameter: "originLocationCode"}},
+			{Status: 400, Title: "MANDATORY DATA MISSING", Source: &sv.Source{Pointer: "/travelers"}},
+			{Status: 400, Title: "NO SOURCE"},
+		},
+	}}
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		want codes.Code
+		// the details the status must carry
+		wantBadRequest *errdetails.BadRequest
+		wantRetryDelay time.Duration
+	}{
+		{
+			name: "validation",
+			err:  invalid,
+			want: codes.InvalidArgument,
+			wantBadRequest: &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "originLocationCode", Description: "INVALID FORMAT: must be an IATA code"},
+				{Field: "/travelers", Description: "MANDATORY DATA MISSING"},
+			}},
+		},
+		{name: "validation without sources", err: &sv.ValidationError{AmadeusError: base()}, want: codes.InvalidArgument},
+		{name: "not found", err: &sv.NotFoundError{AmadeusError: base()}, want: codes.NotFound},
+		{name: "conflict", err: &sv.ConflictError{AmadeusError: base()}, want: codes.Aborted},
+		{name: "credentials of the service", err: &sv.AuthError{AmadeusError: base()}, want: codes.Internal},
+		{name: "rate limited", err: &sv.RateLimitError{AmadeusError: base(), RetryAfter: time.Second * 3}, want: codes.ResourceExhausted, wantRetryDelay: time.Second * 3},
+		{name: "rate limited without delay", err: &sv.RateLimitError{AmadeusError: base()}, want: codes.ResourceExhausted},
+		{name: "unavailable", err: &sv.UnavailableError{AmadeusError: base()}, want: codes.Unavailable},
+		{name: "other amadeus error", err: base(), want: codes.FailedPrecondition},
+		{name: "unauthenticated", err: auth.ErrUnauthenticated, want: codes.Unauthenticated},
+		{name: "permission denied", err: auth.ErrPermissionDenied, want: codes.PermissionDenied},
+		{name: "canceled", err: context.Canceled, want: codes.Canceled},
+		{name: "deadline", err: context.DeadlineExceeded, want: codes.DeadlineExceeded},
+		{name: "unknown", err: errors.New("boom"), want: codes.Unknown},
+		{name: "already a status", err: status.Error(codes.OutOfRange, "out of range"), want: codes.OutOfRange},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			st, ok := status.FromError(encodeError(tc.err))
+			if !ok {
+				t.Fatal("the error isn't a status")
+			}
+			if st.Code() != tc.want {
+				t.Fatalf("got code %s, want %s", st.Code(), tc.want)
+			}
+
+			var (
+				badRequest *errdetails.BadRequest
+				retryDelay time.Duration
+			)
+			for _, d := range st.Details() {
+				switch d := d.(type) {
+				case *errdetails.BadRequest:
+					badRequest = d
+				case *errdetails.RetryInfo:
+					retryDelay = d.RetryDelay.AsDuration()
+				}
+			}
+			if (badRequest == nil) != (tc.wantBadRequest == nil) {
+				t.Fatalf("got BadRequest details %v, want %v", badRequest, tc.wantBadRequest)
+			}
+			if badRequest != nil {
+				if len(badRequest.FieldViolations) != len(tc.wantBadRequest.FieldViolations) {
+					t.Fatalf("got violations %v, want %v", badRequest.FieldViolations, tc.wantBadRequest.FieldViolations)
+				}
+				for i, v := range badRequest.FieldViolations {
+					want := tc.wantBadRequest.FieldViolations[i]
+					if v.Field != want.Field || v.Description != want.Description {
+						t.Errorf("violation %d: got %s %q, want %s %q", i, v.Field, v.Description, want.Field, want.Description)
+					}
+				}
+			}
+			if retryDelay != tc.wantRetryDelay {
+				t.Errorf("got a retry delay of %s, want %s", retryDelay, tc.wantRetryDelay)
+			}
+		})
+	}
+}
+
+func TestDecodeErrorReversesEncodeError(t *testing.T) {
+	for _, err := range []error{
+		&sv.ValidationError{AmadeusError: &sv.AmadeusError{
+			Errors: []*sv.ErrorWarning{{Title: "INVALID FORMAT", Source: &sv.Source{Parameter: "originLocationCode"}}},
+		}},
+		&sv.NotFoundError{AmadeusError: &sv.AmadeusError{}},
+		&sv.ConflictError{AmadeusError: &sv.AmadeusError{}},
+		&sv.RateLimitError{AmadeusError: &sv.AmadeusError{}, RetryAfter: time.Second},
+		&sv.UnavailableError{AmadeusError: &sv.AmadeusError{}},
+		auth.ErrUnauthenticated,
+		auth.ErrPermissionDenied,
+		context.Canceled,
+		context.DeadlineExceeded,
+	} {
+		decoded := decodeError("FlightOffersSearch", encodeError(err))
+		if reflect.TypeOf(decoded) != reflect.TypeOf(err) {
+			t.Errorf("%T came back as %T", err, decoded)
+			continue
+		}
+
+		switch d := decoded.(type) {
+		case *sv.ValidationError:
+			if len(d.Errors) != 1 || d.Errors[0].Source.Parameter != "originLocationCode" {
+				t.Errorf("got errors %v, want the invalid parameter", d.Errors)
+			}
+		case *sv.RateLimitError:
+			if d.RetryAfter != time.Second {
+				t.Errorf("got RetryAfter %s, want a second", d.RetryAfter)
+			}
+		}
+	}
+
+	// statuses that don't stand for an error of the service stay as they are
+	other := status.Error(codes.DataLoss, "lost")
+	if err := decodeError("FlightOffersSearch", other); err != other {
+		t.Errorf("got %v, want the status back", err)
+	}
+}
@@ -3,7 +3,9 @@ package transports
 import (
 	pbFunc "amadeus-go/api/amadeus/func"
 	pbType "amadeus-go/api/amadeus/type"
+	"amadeus-go/pkg/auth"
 	"amadeus-go/pkg/endpoints"
+	"amadeus-go/pkg/logging"
 	sv "amadeus-go/pkg/services"
 	
 	"context"
@@ -11,6 +13,8 @@ import (
 	
 	"github.com/go-kit/kit/log"
 	grpcTransport "github.com/go-kit/kit/transport/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 type grpcServer struct {
@@ -26,12 +30,20 @@ type grpcServer struct {
 	AirportNearestRelevantHandler          grpcTransport.Handler
 	AirportAndCitySearchHandler            grpcTransport.Handler
 	AirlineCodeLookupHandler               grpcTransport.Handler
+	FlightOffersSearchHandler              grpcTransport.Handler
+	FlightOffersPriceHandler               grpcTransport.Handler
+	CreateFlightOrderHandler               grpcTransport.Handler
+	GetFlightOrderHandler                  grpcTransport.Handler
+	CancelFlightOrderHandler               grpcTransport.Handler
+	SeatMapDisplayHandler                  grpcTransport.Handler
+	BrandedFaresUpsellHandler              grpcTransport.Handler
+	FlightAvailabilitiesSearchHandler      grpcTransport.Handler
 }
 
 func (s *grpcServer) FlightLowFareSearch(ctx context.Context, req *pbFunc.FlightLowFareSearchRequest) (*pbType.Response, error) {
 	_, resp, err := s.FlightLowFareSearchHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
@@ -39,9 +51,9 @@ func (s *grpcServer) FlightLowFareSearch(ctx context.Context, req *pbFunc.Flight
 }
 
 func (s *grpcServer) FlightInspirationSearch(ctx context.Context, req *pbFunc.FlightInspirationSearchRequest) (*pbType.Response, error) {
-	_, resp, err := s.FlightLowFareSearchHandler.ServeGRPC(ctx, req)
+	_, resp, err := s.FlightInspirationSearchHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
@@ -51,7 +63,7 @@ func (s *grpcServer) FlightInspirationSearch(ctx context.Context, req *pbFunc.Fl
 func (s *grpcServer) FlightCheapestDateSearch(ctx context.Context, req *pbFunc.FlightCheapestDateSearchRequest) (*pbType.Response, error) {
 	_, resp, err := s.FlightCheapestDateSearchHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
@@ -61,7 +73,7 @@ func (s *grpcServer) FlightCheapestDateSearch(ctx context.Context, req *pbFunc.F
 func (s *grpcServer) FlightMostSearchedDestinations(ctx context.Context, req *pbFunc.FlightMostSearchedDestinationsRequest) (*pbType.Response, error) {
 	_, resp, err := s.FlightMostSearchedDestinationsHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
@@ -71,7 +83,7 @@ func (s *grpcServer) FlightMostSearchedDestinations(ctx context.Context, req *pb
 func (s *grpcServer) FlightMostSearchedByDestination(ctx context.Context, req *pbFunc.FlightMostSearchedByDestinationRequest) (*pbType.Response, error) {
 	_, resp, err := s.FlightMostSearchedByDestinationHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
@@ -81,7 +93,7 @@ func (s *grpcServer) FlightMostSearchedByDestination(ctx context.Context, req *p
 func (s *grpcServer) FlightCheckInLinks(ctx context.Context, req *pbFunc.FlightCheckInLinksRequest) (*pbType.Response, error) {
 	_, resp, err := s.FlightCheckInLinksHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
@@ -89,9 +101,9 @@ func (s *grpcServer) FlightCheckInLinks(ctx context.Context, req *pbFunc.FlightC
 }
 
 func (s *grpcServer) FlightMostTraveledDestinations(ctx context.Context, req *pbFunc.FlightMostTraveledDestinationsRequest) (*pbType.Response, error) {
-	_, resp, err := s.FlightLowFareSearchHandler.ServeGRPC(ctx, req)
+	_, resp, err := s.FlightMostTraveledDestinationsHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
@@ -101,7 +113,7 @@ func (s *grpcServer) FlightMostTraveledDestinations(ctx context.Context, req *pb
 func (s *grpcServer) FlightMostBookedDestinations(ctx context.Context, req *pbFunc.FlightMostBookedDestinationsRequest) (*pbType.Response, error) {
 	_, resp, err := s.FlightMostBookedDestinationsHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
@@ -111,7 +123,7 @@ func (s *grpcServer) FlightMostBookedDestinations(ctx context.Context, req *pbFu
 func (s *grpcServer) FlightBusiestTravelingPeriod(ctx context.Context, req *pbFunc.FlightBusiestTravelingPeriodRequest) (*pbType.Response, error) {
 	_, resp, err := s.FlightBusiestTravelingPeriodHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
@@ -121,7 +133,7 @@ func (s *grpcServer) FlightBusiestTravelingPeriod(ctx context.Context, req *pbFu
 func (s *grpcServer) AirportNearestRelevant(ctx context.Context, req *pbFunc.AirportNearestRelevantRequest) (*pbType.Response, error) {
 	_, resp, err := s.AirportNearestRelevantHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
@@ -129,9 +141,9 @@ func (s *grpcServer) AirportNearestRelevant(ctx context.Context, req *pbFunc.Air
 }
 
 func (s *grpcServer) AirportAndCitySearch(ctx context.Context, req *pbFunc.AirportAndCitySearchRequest) (*pbType.Response, error) {
-	_, resp, err := s.AirportNearestRelevantHandler.ServeGRPC(ctx, req)
+	_, resp, err := s.AirportAndCitySearchHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
@@ -141,80 +153,256 @@ func (s *grpcServer) AirportAndCitySearch(ctx context.Context, req *pbFunc.Airpo
 func (s *grpcServer) AirlineCodeLookup(ctx context.Context, req *pbFunc.AirlineCodeLookupRequest) (*pbType.Response, error) {
 	_, resp, err := s.AirlineCodeLookupHandler.ServeGRPC(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, encodeError(err)
 	}
 
 	response := resp.(*pbType.Response)
 	return response, nil
 }
 
+func (s *grpcServer) FlightOffersSearch(ctx context.Context, req *pbFunc.FlightOffersSearchRequest) (*pbType.FlightOffersResponse, error) {
+	_, resp, err := s.FlightOffersSearchHandler.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	response := resp.(*pbType.FlightOffersResponse)
+	return response, nil
+}
+
+func (s *grpcServer) FlightOffersPrice(ctx context.Context, req *pbFunc.FlightOffersPriceRequest) (*pbType.FlightOffersPriceResponse, error) {
+	_, resp, err := s.FlightOffersPriceHandler.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	response := resp.(*pbType.FlightOffersPriceResponse)
+	return response, nil
+}
+
+func (s *grpcServer) CreateFlightOrder(ctx context.Context, req *pbFunc.CreateFlightOrderRequest) (*pbType.FlightOrderResponse, error) {
+	_, resp, err := s.CreateFlightOrderHandler.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	response := resp.(*pbType.FlightOrderResponse)
+	return response, nil
+}
+
+func (s *grpcServer) GetFlightOrder(ctx context.Context, req *pbFunc.GetFlightOrderRequest) (*pbType.FlightOrderResponse, error) {
+	_, resp, err := s.GetFlightOrderHandler.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	response := resp.(*pbType.FlightOrderResponse)
+	return response, nil
+}
+
+func (s *grpcServer) CancelFlightOrder(ctx context.Context, req *pbFunc.CancelFlightOrderRequest) (*pbType.CancelFlightOrderResponse, error) {
+	_, resp, err := s.CancelFlightOrderHandler.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	response := resp.(*pbType.CancelFlightOrderResponse)
+	return response, nil
+}
+
+func (s *grpcServer) SeatMapDisplay(ctx context.Context, req *pbFunc.SeatMapDisplayRequest) (*pbType.SeatMapResponse, error) {
+	_, resp, err := s.SeatMapDisplayHandler.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	response := resp.(*pbType.SeatMapResponse)
+	return response, nil
+}
+
+func (s *grpcServer) BrandedFaresUpsell(ctx context.Context, req *pbFunc.BrandedFaresUpsellRequest) (*pbType.FlightOffersResponse, error) {
+	_, resp, err := s.BrandedFaresUpsellHandler.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	response := resp.(*pbType.FlightOffersResponse)
+	return response, nil
+}
+
+func (s *grpcServer) FlightAvailabilitiesSearch(ctx context.Context, req *pbFunc.FlightAvailabilitiesSearchRequest) (*pbType.FlightAvailabilitiesResponse, error) {
+	_, resp, err := s.FlightAvailabilitiesSearchHandler.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	response := resp.(*pbType.FlightAvailabilitiesResponse)
+	return response, nil
+}
+
 func NewGRPCServer(endpoints *endpoints.AmadeusEndpointSet, logger log.Logger) (s pbFunc.AmadeusServiceServer) {
+	options := []grpcTransport.ServerOption{
+		grpcTransport.ServerBefore(credentialsFromMetadata),
+	}
+
 	s = &grpcServer{
 		FlightLowFareSearchHandler: grpcTransport.NewServer(
 			endpoints.FlightLowFareSearchEndpoint,
 			decodeFlightLowFareSearchRequest,
 			encodeResponse,
+			options...,
 		),
 		FlightInspirationSearchHandler: grpcTransport.NewServer(
 			endpoints.FlightInspirationSearchEndpoint,
 			decodeFlightInspirationSearchRequest,
 			encodeResponse,
+			options...,
 		),
 		FlightCheapestDateSearchHandler: grpcTransport.NewServer(
 			endpoints.FlightCheapestDateSearchEndpoint,
 			decodeFlightCheapestDateSearchRequest,
 			encodeResponse,
+			options...,
 		),
 		FlightMostTraveledDestinationsHandler: grpcTransport.NewServer(
 			endpoints.FlightMostTraveledDestinationsEndpoint,
 			decodeFlightMostTraveledDestinationsRequest,
 			encodeResponse,
+			options...,
 		),
 		FlightMostSearchedDestinationsHandler: grpcTransport.NewServer(
 			endpoints.FlightMostSearchedDestinationsEndpoint,
 			decodeFlightMostSearchedDestinationsRequest,
 			encodeResponse,
+			options...,
 		),
 		FlightMostSearchedByDestinationHandler: grpcTransport.NewServer(
 			endpoints.FlightMostSearchedByDestinationEndpoint,
 			decodeFlightMostSearchedByDestinationRequest,
 			encodeResponse,
+			options...,
 		),
 		FlightCheckInLinksHandler: grpcTransport.NewServer(
 			endpoints.FlightCheckInLinksEndpoint,
 			decodeFlightCheckInLinksRequest,
 			encodeResponse,
+			options...,
 		),
 		FlightMostBookedDestinationsHandler: grpcTransport.NewServer(
 			endpoints.FlightMostBookedDestinationsEndpoint,
 			decodeFlightMostBookedDestinationsRequest,
 			encodeResponse,
+			options...,
 		),
 		FlightBusiestTravelingPeriodHandler: grpcTransport.NewServer(
 			endpoints.FlightBusiestTravelingPeriodEndpoint,
 			decodeFlightBusiestTravelingPeriodRequest,
 			encodeResponse,
+			options...,
 		),
 		AirportNearestRelevantHandler: grpcTransport.NewServer(
 			endpoints.AirportNearestRelevantEndpoint,
 			decodeAirportNearestRelevantRequest,
 			encodeResponse,
+			options...,
 		),
 		AirportAndCitySearchHandler: grpcTransport.NewServer(
 			endpoints.AirportAndCitySearchEndpoint,
 			decodeAirportAndCitySearchRequest,
 			encodeResponse,
+			options...,
 		),
 		AirlineCodeLookupHandler: grpcTransport.NewServer(
 			endpoints.AirlineCodeLookupEndpoint,
 			decodeAirlineCodeLookupRequest,
 			encodeResponse,
+			options...,
+		),
+		FlightOffersSearchHandler: grpcTransport.NewServer(
+			endpoints.FlightOffersSearchEndpoint,
+			decodeFlightOffersSearchRequest,
+			encodeFlightOffersResponse,
+			options...,
+		),
+		FlightOffersPriceHandler: grpcTransport.NewServer(
+			endpoints.FlightOffersPriceEndpoint,
+			decodeFlightOffersPriceRequest,
+			encodeFlightOffersPriceResponse,
+			options...,
+		),
+		CreateFlightOrderHandler: grpcTransport.NewServer(
+			endpoints.CreateFlightOrderEndpoint,
+			decodeCreateFlightOrderRequest,
+			encodeFlightOrderResponse,
+			options...,
+		),
+		GetFlightOrderHandler: grpcTransport.NewServer(
+			endpoints.GetFlightOrderEndpoint,
+			decodeGetFlightOrderRequest,
+			encodeFlightOrderResponse,
+			options...,
+		),
+		CancelFlightOrderHandler: grpcTransport.NewServer(
+			endpoints.CancelFlightOrderEndpoint,
+			decodeCancelFlightOrderRequest,
+			encodeCancelFlightOrderResponse,
+			options...,
+		),
+		SeatMapDisplayHandler: grpcTransport.NewServer(
+			endpoints.SeatMapDisplayEndpoint,
+			decodeSeatMapDisplayRequest,
+			encodeSeatMapResponse,
+			options...,
+		),
+		BrandedFaresUpsellHandler: grpcTransport.NewServer(
+			endpoints.BrandedFaresUpsellEndpoint,
+			decodeBrandedFaresUpsellRequest,
+			encodeFlightOffersResponse,
+			options...,
+		),
+		FlightAvailabilitiesSearchHandler: grpcTransport.NewServer(
+			endpoints.FlightAvailabilitiesSearchEndpoint,
+			decodeFlightAvailabilitiesSearchRequest,
+			encodeFlightAvailabilitiesResponse,
+			options...,
 		),
 	}
 
 	return
 }
 
+// credentialsFromMetadata puts the API key or JWT of a call in the context,
+// from either the authorization (as a bearer token) or x-api-key metadata.
+func credentialsFromMetadata(ctx context.Context, md metadata.MD) context.Context {
+	if values := md.Get("authorization"); len(values) > 0 {
+		if credentials := auth.ParseAuthorization(values[0]); credentials != "" {
+			return auth.WithCredentials(ctx, credentials)
+		}
+	}
+	if values := md.Get("x-api-key"); len(values) > 0 {
+		return auth.WithCredentials(ctx, values[0])
+	}
+	return ctx
+}
+
+// RequestIDInterceptor gives every call the request ID its client sent in the
+// x-request-id metadata, or a new one, and sends it back in the header so
+// the logs of both sides can be matched.
+func RequestIDInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-request-id"); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	if id == "" {
+		id = logging.NewRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs("x-request-id", id))
+
+	return handler(logging.WithRequestID(ctx, id), req)
+}
+
 // =============================================================================
 
 // watch out for this method, it is long and hard to understand cause we are
@@ -252,28 +440,36 @@ func encodeResponse(_ context.Context, response interface{}) (interface{}, error
 								}
 
 								var flightSegment pbType.FlightSegment
-								if segment.FlightSegment != nil {
+								if fs := segment.FlightSegment; fs != nil {
 									flightSegment = pbType.FlightSegment{
-										Duration: segment.FlightSegment.Duration,
-										Number:   segment.FlightSegment.Number,
-										Aircraft: &pbType.Aircraft{
-											Code: segment.FlightSegment.Aircraft.Code,
-										},
-										Arrival: &pbType.DepartureArrival{
-											At:       segment.FlightSegment.Arrival.At,
-											IataCode: segment.FlightSegment.Arrival.IataCode,
-											Terminal: segment.FlightSegment.Arrival.Terminal,
-										},
-										Departure: &pbType.DepartureArrival{
-											At:       segment.FlightSegment.Departure.At,
-											IataCode: segment.FlightSegment.Departure.IataCode,
-											Terminal: segment.FlightSegment.Departure.Terminal,
-										},
-										CarrierCode: segment.FlightSegment.CarrierCode,
-										Operating: &pbType.Operating{
-											CarrierCode: segment.FlightSegment.Operating.CarrierCode,
-											Number:      segment.FlightSegment.Operating.Number,
-										},
+										Duration:    fs.Duration,
+										Number:      fs.Number,
+										CarrierCode: fs.CarrierCode,
+									}
+									if fs.Aircraft != nil {
+										flightSegment.Aircraft = &pbType.Aircraft{
+											Code: fs.Aircraft.Code,
+										}
+									}
+									if fs.Arrival != nil {
+										flightSegment.Arrival = &pbType.DepartureArrival{
+											At:       fs.Arrival.At,
+											IataCode: fs.Arrival.IataCode,
+											Terminal: fs.Arrival.Terminal,
+										}
+									}
+									if fs.Departure != nil {
+										flightSegment.Departure = &pbType.DepartureArrival{
+											At:       fs.Departure.At,
+											IataCode: fs.Departure.IataCode,
+											Terminal: fs.Departure.Terminal,
+										}
+									}
+									if fs.Operating != nil {
+										flightSegment.Operating = &pbType.Operating{
+											CarrierCode: fs.Operating.CarrierCode,
+											Number:      fs.Operating.Number,
+										}
 									}
 								}
 
@@ -371,15 +567,15 @@ func encodeResponse(_ context.Context, response interface{}) (interface{}, error
 		var analytics pbType.Analytics
 		if data.Analytics != nil {
 			if data.Analytics.Flights != nil {
-				analytics.Flights.Score = data.Analytics.Flights.Score
+				analytics.Flights = &pbType.Score{Score: data.Analytics.Flights.Score}
 			}
 
 			if data.Analytics.Travelers != nil {
-				analytics.Travelers.Score = data.Analytics.Travelers.Score
+				analytics.Travelers = &pbType.Score{Score: data.Analytics.Travelers.Score}
 			}
 
 			if data.Analytics.Searches != nil {
-				analytics.Searches.Score = data.Analytics.Searches.Score
+				analytics.Searches = &pbType.Score{Score: data.Analytics.Searches.Score}
 				if data.Analytics.Searches.NumberOfSearches != nil {
 
 					perTripDuration := make(map[string]string)
@@ -434,6 +630,9 @@ func encodeResponse(_ context.Context, response interface{}) (interface{}, error
 			Href:           data.Href,
 			Channel:        data.Channel,
 			Parameters:     params,
+			IcaoCode:       data.IcaoCode,
+			BusinessName:   data.BusinessName,
+			CommonName:     data.CommonName,
 		}
 		datas = append(datas, &newData)
 	} // endfor resp.Data
@@ -449,22 +648,19 @@ func encodeResponse(_ context.Context, response interface{}) (interface{}, error
 			dictionaries.Aircrafts[k] = v
 		}
 		for k, v := range resp.Dictionaries.Locations {
-			if _, ok := dictionaries.Locations[k]; !ok {
-				dictionaries.Locations[k] = &pbType.LocationDetail{
-					Detail: make(map[string]string),
-				}
-			}
+			detail := make(map[string]string)
 			for subK, subV := range v {
-				dictionaries.Locations[k] = &pbType.LocationDetail{
-					Detail: map[string]string{subK: subV},
-				}
+				detail[subK] = subV
+			}
+			dictionaries.Locations[k] = &pbType.LocationDetail{
+				Detail: detail,
 			}
 		}
 		for k, v := range resp.Dictionaries.Carriers {
-			dictionaries.Aircrafts[k] = v
+			dictionaries.Carriers[k] = v
 		}
 		for k, v := range resp.Dictionaries.Currencies {
-			dictionaries.Aircrafts[k] = v
+			dictionaries.Currencies[k] = v
 		}
 	} // endif resp.Dictionaries != nil
 
@@ -486,8 +682,12 @@ func encodeResponse(_ context.Context, response interface{}) (interface{}, error
 		var defaults pbType.Defaults
 		if resp.Meta.Defaults != nil {
 			defaults = pbType.Defaults{
-				Adults:  resp.Meta.Defaults.Adults,
-				NonStop: resp.Meta.Defaults.NonStop,
+				Adults:        resp.Meta.Defaults.Adults,
+				NonStop:       resp.Meta.Defaults.NonStop,
+				DepartureDate: resp.Meta.Defaults.DepartureDate,
+				OneWay:        resp.Meta.Defaults.OneWay,
+				Duration:      resp.Meta.Defaults.Duration,
+				ViewBy:        resp.Meta.Defaults.ViewBy,
 			}
 		}
 
@@ -547,6 +747,721 @@ func encodeResponse(_ context.Context, response interface{}) (interface{}, error
 	}, nil
 }
 
+// encodeFlightOffersResponse is encodeResponse for the v2 offer model.
+func encodeFlightOffersResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*sv.FlightOffersResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <FlightOffersResponse>")
+	}
+
+	var offers []*pbType.FlightOffer
+	for _, offer := range resp.Data {
+		if offer != nil {
+			offers = append(offers, encodeFlightOffer(offer))
+		}
+	}
+
+	return &pbType.FlightOffersResponse{
+		Data:         offers,
+		Dictionaries: encodeOfferDictionaries(resp.Dictionaries),
+		Meta:         encodeMeta(resp.Meta),
+		Warnings:     encodeErrorWarnings(resp.Warnings),
+		Errors:       encodeErrorWarnings(resp.Errors),
+	}, nil
+}
+
+func encodeOfferDictionaries(d *sv.OfferDictionaries) *pbType.OfferDictionaries {
+	if d == nil {
+		return nil
+	}
+
+	dictionaries := &pbType.OfferDictionaries{
+		Locations:  make(map[string]*pbType.LocationValue),
+		Aircraft:   make(map[string]string),
+		Currencies: make(map[string]string),
+		Carriers:   make(map[string]string),
+	}
+	for k, v := range d.Locations {
+		if v != nil {
+			dictionaries.Locations[k] = &pbType.LocationValue{CityCode: v.CityCode, CountryCode: v.CountryCode}
+		}
+	}
+	for k, v := range d.Aircraft {
+		dictionaries.Aircraft[k] = v
+	}
+	for k, v := range d.Currencies {
+		dictionaries.Currencies[k] = v
+	}
+	for k, v := range d.Carriers {
+		dictionaries.Carriers[k] = v
+	}
+	return dictionaries
+}
+
+// encodeFlightOffersPriceResponse is encodeResponse for priced offers.
+func encodeFlightOffersPriceResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*sv.FlightOffersPriceResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <FlightOffersPriceResponse>")
+	}
+
+	var pricing *pbType.FlightOffersPricing
+	if d := resp.Data; d != nil {
+		pricing = &pbType.FlightOffersPricing{Type: d.Type}
+		for _, offer := range d.FlightOffers {
+			if offer != nil {
+				pricing.FlightOffers = append(pricing.FlightOffers, encodeFlightOffer(offer))
+			}
+		}
+		if r := d.BookingRequirements; r != nil {
+			pricing.BookingRequirements = &pbType.BookingRequirements{
+				EmailAddressRequired:      r.EmailAddressRequired,
+				MobilePhoneNumberRequired: r.MobilePhoneNumberRequired,
+				InvoiceAddressRequired:    r.InvoiceAddressRequired,
+				MailingAddressRequired:    r.MailingAddressRequired,
+			}
+			for _, t := range r.TravelerRequirements {
+				if t == nil {
+					continue
+				}
+				pricing.BookingRequirements.TravelerRequirements = append(pricing.BookingRequirements.TravelerRequirements, &pbType.TravelerRequirements{
+					TravelerId:                   t.TravelerId,
+					GenderRequired:               t.GenderRequired,
+					DocumentRequired:             t.DocumentRequired,
+					DocumentIssuanceCityRequired: t.DocumentIssuanceCityRequired,
+					DateOfBirthRequired:          t.DateOfBirthRequired,
+					RedressRequiredIfAny:         t.RedressRequiredIfAny,
+					ResidenceRequired:            t.ResidenceRequired,
+				})
+			}
+		}
+	}
+
+	var included *pbType.PricingIncluded
+	if i := resp.Included; i != nil {
+		included = &pbType.PricingIncluded{
+			CreditCardFees:    make(map[string]*pbType.CreditCardFee),
+			Bags:              make(map[string]*pbType.BagOffer),
+			DetailedFareRules: make(map[string]*pbType.DetailedFareRules),
+		}
+		for k, v := range i.CreditCardFees {
+			if v != nil {
+				included.CreditCardFees[k] = &pbType.CreditCardFee{
+					Brand:         v.Brand,
+					Amount:        v.Amount,
+					Currency:      v.Currency,
+					FlightOfferId: v.FlightOfferId,
+				}
+			}
+		}
+		for k, v := range i.Bags {
+			if v == nil {
+				continue
+			}
+			bag := &pbType.BagOffer{
+				Quantity:            v.Quantity,
+				Weight:              v.Weight,
+				WeightUnit:          v.WeightUnit,
+				Name:                v.Name,
+				BookableByItinerary: v.BookableByItinerary,
+				SegmentIds:          v.SegmentIds,
+				TravelerIds:         v.TravelerIds,
+			}
+			if v.Price != nil {
+				bag.Price = &pbType.BagPrice{Amount: v.Price.Amount, CurrencyCode: v.Price.CurrencyCode}
+			}
+			included.Bags[k] = bag
+		}
+		for k, v := range i.DetailedFareRules {
+			if v == nil {
+				continue
+			}
+			rules := &pbType.DetailedFareRules{
+				FareBasis: v.FareBasis,
+				Name:      v.Name,
+				SegmentId: v.SegmentId,
+			}
+			if v.FareNotes != nil {
+				rules.FareNotes = &pbType.FareNotes{}
+				for _, d := range v.FareNotes.Descriptions {
+					if d != nil {
+						rules.FareNotes.Descriptions = append(rules.FareNotes.Descriptions, &pbType.FareDescription{
+							DescriptionType: d.DescriptionType,
+							Text:            d.Text,
+						})
+					}
+				}
+			}
+			included.DetailedFareRules[k] = rules
+		}
+	}
+
+	return &pbType.FlightOffersPriceResponse{
+		Data:         pricing,
+		Included:     included,
+		Dictionaries: encodeOfferDictionaries(resp.Dictionaries),
+		Warnings:     encodeErrorWarnings(resp.Warnings),
+		Errors:       encodeErrorWarnings(resp.Errors),
+	}, nil
+}
+
+// encodeFlightOrderResponse is encodeResponse for orders.
+func encodeFlightOrderResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*sv.FlightOrderResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <FlightOrderResponse>")
+	}
+
+	var order *pbType.FlightOrder
+	if resp.Data != nil {
+		order = encodeFlightOrder(resp.Data)
+	}
+
+	return &pbType.FlightOrderResponse{
+		Data:         order,
+		Dictionaries: encodeOfferDictionaries(resp.Dictionaries),
+		Warnings:     encodeErrorWarnings(resp.Warnings),
+		Errors:       encodeErrorWarnings(resp.Errors),
+	}, nil
+}
+
+func encodeCancelFlightOrderResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*sv.CancelFlightOrderResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <CancelFlightOrderResponse>")
+	}
+	return &pbType.CancelFlightOrderResponse{FlightOrderId: resp.FlightOrderId}, nil
+}
+
+func encodeFlightOrder(order *sv.FlightOrder) *pbType.FlightOrder {
+	out := &pbType.FlightOrder{
+		Type:            order.Type,
+		Id:              order.Id,
+		QueuingOfficeId: order.QueuingOfficeId,
+		Travelers:       encodeTravelers(order.Travelers),
+		Contacts:        encodeContacts(order.Contacts),
+	}
+	for _, r := range order.AssociatedRecords {
+		if r != nil {
+			out.AssociatedRecords = append(out.AssociatedRecords, &pbType.AssociatedRecord{
+				Reference:        r.Reference,
+				CreationDate:     r.CreationDate,
+				OriginSystemCode: r.OriginSystemCode,
+				FlightOfferId:    r.FlightOfferId,
+			})
+		}
+	}
+	for _, offer := range order.FlightOffers {
+		if offer != nil {
+			out.FlightOffers = append(out.FlightOffers, encodeFlightOffer(offer))
+		}
+	}
+	return out
+}
+
+func encodeTravelers(travelers []*sv.Traveler) []*pbType.Traveler {
+	var out []*pbType.Traveler
+	for _, t := range travelers {
+		if t == nil {
+			continue
+		}
+
+		traveler := &pbType.Traveler{
+			Id:          t.Id,
+			DateOfBirth: t.DateOfBirth,
+			Gender:      t.Gender,
+			Name:        encodeTravelerName(t.Name),
+			Contact:     encodeContact(t.Contact),
+		}
+		for _, d := range t.Documents {
+			if d != nil {
+				traveler.Documents = append(traveler.Documents, &pbType.TravelerDocument{
+					DocumentType:     d.DocumentType,
+					Number:           d.Number,
+					ExpiryDate:       d.ExpiryDate,
+					IssuanceCountry:  d.IssuanceCountry,
+					IssuanceDate:     d.IssuanceDate,
+					IssuanceLocation: d.IssuanceLocation,
+					Nationality:      d.Nationality,
+					BirthPlace:       d.BirthPlace,
+					ValidityCountry:  d.ValidityCountry,
+					Holder:           d.Holder,
+				})
+			}
+		}
+		out = append(out, traveler)
+	}
+	return out
+}
+
+func encodeTravelerName(n *sv.TravelerName) *pbType.TravelerName {
+	if n == nil {
+		return nil
+	}
+	return &pbType.TravelerName{FirstName: n.FirstName, LastName: n.LastName}
+}
+
+func encodeContacts(contacts []*sv.Contact) []*pbType.Contact {
+	var out []*pbType.Contact
+	for _, c := range contacts {
+		if c != nil {
+			out = append(out, encodeContact(c))
+		}
+	}
+	return out
+}
+
+func encodeContact(c *sv.Contact) *pbType.Contact {
+	if c == nil {
+		return nil
+	}
+
+	out := &pbType.Contact{
+		AddresseeName: encodeTravelerName(c.AddresseeName),
+		CompanyName:   c.CompanyName,
+		Purpose:       c.Purpose,
+		EmailAddress:  c.EmailAddress,
+	}
+	for _, p := range c.Phones {
+		if p != nil {
+			out.Phones = append(out.Phones, &pbType.Phone{
+				DeviceType:         p.DeviceType,
+				CountryCallingCode: p.CountryCallingCode,
+				Number:             p.Number,
+			})
+		}
+	}
+	if a := c.Address; a != nil {
+		out.Address = &pbType.PostalAddress{
+			Lines:       a.Lines,
+			PostalCode:  a.PostalCode,
+			CityName:    a.CityName,
+			CountryCode: a.CountryCode,
+		}
+	}
+	return out
+}
+
+// encodeSeatMapResponse is encodeResponse for seat maps.
+func encodeSeatMapResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*sv.SeatMapResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <SeatMapResponse>")
+	}
+
+	var seatMaps []*pbType.SeatMap
+	for _, m := range resp.Data {
+		if m != nil {
+			seatMaps = append(seatMaps, encodeSeatMap(m))
+		}
+	}
+
+	var dictionaries *pbType.SeatMapDictionaries
+	if d := resp.Dictionaries; d != nil {
+		dictionaries = &pbType.SeatMapDictionaries{
+			Locations:          make(map[string]*pbType.LocationValue),
+			Facility:           make(map[string]string),
+			SeatCharacteristic: make(map[string]string),
+		}
+		for k, v := range d.Locations {
+			if v != nil {
+				dictionaries.Locations[k] = &pbType.LocationValue{CityCode: v.CityCode, CountryCode: v.CountryCode}
+			}
+		}
+		for k, v := range d.Facility {
+			dictionaries.Facility[k] = v
+		}
+		for k, v := range d.SeatCharacteristic {
+			dictionaries.SeatCharacteristic[k] = v
+		}
+	}
+
+	return &pbType.SeatMapResponse{
+		Data:         seatMaps,
+		Dictionaries: dictionaries,
+		Meta:         encodeMeta(resp.Meta),
+		Warnings:     encodeErrorWarnings(resp.Warnings),
+		Errors:       encodeErrorWarnings(resp.Errors),
+	}, nil
+}
+
+func encodeSeatMap(m *sv.SeatMap) *pbType.SeatMap {
+	out := &pbType.SeatMap{
+		Type:          m.Type,
+		Id:            m.Id,
+		Departure:     encodeFlightEndPoint(m.Departure),
+		Arrival:       encodeFlightEndPoint(m.Arrival),
+		CarrierCode:   m.CarrierCode,
+		Number:        m.Number,
+		Class:         m.Class,
+		FlightOfferId: m.FlightOfferId,
+		SegmentId:     m.SegmentId,
+	}
+	if m.Aircraft != nil {
+		out.Aircraft = &pbType.Aircraft{Code: m.Aircraft.Code}
+	}
+	if m.Operating != nil {
+		out.Operating = &pbType.Operating{
+			CarrierCode: m.Operating.CarrierCode,
+			Number:      m.Operating.Number,
+		}
+	}
+	for _, c := range m.AvailableSeatsCounters {
+		if c != nil {
+			out.AvailableSeatsCounters = append(out.AvailableSeatsCounters, &pbType.AvailableSeatsCounter{
+				TravelerId: c.TravelerId,
+				Value:      c.Value,
+			})
+		}
+	}
+
+	for _, d := range m.Decks {
+		if d == nil {
+			continue
+		}
+
+		deck := &pbType.Deck{DeckType: d.DeckType}
+		if c := d.DeckConfiguration; c != nil {
+			deck.DeckConfiguration = &pbType.DeckConfiguration{
+				Width:         c.Width,
+				Length:        c.Length,
+				StartSeatRow:  c.StartSeatRow,
+				EndSeatRow:    c.EndSeatRow,
+				StartWingsX:   c.StartWingsX,
+				EndWingsX:     c.EndWingsX,
+				StartWingsRow: c.StartWingsRow,
+				EndWingsRow:   c.EndWingsRow,
+				ExitRowsX:     c.ExitRowsX,
+			}
+		}
+		for _, f := range d.Facilities {
+			if f != nil {
+				deck.Facilities = append(deck.Facilities, &pbType.Facility{
+					Code:        f.Code,
+					Column:      f.Column,
+					Row:         f.Row,
+					Position:    f.Position,
+					Coordinates: encodeCoordinates(f.Coordinates),
+				})
+			}
+		}
+		for _, s := range d.Seats {
+			if s == nil {
+				continue
+			}
+
+			seat := &pbType.Seat{
+				Cabin:                s.Cabin,
+				Number:               s.Number,
+				CharacteristicsCodes: s.CharacteristicsCodes,
+				Coordinates:          encodeCoordinates(s.Coordinates),
+			}
+			for _, tp := range s.TravelerPricing {
+				if tp != nil {
+					seat.TravelerPricing = append(seat.TravelerPricing, &pbType.SeatTravelerPricing{
+						TravelerId:             tp.TravelerId,
+						SeatAvailabilityStatus: tp.SeatAvailabilityStatus,
+						Price:                  encodeOfferPrice(tp.Price),
+					})
+				}
+			}
+			deck.Seats = append(deck.Seats, seat)
+		}
+		out.Decks = append(out.Decks, deck)
+	}
+	return out
+}
+
+func encodeCoordinates(c *sv.Coordinates) *pbType.Coordinates {
+	if c == nil {
+		return nil
+	}
+	return &pbType.Coordinates{X: c.X, Y: c.Y}
+}
+
+// encodeFlightAvailabilitiesResponse is encodeResponse for availabilities.
+func encodeFlightAvailabilitiesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp, ok := response.(*sv.FlightAvailabilitiesResponse)
+	if !ok {
+		return nil, errors.New("couldn't convert response to <FlightAvailabilitiesResponse>")
+	}
+
+	var availabilities []*pbType.FlightAvailability
+	for _, a := range resp.Data {
+		if a != nil {
+			availabilities = append(availabilities, encodeFlightAvailability(a))
+		}
+	}
+
+	return &pbType.FlightAvailabilitiesResponse{
+		Data:         availabilities,
+		Dictionaries: encodeOfferDictionaries(resp.Dictionaries),
+		Meta:         encodeMeta(resp.Meta),
+		Warnings:     encodeErrorWarnings(resp.Warnings),
+		Errors:       encodeErrorWarnings(resp.Errors),
+	}, nil
+}
+
+func encodeFlightAvailability(a *sv.FlightAvailability) *pbType.FlightAvailability {
+	out := &pbType.FlightAvailability{
+		Type:                     a.Type,
+		Id:                       a.Id,
+		OriginDestinationId:      a.OriginDestinationId,
+		Source:                   a.Source,
+		InstantTicketingRequired: a.InstantTicketingRequired,
+		PaymentCardRequired:      a.PaymentCardRequired,
+		Duration:                 a.Duration,
+	}
+	for _, segment := range a.Segments {
+		if segment == nil {
+			continue
+		}
+
+		s := &pbType.AvailabilitySegment{
+			Id:              segment.Id,
+			NumberOfStops:   segment.NumberOfStops,
+			BlacklistedInEU: segment.BlacklistedInEU,
+			Departure:       encodeFlightEndPoint(segment.Departure),
+			Arrival:         encodeFlightEndPoint(segment.Arrival),
+			CarrierCode:     segment.CarrierCode,
+			Number:          segment.Number,
+		}
+		if segment.Aircraft != nil {
+			s.Aircraft = &pbType.Aircraft{Code: segment.Aircraft.Code}
+		}
+		if segment.Operating != nil {
+			s.Operating = &pbType.Operating{
+				CarrierCode: segment.Operating.CarrierCode,
+				Number:      segment.Operating.Number,
+			}
+		}
+		for _, c := range segment.AvailabilityClasses {
+			if c != nil {
+				s.AvailabilityClasses = append(s.AvailabilityClasses, &pbType.AvailabilityClass{
+					NumberOfBookableSeats: c.NumberOfBookableSeats,
+					Class:                 c.Class,
+					ClosedStatus:          c.ClosedStatus,
+				})
+			}
+		}
+		out.Segments = append(out.Segments, s)
+	}
+	return out
+}
+
+func encodeFlightOffer(offer *sv.FlightOffer) *pbType.FlightOffer {
+	out := &pbType.FlightOffer{
+		Type:                     offer.Type,
+		Id:                       offer.Id,
+		Source:                   offer.Source,
+		InstantTicketingRequired: offer.InstantTicketingRequired,
+		NonHomogeneous:           offer.NonHomogeneous,
+		OneWay:                   offer.OneWay,
+		LastTicketingDate:        offer.LastTicketingDate,
+		NumberOfBookableSeats:    offer.NumberOfBookableSeats,
+		Price:                    encodeOfferPrice(offer.Price),
+		ValidatingAirlineCodes:   offer.ValidatingAirlineCodes,
+	}
+	if o := offer.PricingOptions; o != nil {
+		out.PricingOptions = &pbType.PricingOptions{
+			FareType:                o.FareType,
+			IncludedCheckedBagsOnly: o.IncludedCheckedBagsOnly,
+		}
+	}
+
+	for _, itinerary := range offer.Itineraries {
+		if itinerary == nil {
+			continue
+		}
+
+		var segments []*pbType.OfferSegment
+		for _, segment := range itinerary.Segments {
+			if segment != nil {
+				segments = append(segments, encodeOfferSegment(segment))
+			}
+		}
+		out.Itineraries = append(out.Itineraries, &pbType.Itinerary{
+			Duration: itinerary.Duration,
+			Segments: segments,
+		})
+	}
+
+	for _, tp := range offer.TravelerPricings {
+		if tp == nil {
+			continue
+		}
+
+		var details []*pbType.FareDetailsBySegment
+		for _, d := range tp.FareDetailsBySegment {
+			if d == nil {
+				continue
+			}
+
+			detail := &pbType.FareDetailsBySegment{
+				SegmentId:        d.SegmentId,
+				Cabin:            d.Cabin,
+				FareBasis:        d.FareBasis,
+				BrandedFare:      d.BrandedFare,
+				BrandedFareLabel: d.BrandedFareLabel,
+				Class:            d.Class,
+			}
+			for _, a := range d.Amenities {
+				if a == nil {
+					continue
+				}
+
+				amenity := &pbType.Amenity{
+					Description:  a.Description,
+					IsChargeable: a.IsChargeable,
+					AmenityType:  a.AmenityType,
+				}
+				if a.AmenityProvider != nil {
+					amenity.AmenityProvider = &pbType.AmenityProvider{Name: a.AmenityProvider.Name}
+				}
+				detail.Amenities = append(detail.Amenities, amenity)
+			}
+			if b := d.IncludedCheckedBags; b != nil {
+				detail.IncludedCheckedBags = &pbType.BaggageAllowance{
+					Quantity:   b.Quantity,
+					Weight:     b.Weight,
+					WeightUnit: b.WeightUnit,
+				}
+			}
+			details = append(details, detail)
+		}
+		out.TravelerPricings = append(out.TravelerPricings, &pbType.TravelerPricing{
+			TravelerId:           tp.TravelerId,
+			FareOption:           tp.FareOption,
+			TravelerType:         tp.TravelerType,
+			AssociatedAdultId:    tp.AssociatedAdultId,
+			Price:                encodeOfferPrice(tp.Price),
+			FareDetailsBySegment: details,
+		})
+	}
+	return out
+}
+
+func encodeOfferSegment(segment *sv.OfferSegment) *pbType.OfferSegment {
+	out := &pbType.OfferSegment{
+		Departure:       encodeFlightEndPoint(segment.Departure),
+		Arrival:         encodeFlightEndPoint(segment.Arrival),
+		CarrierCode:     segment.CarrierCode,
+		Number:          segment.Number,
+		Duration:        segment.Duration,
+		Id:              segment.Id,
+		NumberOfStops:   segment.NumberOfStops,
+		BlacklistedInEU: segment.BlacklistedInEU,
+	}
+	if segment.Aircraft != nil {
+		out.Aircraft = &pbType.Aircraft{Code: segment.Aircraft.Code}
+	}
+	if segment.Operating != nil {
+		out.Operating = &pbType.Operating{
+			CarrierCode: segment.Operating.CarrierCode,
+			Number:      segment.Operating.Number,
+		}
+	}
+	return out
+}
+
+func encodeFlightEndPoint(e *sv.FlightEndPoint) *pbType.FlightEndPoint {
+	if e == nil {
+		return nil
+	}
+	return &pbType.FlightEndPoint{
+		IataCode: e.IataCode,
+		Terminal: e.Terminal,
+		At:       e.At,
+	}
+}
+
+func encodeOfferPrice(p *sv.OfferPrice) *pbType.OfferPrice {
+	if p == nil {
+		return nil
+	}
+
+	price := &pbType.OfferPrice{
+		Currency:        p.Currency,
+		Total:           p.Total,
+		Base:            p.Base,
+		GrandTotal:      p.GrandTotal,
+		RefundableTaxes: p.RefundableTaxes,
+	}
+	for _, fee := range p.Fees {
+		if fee != nil {
+			price.Fees = append(price.Fees, &pbType.Fee{Amount: fee.Amount, Type: fee.Type})
+		}
+	}
+	for _, tax := range p.Taxes {
+		if tax != nil {
+			price.Taxes = append(price.Taxes, &pbType.Tax{Amount: tax.Amount, Code: tax.Code})
+		}
+	}
+	for _, service := range p.AdditionalServices {
+		if service != nil {
+			price.AdditionalServices = append(price.AdditionalServices, &pbType.Fee{Amount: service.Amount, Type: service.Type})
+		}
+	}
+	return price
+}
+
+func encodeMeta(m *sv.Meta) *pbType.Meta {
+	if m == nil {
+		return nil
+	}
+
+	meta := &pbType.Meta{
+		Currency: m.Currency,
+		Count:    m.Count,
+	}
+	if l := m.Links; l != nil {
+		meta.Links = &pbType.Links{
+			Self:               l.Self,
+			Next:               l.Next,
+			Last:               l.Last,
+			FlightDates:        l.FlightDates,
+			FlightOffers:       l.FlightOffers,
+			FlightDestinations: l.FlightDestinations,
+		}
+	}
+	if d := m.Defaults; d != nil {
+		meta.Defaults = &pbType.Defaults{
+			Adults:        d.Adults,
+			NonStop:       d.NonStop,
+			DepartureDate: d.DepartureDate,
+			OneWay:        d.OneWay,
+			Duration:      d.Duration,
+			ViewBy:        d.ViewBy,
+		}
+	}
+	return meta
+}
+
+func encodeErrorWarnings(ews []*sv.ErrorWarning) []*pbType.ErrorWarning {
+	var out []*pbType.ErrorWarning
+	for _, ew := range ews {
+		if ew == nil {
+			continue
+		}
+
+		e := &pbType.ErrorWarning{
+			Title:  ew.Title,
+			Status: ew.Status,
+			Code:   ew.Code,
+			Detail: ew.Detail,
+		}
+		if ew.Source != nil {
+			e.Source = &pbType.Source{
+				Example:   ew.Source.Example,
+				Parameter: ew.Source.Parameter,
+				Pointer:   ew.Source.Pointer,
+			}
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
 func decodeFlightLowFareSearchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req, ok := grpcReq.(*pbFunc.FlightLowFareSearchRequest)
 	if !ok {
@@ -684,3 +1599,156 @@ func decodeAirlineCodeLookupRequest(_ context.Context, grpcReq interface{}) (int
 		AirlineCodes: req.AirlineCodes,
 	}, nil
 }
+
+func decodeFlightOffersSearchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req, ok := grpcReq.(*pbFunc.FlightOffersSearchRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightOffersSearchRequest>")
+	}
+
+	return &sv.FlightOffersSearchRequest{
+		OriginLocationCode:      req.OriginLocationCode,
+		DestinationLocationCode: req.DestinationLocationCode,
+		DepartureDate:           req.DepartureDate,
+		ReturnDate:              req.ReturnDate,
+		Adults:                  req.Adults,
+		Children:                req.Children,
+		Infants:                 req.Infants,
+		TravelClass:             req.TravelClass,
+		IncludedAirlineCodes:    req.IncludedAirlineCodes,
+		ExcludedAirlineCodes:    req.ExcludedAirlineCodes,
+		NonStop:                 req.NonStop,
+		CurrencyCode:            req.CurrencyCode,
+		MaxPrice:                req.MaxPrice,
+		Max:                     req.Max,
+		OriginDestinations:      decodeOriginDestinations(req.OriginDestinations),
+	}, nil
+}
+
+func decodeOriginDestinations(ods []*pbFunc.OriginDestination) []*sv.OriginDestination {
+	var out []*sv.OriginDestination
+	for _, od := range ods {
+		if od == nil {
+			continue
+		}
+		out = append(out, &sv.OriginDestination{
+			Id:                      od.Id,
+			OriginLocationCode:      od.OriginLocationCode,
+			DestinationLocationCode: od.DestinationLocationCode,
+			DepartureDate:           od.DepartureDate,
+			DepartureTime:           od.DepartureTime,
+		})
+	}
+	return out
+}
+
+func decodeFlightOffersPriceRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req, ok := grpcReq.(*pbFunc.FlightOffersPriceRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightOffersPriceRequest>")
+	}
+
+	var offers []*sv.FlightOffer
+	for _, offer := range req.FlightOffers {
+		if offer != nil {
+			offers = append(offers, decodeFlightOffer(offer))
+		}
+	}
+
+	return &sv.FlightOffersPriceRequest{
+		FlightOffers: offers,
+		Include:      req.Include,
+	}, nil
+}
+
+func decodeCreateFlightOrderRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req, ok := grpcReq.(*pbFunc.CreateFlightOrderRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <CreateFlightOrderRequest>")
+	}
+
+	var offers []*sv.FlightOffer
+	for _, offer := range req.FlightOffers {
+		if offer != nil {
+			offers = append(offers, decodeFlightOffer(offer))
+		}
+	}
+
+	return &sv.CreateFlightOrderRequest{
+		FlightOffers:   offers,
+		Travelers:      decodeTravelers(req.Travelers),
+		Contacts:       decodeContacts(req.Contacts),
+		IdempotencyKey: req.IdempotencyKey,
+	}, nil
+}
+
+func decodeGetFlightOrderRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req, ok := grpcReq.(*pbFunc.GetFlightOrderRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <GetFlightOrderRequest>")
+	}
+	return &sv.GetFlightOrderRequest{
+		FlightOrderId: req.FlightOrderId,
+	}, nil
+}
+
+func decodeCancelFlightOrderRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req, ok := grpcReq.(*pbFunc.CancelFlightOrderRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <CancelFlightOrderRequest>")
+	}
+	return &sv.CancelFlightOrderRequest{
+		FlightOrderId: req.FlightOrderId,
+	}, nil
+}
+
+func decodeSeatMapDisplayRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req, ok := grpcReq.(*pbFunc.SeatMapDisplayRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <SeatMapDisplayRequest>")
+	}
+
+	var offers []*sv.FlightOffer
+	for _, offer := range req.FlightOffers {
+		if offer != nil {
+			offers = append(offers, decodeFlightOffer(offer))
+		}
+	}
+
+	return &sv.SeatMapDisplayRequest{
+		FlightOffers:  offers,
+		FlightOrderId: req.FlightOrderId,
+	}, nil
+}
+
+func decodeBrandedFaresUpsellRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req, ok := grpcReq.(*pbFunc.BrandedFaresUpsellRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <BrandedFaresUpsellRequest>")
+	}
+
+	var offers []*sv.FlightOffer
+	for _, offer := range req.FlightOffers {
+		if offer != nil {
+			offers = append(offers, decodeFlightOffer(offer))
+		}
+	}
+
+	return &sv.BrandedFaresUpsellRequest{
+		FlightOffers: offers,
+	}, nil
+}
+
+func decodeFlightAvailabilitiesSearchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req, ok := grpcReq.(*pbFunc.FlightAvailabilitiesSearchRequest)
+	if !ok {
+		return nil, errors.New("your request is not of type <FlightAvailabilitiesSearchRequest>")
+	}
+	return &sv.FlightAvailabilitiesSearchRequest{
+		OriginDestinations:   decodeOriginDestinations(req.OriginDestinations),
+		Adults:               req.Adults,
+		Children:             req.Children,
+		IncludedAirlineCodes: req.IncludedAirlineCodes,
+		ExcludedAirlineCodes: req.ExcludedAirlineCodes,
+	}, nil
+}
@@ -0,0 +1,480 @@
+package transports
+
+import (
+	"amadeus-go/pkg/auth"
+	"amadeus-go/pkg/endpoints"
+	"amadeus-go/pkg/logging"
+	sv "amadeus-go/pkg/services"
+	"amadeus-go/pkg/tracing"
+
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	httpTransport "github.com/go-kit/kit/transport/http"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// httpRoute is a REST route of the gateway and the endpoint behind it. The
+// query parameters of a GET are the fields of Request, named in lower camel
+// case, and the body of a POST is the JSON of Request, so decoding and the
+// OpenAPI document both follow the structs of ds.go.
+type httpRoute struct {
+	Method   string
+	Path     string
+	Name     string
+	Summary  string
+	Endpoint endpoint.Endpoint
+	Request  interface{}
+	Response interface{}
+	Required []string
+}
+
+func httpRoutes(endpoints *endpoints.AmadeusEndpointSet) []httpRoute {
+	return []httpRoute{
+		{
+			http.MethodGet, "/v1/flights/low-fare", "FlightLowFareSearch",
+			"I know where I want to fly, the dates and duration, what are the best flight deals?",
+			endpoints.FlightLowFareSearchEndpoint, &sv.FlightLowFareSearchRequest{}, &sv.Response{},
+			[]string{"origin", "destination", "departureDate"},
+		},
+		{
+			http.MethodGet, "/v1/flights/inspiration", "FlightInspirationSearch",
+			"Where can I fly from/to Delhi in the next months for $400?",
+			endpoints.FlightInspirationSearchEndpoint, &sv.FlightInspirationSearchRequest{}, &sv.Response{},
+			[]string{"origin"},
+		},
+		{
+			http.MethodGet, "/v1/flights/cheapest-dates", "FlightCheapestDateSearch",
+			"When is the cheapest date to fly to San Francisco from Miami?",
+			endpoints.FlightCheapestDateSearchEndpoint, &sv.FlightCheapestDateSearchRequest{}, &sv.Response{},
+			[]string{"origin", "destination"},
+		},
+		{
+			http.MethodGet, "/v1/flights/most-searched-destinations", "FlightMostSearchedDestinations",
+			"Which were the most searched destinations from Nice in June 2017?",
+			endpoints.FlightMostSearchedDestinationsEndpoint, &sv.FlightMostSearchedDestinationsRequest{}, &sv.Response{},
+			[]string{"originCityCode", "searchPeriod", "marketCountryCode"},
+		},
+		{
+			http.MethodGet, "/v1/flights/most-searched-by-destination", "FlightMostSearchedByDestination",
+			"How many people searched for flights from Madrid to Nice in June 2017?",
+			endpoints.FlightMostSearchedByDestinationEndpoint, &sv.FlightMostSearchedByDestinationRequest{}, &sv.Response{},
+			[]string{"originCityCode", "destinationCityCode", "searchPeriod", "marketCountryCode"},
+		},
+		{
+			http.MethodGet, "/v1/flights/check-in-links", "FlightCheckInLinks",
+			"What is the URL to my online check-in?",
+			endpoints.FlightCheckInLinksEndpoint, &sv.FlightCheckInLinksRequest{}, &sv.Response{},
+			[]string{"airlineCode"},
+		},
+		{
+			http.MethodGet, "/v1/flights/most-traveled-destinations", "FlightMostTraveledDestinations",
+			"Where were people flying to the most from London in September 2017?",
+			endpoints.FlightMostTraveledDestinationsEndpoint, &sv.FlightMostTraveledDestinationsRequest{}, &sv.Response{},
+			[]string{"originCityCode", "period"},
+		},
+		{
+			http.MethodGet, "/v1/flights/most-booked-destinations", "FlightMostBookedDestinations",
+			"Where were the most number of bookings made to from Bangalore last November?",
+			endpoints.FlightMostBookedDestinationsEndpoint, &sv.FlightMostBookedDestinationsRequest{}, &sv.Response{},
+			[]string{"originCityCode", "period"},
+		},
+		{
+			http.MethodGet, "/v1/flights/busiest-period", "FlightBusiestTravelingPeriod",
+			"What was the busiest travel period for New York, based on either arrivals or departures?",
+			endpoints.FlightBusiestTravelingPeriodEndpoint, &sv.FlightBusiestTravelingPeriodRequest{}, &sv.Response{},
+			[]string{"cityCode", "period"},
+		},
+		{
+			http.MethodGet, "/v1/airports/nearest", "AirportNearestRelevant",
+			"What relevant airports are there around a specific location?",
+			endpoints.AirportNearestRelevantEndpoint, &sv.AirportNearestRelevantRequest{}, &sv.Response{},
+			[]string{"latitude", "longitude"},
+		},
+		{
+			http.MethodGet, "/v1/locations", "AirportAndCitySearch",
+			"Which cities and/or airports start with 'PA' characters?",
+			endpoints.AirportAndCitySearchEndpoint, &sv.AirportAndCitySearchRequest{}, &sv.Response{},
+			[]string{"subType", "keyword"},
+		},
+		{
+			http.MethodGet, "/v1/airlines", "AirlineCodeLookup",
+			"Which airline has IATA code BA?",
+			endpoints.AirlineCodeLookupEndpoint, &sv.AirlineCodeLookupRequest{}, &sv.Response{},
+			[]string{"airlineCodes"},
+		},
+		{
+			http.MethodGet, "/v1/flights/offers", "FlightOffersSearch",
+			"What are the cheapest flights from Madrid to New York on a date, for two adults and a child in economy?",
+			endpoints.FlightOffersSearchEndpoint, &sv.FlightOffersSearchRequest{}, &sv.FlightOffersResponse{},
+			[]string{"originLocationCode", "destinationLocationCode", "departureDate", "adults"},
+		},
+		{
+			http.MethodPost, "/v1/flights/offers", "FlightOffersSearch",
+			"What are the cheapest flights for a trip of several legs?",
+			endpoints.FlightOffersSearchEndpoint, &sv.FlightOffersSearchRequest{}, &sv.FlightOffersResponse{},
+			[]string{"originDestinations", "adults"},
+		},
+		{
+			http.MethodPost, "/v1/flights/offers/pricing", "FlightOffersPrice",
+			"Is this flight offer still available at this price, and what would a checked bag cost?",
+			endpoints.FlightOffersPriceEndpoint, &sv.FlightOffersPriceRequest{}, &sv.FlightOffersPriceResponse{},
+			[]string{"flightOffers"},
+		},
+		{
+			http.MethodPost, "/v1/flight-orders", "CreateFlightOrder",
+			"Book these priced offers for these travelers.",
+			endpoints.CreateFlightOrderEndpoint, &sv.CreateFlightOrderRequest{}, &sv.FlightOrderResponse{},
+			[]string{"flightOffers", "travelers"},
+		},
+		{
+			http.MethodGet, "/v1/flight-orders", "GetFlightOrder",
+			"What is the state of this booking?",
+			endpoints.GetFlightOrderEndpoint, &sv.GetFlightOrderRequest{}, &sv.FlightOrderResponse{},
+			[]string{"flightOrderId"},
+		},
+		{
+			http.MethodDelete, "/v1/flight-orders", "CancelFlightOrder",
+			"Cancel this booking.",
+			endpoints.CancelFlightOrderEndpoint, &sv.CancelFlightOrderRequest{}, &sv.CancelFlightOrderResponse{},
+			[]string{"flightOrderId"},
+		},
+		{
+			http.MethodGet, "/v1/flights/seatmaps", "SeatMapDisplay",
+			"Which seats are still free on the flights of this booking?",
+			endpoints.SeatMapDisplayEndpoint, &sv.SeatMapDisplayRequest{}, &sv.SeatMapResponse{},
+			[]string{"flightOrderId"},
+		},
+		{
+			http.MethodPost, "/v1/flights/seatmaps", "SeatMapDisplay",
+			"Which seats could I pick on the flights of this offer, and at what price?",
+			endpoints.SeatMapDisplayEndpoint, &sv.SeatMapDisplayRequest{}, &sv.SeatMapResponse{},
+			[]string{"flightOffers"},
+		},
+		{
+			http.MethodPost, "/v1/flights/offers/upselling", "BrandedFaresUpsell",
+			"What would this offer cost in the other fare families, and what comes with each?",
+			endpoints.BrandedFaresUpsellEndpoint, &sv.BrandedFaresUpsellRequest{}, &sv.FlightOffersResponse{},
+			[]string{"flightOffers"},
+		},
+		{
+			http.MethodPost, "/v1/flights/availabilities", "FlightAvailabilitiesSearch",
+			"How many seats are left in each booking class of these flights?",
+			endpoints.FlightAvailabilitiesSearchEndpoint, &sv.FlightAvailabilitiesSearchRequest{}, &sv.FlightAvailabilitiesResponse{},
+			[]string{"originDestinations", "adults"},
+		},
+	}
+}
+
+// NewHTTPHandler serves the endpoints as GET and DELETE routes taking their
+// parameters from the query string, or POST routes taking them from a JSON body, and
+// answering with the JSON of the response of the service. Failures are
+// answered in the shape Amadeus uses: {"errors": [...]}. The OpenAPI document
+// of the routes is served at /openapi.json.
+func NewHTTPHandler(endpoints *endpoints.AmadeusEndpointSet, logger log.Logger) http.Handler {
+	options := []httpTransport.ServerOption{
+		httpTransport.ServerErrorEncoder(encodeHTTPError),
+		httpTransport.ServerErrorLogger(level.Error(log.With(logger, "layer", "http"))),
+		httpTransport.ServerBefore(credentialsFromHeader, traceFromHeader),
+	}
+
+	routes := httpRoutes(endpoints)
+
+	// routes may share a path with another method
+	handlers := make(map[string]map[string]http.Handler)
+	for _, route := range routes {
+		decode := decodeHTTPQuery(route)
+		if route.Method == http.MethodPost {
+			decode = decodeHTTPBody(route)
+		}
+
+		if handlers[route.Path] == nil {
+			handlers[route.Path] = make(map[string]http.Handler)
+		}
+		handlers[route.Path][route.Method] = httpTransport.NewServer(
+			route.Endpoint,
+			decode,
+			encodeHTTPResponse,
+			options...,
+		)
+	}
+
+	mux := http.NewServeMux()
+	for path, byMethod := range handlers {
+		mux.Handle(path, allowMethods(byMethod))
+	}
+	mux.Handle("/openapi.json", allowMethods(map[string]http.Handler{http.MethodGet: openAPIHandler(routes)}))
+
+	return withRequestID(mux)
+}
+
+// withRequestID gives every request the ID its client sent in X-Request-Id,
+// or a new one, and answers with it in the same header.
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-Id")
+		if id == "" {
+			id = logging.NewRequestID()
+		}
+		w.Header().Set("X-Request-Id", id)
+
+		next.ServeHTTP(w, r.WithContext(logging.WithRequestID(r.Context(), id)))
+	})
+}
+
+// credentialsFromHeader puts the API key or JWT of a request in the context,
+// from either the Authorization (as a bearer token) or X-API-Key header.
+func credentialsFromHeader(ctx context.Context, r *http.Request) context.Context {
+	if credentials := auth.ParseAuthorization(r.Header.Get("Authorization")); credentials != "" {
+		return auth.WithCredentials(ctx, credentials)
+	}
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		return auth.WithCredentials(ctx, key)
+	}
+	return ctx
+}
+
+// traceFromHeader continues the trace of the caller, when it sent one.
+func traceFromHeader(ctx context.Context, r *http.Request) context.Context {
+	return tracing.Propagator.Extract(ctx, propagation.HeaderCarrier(r.Header))
+}
+
+// allowMethods hands requests to the handler of their method, and answers
+// the others with 405 Method Not Allowed.
+func allowMethods(handlers map[string]http.Handler) http.Handler {
+	var allowed []string
+	for method := range handlers {
+		allowed = append(allowed, method)
+	}
+	sort.Strings(allowed)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next, ok := handlers[r.Method]
+		if !ok {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			writeHTTPErrors(w, http.StatusMethodNotAllowed, []*sv.ErrorWarning{{
+				Status: http.StatusMethodNotAllowed,
+				Title:  http.StatusText(http.StatusMethodNotAllowed),
+			}})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// =============================================================================
+func encodeHTTPResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(response)
+}
+
+// encodeHTTPError answers with the status matching the typed error, and
+// with the errors Amadeus listed when there are any.
+func encodeHTTPError(_ context.Context, err error, w http.ResponseWriter) {
+	code := http.StatusInternalServerError
+	var errs []*sv.ErrorWarning
+
+	switch e := err.(type) {
+	case *sv.ValidationError:
+		code, errs = http.StatusBadRequest, e.Errors
+	case *sv.NotFoundError:
+		code, errs = http.StatusNotFound, e.Errors
+	case *sv.ConflictError:
+		code, errs = http.StatusConflict, e.Errors
+	case *sv.RateLimitError:
+		code, errs = http.StatusTooManyRequests, e.Errors
+		if e.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int((e.RetryAfter+time.Second-1)/time.Second)))
+		}
+	case *sv.UnavailableError:
+		code, errs = http.StatusServiceUnavailable, e.Errors
+	case *sv.AuthError:
+		// these are the credentials of this service, not of the caller
+		code = http.StatusBadGateway
+	case *sv.AmadeusError:
+		code, errs = http.StatusBadGateway, e.Errors
+	default:
+		switch err {
+		case auth.ErrUnauthenticated:
+			code = http.StatusUnauthorized
+			w.Header().Set("WWW-Authenticate", "Bearer")
+		case auth.ErrPermissionDenied:
+			code = http.StatusForbidden
+		case context.DeadlineExceeded:
+			code = http.StatusGatewayTimeout
+		case context.Canceled:
+			// the caller is gone; this is only seen in the logs
+			code = 499
+		}
+	}
+
+	if len(errs) == 0 {
+		errs = []*sv.ErrorWarning{{
+			Status: int32(code),
+			Title:  http.StatusText(code),
+			Detail: err.Error(),
+		}}
+	}
+	writeHTTPErrors(w, code, errs)
+}
+
+func writeHTTPErrors(w http.ResponseWriter, code int, errs []*sv.ErrorWarning) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(struct {
+		Errors []*sv.ErrorWarning `json:"errors"`
+	}{errs})
+}
+
+// requestType returns the type of the requests of route, checking that it
+// has the fields the route requires.
+func requestType(route httpRoute) reflect.Type {
+	typ := reflect.TypeOf(route.Request).Elem()
+	for _, name := range route.Required {
+		if _, ok := typ.FieldByName(strings.ToUpper(name[:1]) + name[1:]); !ok {
+			panic(fmt.Sprintf("route %s requires %s, which %s doesn't have", route.Path, name, typ.Name()))
+		}
+	}
+	return typ
+}
+
+// invalidParameters collects the parameters of a request that are missing or
+// invalid.
+type invalidParameters []*sv.ErrorWarning
+
+func (errs *invalidParameters) add(name, title, detail string) {
+	*errs = append(*errs, &sv.ErrorWarning{
+		Status: http.StatusBadRequest,
+		Title:  title,
+		Detail: fmt.Sprintf("%s: %s", name, detail),
+		Source: &sv.Source{Parameter: name},
+	})
+}
+
+// err reports all the parameters together in one ValidationError, if there
+// are any.
+func (errs invalidParameters) err(route httpRoute) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &sv.ValidationError{AmadeusError: &sv.AmadeusError{
+		Route:  route.Name,
+		Status: http.StatusBadRequest,
+		Errors: errs,
+	}}
+}
+
+// decodeHTTPQuery returns a decoder filling a new request of the route's
+// type from the query string. Parameters that are missing or can't be parsed
+// are reported together in one ValidationError.
+func decodeHTTPQuery(route httpRoute) httpTransport.DecodeRequestFunc {
+	typ := requestType(route)
+
+	return func(_ context.Context, r *http.Request) (interface{}, error) {
+		query := r.URL.Query()
+		request := reflect.New(typ)
+
+		var errs invalidParameters
+		for _, name := range route.Required {
+			if query.Get(name) == "" {
+				errs.add(name, "MANDATORY DATA MISSING", "this parameter is required")
+			}
+		}
+
+		for i := 0; i < typ.NumField(); i++ {
+			if !queryable(typ.Field(i)) {
+				continue
+			}
+			name := queryName(typ.Field(i))
+			v := query.Get(name)
+			if v == "" {
+				continue
+			}
+
+			field := request.Elem().Field(i)
+			switch field.Kind() {
+			case reflect.String:
+				field.SetString(v)
+			case reflect.Slice:
+				// lists are comma separated, as Amadeus takes them
+				field.Set(reflect.ValueOf(strings.Split(v, ",")))
+			case reflect.Int, reflect.Int32, reflect.Int64:
+				n, err := strconv.ParseInt(v, 10, field.Type().Bits())
+				if err != nil {
+					errs.add(name, "INVALID FORMAT", "expected an integer")
+				}
+				field.SetInt(n)
+			case reflect.Float32, reflect.Float64:
+				f, err := strconv.ParseFloat(v, field.Type().Bits())
+				if err != nil {
+					errs.add(name, "INVALID FORMAT", "expected a number")
+				}
+				field.SetFloat(f)
+			case reflect.Bool:
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					errs.add(name, "INVALID FORMAT", "expected true or false")
+				}
+				field.SetBool(b)
+			}
+		}
+
+		if err := errs.err(route); err != nil {
+			return nil, err
+		}
+		return request.Interface(), nil
+	}
+}
+
+// decodeHTTPBody returns a decoder filling a new request of the route's type
+// from the JSON body. A body that can't be parsed and required fields left
+// empty are reported in one ValidationError.
+func decodeHTTPBody(route httpRoute) httpTransport.DecodeRequestFunc {
+	typ := requestType(route)
+
+	return func(_ context.Context, r *http.Request) (interface{}, error) {
+		request := reflect.New(typ)
+
+		var errs invalidParameters
+		if err := json.NewDecoder(r.Body).Decode(request.Interface()); err != nil {
+			errs.add("body", "INVALID FORMAT", err.Error())
+			return nil, errs.err(route)
+		}
+
+		for _, name := range route.Required {
+			if request.Elem().FieldByName(strings.ToUpper(name[:1]) + name[1:]).IsZero() {
+				errs.add(name, "MANDATORY DATA MISSING", "this parameter is required")
+			}
+		}
+
+		if err := errs.err(route); err != nil {
+			return nil, err
+		}
+		return request.Interface(), nil
+	}
+}
+
+// queryName is the name of the query parameter for a field of a request:
+// the field name in lower camel case, as Amadeus names its parameters.
+func queryName(field reflect.StructField) string {
+	return strings.ToLower(field.Name[:1]) + field.Name[1:]
+}
+
+// queryable tells whether a field of a request can be given in the query
+// string: lists only can when they are lists of strings.
+func queryable(field reflect.StructField) bool {
+	if field.Type.Kind() == reflect.Slice {
+		return field.Type.Elem().Kind() == reflect.String
+	}
+	return true
+}
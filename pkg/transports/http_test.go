@@ -0,0 +1,228 @@
+package transports
+
+import (
+	"amadeus-go/pkg/auth"
+	sv "amadeus-go/pkg/services"
+
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+)
+
+// httpErrors is the body of a failed call.
+type httpErrors struct {
+	Errors []*sv.ErrorWarning `json:"errors"`
+}
+
+func TestHTTPDecoding(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		method string
+		target string
+		body   string
+		// the request the endpoint should get, or the parameters it should
+		// be refused for
+		want       interface{}
+		wantStatus int
+		wantParams []string
+	}{
+		{
+			name:       "query",
+			method:     http.MethodGet,
+			target:     "/v1/airlines?airlineCodes=BA,AF",
+			want:       &sv.AirlineCodeLookupRequest{AirlineCodes: "BA,AF"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "typed query",
+			method: http.MethodGet,
+			target: "/v1/flights/offers?originLocationCode=MAD&destinationLocationCode=JFK&departureDate=2026-11-01&adults=2&nonStop=true&includedAirlineCodes=IB,BA",
+			want: &sv.FlightOffersSearchRequest{
+				OriginLocationCode:      "MAD",
+				DestinationLocationCode: "JFK",
+				DepartureDate:           "2026-11-01",
+				Adults:                  2,
+				NonStop:                 true,
+				IncludedAirlineCodes:    []string{"IB", "BA"},
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing parameter",
+			method:     http.MethodGet,
+			target:     "/v1/airlines",
+			wantStatus: http.StatusBadRequest,
+			wantParams: []string{"airlineCodes"},
+		},
+		{
+			name:       "every invalid parameter at once",
+			method:     http.MethodGet,
+			target:     "/v1/flights/offers?originLocationCode=MAD&destinationLocationCode=JFK&departureDate=2026-11-01&adults=two&nonStop=maybe",
+			wantStatus: http.StatusBadRequest,
+			wantParams: []string{"adults", "nonStop"},
+		},
+		{
+			name:       "body",
+			method:     http.MethodPost,
+			target:     "/v1/flights/offers",
+			body:       `{"adults":1,"originDestinations":[{"id":"1","originLocationCode":"MAD"}]}`,
+			want:       &sv.FlightOffersSearchRequest{Adults: 1, OriginDestinations: []*sv.OriginDestination{{Id: "1", OriginLocationCode: "MAD"}}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "body that isn't JSON",
+			method:     http.MethodPost,
+			target:     "/v1/flights/offers",
+			body:       `{"adults":`,
+			wantStatus: http.StatusBadRequest,
+			wantParams: []string{"body"},
+		},
+		{
+			name:       "body missing fields",
+			method:     http.MethodPost,
+			target:     "/v1/flights/offers",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantParams: []string{"originDestinations", "adults"},
+		},
+		{
+			name:       "method not allowed",
+			method:     http.MethodPut,
+			target:     "/v1/flight-orders",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var last interface{}
+			handler := NewHTTPHandler(stubEndpoints(&last), log.NewNopLogger())
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body)))
+
+			if w.Code != tc.wantStatus {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tc.wantStatus, w.Body)
+			}
+			if tc.want != nil && !reflect.DeepEqual(last, tc.want) {
+				t.Errorf("the endpoint got %+v, want %+v", last, tc.want)
+			}
+			if w.Code == http.StatusOK {
+				return
+			}
+
+			if last != nil {
+				t.Error("a refused request got to the endpoint")
+			}
+			var body httpErrors
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil || len(body.Errors) == 0 {
+				t.Fatalf("got body %s, want the errors", w.Body)
+			}
+			var params []string
+			for _, e := range body.Errors {
+				if e.Source != nil {
+					params = append(params, e.Source.Parameter)
+				}
+			}
+			if len(params) != len(tc.wantParams) || (len(params) > 0 && !reflect.DeepEqual(params, tc.wantParams)) {
+				t.Errorf("got errors for %v, want %v", params, tc.wantParams)
+			}
+		})
+	}
+}
+
+func TestHTTPMethodNotAllowedListsMethods(t *testing.T) {
+	var last interface{}
+	handler := NewHTTPHandler(stubEndpoints(&last), log.NewNopLogger())
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/v1/flight-orders", nil))
+	if allow := w.Header().Get("Allow"); allow != "DELETE, GET, POST" {
+		t.Errorf("got Allow %q", allow)
+	}
+}
+
+func TestHTTPRequestID(t *testing.T) {
+	var last interface{}
+	handler := NewHTTPHandler(stubEndpoints(&last), log.NewNopLogger())
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/airlines?airlineCodes=BA", nil)
+	req.Header.Set("X-Request-Id", "abc")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if id := w.Header().Get("X-Request-Id"); id != "abc" {
+		t.Errorf("got request ID %q, want the caller's", id)
+	}
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/airlines?airlineCodes=BA", nil))
+	if w.Header().Get("X-Request-Id") == "" {
+		t.Error("a request without an ID wasn't given one")
+	}
+}
+
+func TestHTTPErrors(t *testing.T) {
+	base := func() *sv.AmadeusError {
+		return &sv.AmadeusError{Route: "AirlineCodeLookup", Cause: errors.New("failed")}
+	}
+	listed := &sv.AmadeusError{
+		Route:  "AirlineCodeLookup",
+		Status: http.StatusBadRequest,
+		Errors: []*sv.ErrorWarning{{Status: 400, Code: 477, Title: "INVALID FORMAT", Source: &sv.Source{Parameter: "airlineCodes"}}},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantHeader map[string]string
+		// the title of the first error of the body
+		wantTitle string
+	}{
+		{"validation", &sv.ValidationError{AmadeusError: listed}, http.StatusBadRequest, nil, "INVALID FORMAT"},
+		{"not found", &sv.NotFoundError{AmadeusError: base()}, http.StatusNotFound, nil, "Not Found"},
+		{"conflict", &sv.ConflictError{AmadeusError: base()}, http.StatusConflict, nil, "Conflict"},
+		{"rate limited", &sv.RateLimitError{AmadeusError: base(), RetryAfter: time.Millisecond * 1500}, http.StatusTooManyRequests, map[string]string{"Retry-After": "2"}, "Too Many Requests"},
+		{"unavailable", &sv.UnavailableError{AmadeusError: base()}, http.StatusServiceUnavailable, nil, "Service Unavailable"},
+		{"credentials of the service", &sv.AuthError{AmadeusError: listed}, http.StatusBadGateway, nil, "Bad Gateway"},
+		{"other amadeus error", base(), http.StatusBadGateway, nil, "Bad Gateway"},
+		{"unauthenticated", auth.ErrUnauthenticated, http.StatusUnauthorized, map[string]string{"WWW-Authenticate": "Bearer"}, "Unauthorized"},
+		{"permission denied", auth.ErrPermissionDenied, http.StatusForbidden, nil, "Forbidden"},
+		{"deadline", context.DeadlineExceeded, http.StatusGatewayTimeout, nil, "Gateway Timeout"},
+		{"unknown", errors.New("boom"), http.StatusInternalServerError, nil, "Internal Server Error"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var last interface{}
+			set := stubEndpoints(&last)
+			set.AirlineCodeLookupEndpoint = func(context.Context, interface{}) (interface{}, error) {
+				return nil, tc.err
+			}
+			handler := NewHTTPHandler(set, log.NewNopLogger())
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/airlines?airlineCodes=BA", nil))
+
+			if w.Code != tc.wantStatus {
+				t.Fatalf("got status %d, want %d", w.Code, tc.wantStatus)
+			}
+			for name, want := range tc.wantHeader {
+				if got := w.Header().Get(name); got != want {
+					t.Errorf("got %s %q, want %q", name, got, want)
+				}
+			}
+			var body httpErrors
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil || len(body.Errors) == 0 {
+				t.Fatalf("got body %s, want the errors", w.Body)
+			}
+			if body.Errors[0].Title != tc.wantTitle {
+				t.Errorf("got error %q, want %q", body.Errors[0].Title, tc.wantTitle)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package transports
+
+import (
+	"amadeus-go/pkg/logging"
+	srv "amadeus-go/pkg/services"
+
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+)
+
+func clientLoggingMiddleware(logger log.Logger, methodName string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			defer func(begin time.Time) {
+				_ = logging.ByError(logging.WithContext(logger, ctx), err).Log(
+					"layer", "client",
+					"method", methodName,
+					"input", request,
+					"error", err,
+					"took", time.Since(begin),
+				)
+			}(time.Now())
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// clientDeadlineMiddleware gives calls without a deadline one, so a server
+// that never answers doesn't hold the caller forever.
+func clientDeadlineMiddleware(timeout time.Duration) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			if _, ok := ctx.Deadline(); !ok {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// clientRetryMiddleware retries calls the server couldn't serve for now,
+// waiting as long as it asked to when it was rate-limited. Only calls that
+// clientRetryable says are safe to make again are retried.
+func clientRetryMiddleware(methodName string, settings ClientSettings) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			if !clientRetryable(methodName, request) {
+				return next(ctx, request)
+			}
+
+			for attempt := 0; ; attempt++ {
+				response, err := next(ctx, request)
+				if err == nil || attempt >= settings.Retries || ctx.Err() != nil {
+					return response, err
+				}
+
+				var wait time.Duration
+				switch e := err.(type) {
+				case *srv.UnavailableError:
+					wait = srv.Backoff(attempt+1, settings.RetryBase, settings.RetryMax)
+				case *srv.RateLimitError:
+					wait = e.RetryAfter
+					if wait == 0 {
+						wait = srv.Backoff(attempt+1, settings.RetryBase, settings.RetryMax)
+					}
+				default:
+					return response, err
+				}
+
+				if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+					return response, err
+				}
+
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+					return response, err
+				}
+			}
+		}
+	}
+}
+
+// clientRetryable tells whether a call can be made again without harm, which
+// is the case of every method that only reads. A cancellation never is, and a
+// booking only when it has an idempotency key: the server holds on to the key
+// of a booking it can't tell the outcome of, so a retry can't book twice.
+func clientRetryable(methodName string, request interface{}) bool {
+	switch methodName {
+	case "CancelFlightOrder":
+		return false
+	case "CreateFlightOrder":
+		req, ok := request.(*srv.CreateFlightOrderRequest)
+		return ok && req.IdempotencyKey != ""
+	default:
+		return true
+	}
+}
+
+// clientErrorMiddleware turns the status of a failed call back into the
+// typed error the service returned.
+func clientErrorMiddleware(methodName string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			response, err := next(ctx, request)
+			if err != nil {
+				return nil, decodeError(methodName, err)
+			}
+			return response, nil
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package transports
+
+import (
+	sv "amadeus-go/pkg/services"
+
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// openAPIDocument describes routes in OpenAPI 3. It is built from the same
+// route table the gateway serves, so the two can't disagree.
+func openAPIDocument(routes []httpRoute) map[string]interface{} {
+	schemas := make(map[string]interface{})
+	errorsRef := map[string]interface{}{"$ref": "#/components/schemas/Errors"}
+	schemas["Errors"] = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"errors": map[string]interface{}{
+				"type":  "array",
+				"items": schemaOf(reflect.TypeOf(sv.ErrorWarning{}), schemas),
+			},
+		},
+	}
+
+	errorResponse := func(description string) map[string]interface{} {
+		return map[string]interface{}{
+			"description": description,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{"schema": errorsRef},
+			},
+		}
+	}
+
+	// endpoints served by both a GET and a POST route need an operation each
+	names := make(map[string]int)
+	for _, route := range routes {
+		names[route.Name]++
+	}
+
+	paths := make(map[string]interface{})
+	for _, route := range routes {
+		required := make(map[string]bool)
+		for _, name := range route.Required {
+			required[name] = true
+		}
+
+		operation := map[string]interface{}{
+			"operationId": route.Name,
+			"summary":     route.Summary,
+		}
+
+		typ := reflect.TypeOf(route.Request).Elem()
+		if route.Method == http.MethodPost {
+			if names[route.Name] > 1 {
+				operation["operationId"] = route.Name + "Post"
+			}
+			operation["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{"schema": schemaOf(typ, schemas)},
+				},
+			}
+		} else {
+			var params []interface{}
+			for i := 0; i < typ.NumField(); i++ {
+				if !queryable(typ.Field(i)) {
+					continue
+				}
+				name := queryName(typ.Field(i))
+				params = append(params, map[string]interface{}{
+					"name":     name,
+					"in":       "query",
+					"required": required[name],
+					"schema":   schemaOf(typ.Field(i).Type, schemas),
+				})
+			}
+			operation["parameters"] = params
+		}
+
+		operation["responses"] = map[string]interface{}{
+			"200": map[string]interface{}{
+				"description": "what Amadeus answered",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{"schema": schemaOf(reflect.TypeOf(route.Response), schemas)},
+				},
+			},
+			"400": errorResponse("the request has invalid or missing parameters"),
+			"401": errorResponse("the request has no API key or JWT, or an unknown one"),
+			"404": errorResponse("nothing matches the request"),
+			"429": errorResponse("too many requests, see the Retry-After header"),
+			"502": errorResponse("Amadeus failed to answer the request"),
+			"503": errorResponse("Amadeus is unavailable"),
+			"504": errorResponse("Amadeus didn't answer in time"),
+		}
+
+		item, ok := paths[route.Path].(map[string]interface{})
+		if !ok {
+			item = make(map[string]interface{})
+			paths[route.Path] = item
+		}
+		item[strings.ToLower(route.Method)] = operation
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "amadeus-go",
+			"version": "v1",
+		},
+		"paths": paths,
+		"security": []interface{}{
+			map[string]interface{}{"apiKey": []interface{}{}},
+			map[string]interface{}{"bearer": []interface{}{}},
+		},
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"securitySchemes": map[string]interface{}{
+				"apiKey": map[string]interface{}{"type": "apiKey", "in": "header", "name": "X-API-Key"},
+				"bearer": map[string]interface{}{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+	}
+}
+
+// schemaOf returns the schema of typ. Structs are added to schemas under
+// their name, with their JSON field names, and referred to.
+func schemaOf(typ reflect.Type, schemas map[string]interface{}) map[string]interface{} {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int32:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Int, reflect.Int64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Float32:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": schemaOf(typ.Elem(), schemas)}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": schemaOf(typ.Elem(), schemas)}
+	case reflect.Struct:
+		ref := map[string]interface{}{"$ref": "#/components/schemas/" + typ.Name()}
+		if _, ok := schemas[typ.Name()]; ok {
+			return ref
+		}
+
+		properties := make(map[string]interface{})
+		schema := map[string]interface{}{"type": "object", "properties": properties}
+		// registered before the fields so types that refer to themselves end
+		schemas[typ.Name()] = schema
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			properties[name] = schemaOf(field.Type, schemas)
+		}
+		return ref
+	default:
+		return map[string]interface{}{}
+	}
+}
+
+func openAPIHandler(routes []httpRoute) http.Handler {
+	doc, err := json.Marshal(openAPIDocument(routes))
+	if err != nil {
+		panic(err)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_, _ = w.Write(doc)
+	})
+}
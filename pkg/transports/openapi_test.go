@@ -0,0 +1,163 @@
+package transports
+
+import (
+	"amadeus-go/pkg/endpoints"
+
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+)
+
+// stubEndpoints returns an endpoint set whose endpoints all answer with an
+// empty response, and remember the request they were last called with.
+func stubEndpoints(last *interface{}) *endpoints.AmadeusEndpointSet {
+	set := &endpoints.AmadeusEndpointSet{}
+	stub := endpoint.Endpoint(func(_ context.Context, request interface{}) (interface{}, error) {
+		*last = request
+		return struct{}{}, nil
+	})
+
+	v := reflect.ValueOf(set).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).Type() == reflect.TypeOf(stub) {
+			v.Field(i).Set(reflect.ValueOf(stub))
+		}
+	}
+	return set
+}
+
+// operations returns the operations of the OpenAPI document of routes, by
+// path and lower case method.
+func operations(t *testing.T, routes []httpRoute) map[string]map[string]map[string]interface{} {
+	paths, ok := openAPIDocument(routes)["paths"].(map[string]interface{})
+	if !ok {
+		t.Fatal("the document has no paths")
+	}
+
+	ops := make(map[string]map[string]map[string]interface{})
+	for path, item := range paths {
+		ops[path] = make(map[string]map[string]interface{})
+		for method, op := range item.(map[string]interface{}) {
+			ops[path][method] = op.(map[string]interface{})
+		}
+	}
+	return ops
+}
+
+func TestOpenAPIMatchesHandler(t *testing.T) {
+	var last interface{}
+	set := stubEndpoints(&last)
+	routes := httpRoutes(set)
+	ops := operations(t, routes)
+	handler := NewHTTPHandler(set, log.NewNopLogger())
+
+	// every route is in the document, once
+	count := 0
+	for _, byMethod := range ops {
+		count += len(byMethod)
+	}
+	if count != len(routes) {
+		t.Errorf("the document has %d operations for %d routes", count, len(routes))
+	}
+	for _, route := range routes {
+		if _, ok := ops[route.Path][strings.ToLower(route.Method)]; !ok {
+			t.Errorf("%s %s is served but not documented", route.Method, route.Path)
+		}
+	}
+
+	// every documented operation is served, and no other method of its path
+	for path, byMethod := range ops {
+		for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete} {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(method, path, strings.NewReader("{}")))
+
+			_, documented := byMethod[strings.ToLower(method)]
+			switch {
+			case documented && (w.Code == http.StatusNotFound || w.Code == http.StatusMethodNotAllowed):
+				t.Errorf("%s %s is documented but answered %d", method, path, w.Code)
+			case !documented && w.Code != http.StatusMethodNotAllowed:
+				t.Errorf("%s %s isn't documented but answered %d", method, path, w.Code)
+			}
+		}
+	}
+}
+
+func TestOpenAPIParametersMatchRequests(t *testing.T) {
+	var last interface{}
+	set := stubEndpoints(&last)
+	routes := httpRoutes(set)
+	ops := operations(t, routes)
+	handler := NewHTTPHandler(set, log.NewNopLogger())
+
+	for _, route := range routes {
+		if route.Method == http.MethodPost {
+			continue
+		}
+		op := ops[route.Path][strings.ToLower(route.Method)]
+		typ := reflect.TypeOf(route.Request).Elem()
+
+		// the parameters are the fields of the request that fit in a query
+		var want []string
+		for i := 0; i < typ.NumField(); i++ {
+			if queryable(typ.Field(i)) {
+				want = append(want, queryName(typ.Field(i)))
+			}
+		}
+
+		var got []string
+		required := make(map[string]bool)
+		query := url.Values{}
+		params, _ := op["parameters"].([]interface{})
+		for _, p := range params {
+			param := p.(map[string]interface{})
+			name := param["name"].(string)
+			got = append(got, name)
+			required[name] = param["required"].(bool)
+
+			switch param["schema"].(map[string]interface{})["type"] {
+			case "integer":
+				query.Set(name, "1")
+			case "number":
+				query.Set(name, "1.5")
+			case "boolean":
+				query.Set(name, "true")
+			default:
+				query.Set(name, "x")
+			}
+		}
+
+		sort.Strings(want)
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s %s documents parameters %v, %s has %v", route.Method, route.Path, got, typ.Name(), want)
+		}
+		for _, name := range route.Required {
+			if !required[name] {
+				t.Errorf("%s %s requires %s, which isn't a required parameter", route.Method, route.Path, name)
+			}
+		}
+
+		// and each of them makes it into the request the endpoint gets
+		last = nil
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(route.Method, route.Path+"?"+query.Encode(), nil))
+		if w.Code != http.StatusOK {
+			t.Errorf("%s %s with every parameter answered %d: %s", route.Method, route.Path, w.Code, w.Body)
+			continue
+		}
+		request := reflect.ValueOf(last).Elem()
+		for i := 0; i < typ.NumField(); i++ {
+			if queryable(typ.Field(i)) && request.Field(i).IsZero() {
+				t.Errorf("%s %s: parameter %s wasn't decoded into %s.%s", route.Method, route.Path, queryName(typ.Field(i)), typ.Name(), typ.Field(i).Name)
+			}
+		}
+	}
+}
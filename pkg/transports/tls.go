@@ -0,0 +1,227 @@
+package transports
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// certificates are looked at again on a handshake once this long has passed,
+// and reloaded if a file changed
+const certCheckInterval = time.Second * 10
+
+type TLSSettings struct {
+	// CertFile and KeyFile hold the certificate presented to the other side.
+	// They are required for a server, and for a client of a server that
+	// verifies client certificates.
+	CertFile string
+	KeyFile  string
+	// CAFile holds the CA bundle the other side's certificate is verified
+	// with. On a server, setting it requires clients to present a certificate
+	// (mutual TLS). On a client, the system roots are used when it is empty.
+	CAFile string
+	// ServerName overrides the name the server certificate is checked against
+	ServerName string
+}
+
+// ServerCredentials returns the transport credentials of a server. Rotated
+// certificates and CA bundles are picked up without a restart.
+func ServerCredentials(settings TLSSettings) (credentials.TransportCredentials, error) {
+	if settings.CertFile == "" || settings.KeyFile == "" {
+		return nil, errors.New("tls: a server needs both a certificate and a key")
+	}
+
+	keyPair, err := newKeyPairReloader(settings.CertFile, settings.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return keyPair.get()
+		},
+	}
+
+	if settings.CAFile != "" {
+		clientCAs, err := newCAReloader(settings.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		// the CA bundle is only read from the config of each connection
+		config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			pool, err := clientCAs.get()
+			if err != nil {
+				return nil, err
+			}
+
+			c := config.Clone()
+			c.GetConfigForClient = nil
+			c.ClientCAs = pool
+			c.ClientAuth = tls.RequireAndVerifyClientCert
+			return c, nil
+		}
+	}
+
+	return credentials.NewTLS(config), nil
+}
+
+// ClientCredentials returns the transport credentials of a client.
+func ClientCredentials(settings TLSSettings) (credentials.TransportCredentials, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: settings.ServerName,
+	}
+
+	if settings.CAFile != "" {
+		pem, err := ioutil.ReadFile(settings.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("tls: no certificate found in %s", settings.CAFile)
+		}
+	}
+
+	if settings.CertFile != "" || settings.KeyFile != "" {
+		keyPair, err := newKeyPairReloader(settings.CertFile, settings.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return keyPair.get()
+		}
+	}
+
+	return credentials.NewTLS(config), nil
+}
+
+// fileReloader holds what was loaded from a set of files, loading it again
+// when one of them was modified since.
+type fileReloader struct {
+	files []string
+	load  func() (interface{}, error)
+
+	mu        sync.Mutex
+	value     interface{}
+	modTimes  []time.Time
+	checkedAt time.Time
+}
+
+func newFileReloader(load func() (interface{}, error), files ...string) (*fileReloader, error) {
+	r := &fileReloader{files: files, load: load}
+
+	var err error
+	r.modTimes, err = r.stat()
+	if err != nil {
+		return nil, err
+	}
+	r.value, err = load()
+	if err != nil {
+		return nil, err
+	}
+	r.checkedAt = time.Now()
+
+	return r, nil
+}
+
+// get returns the current value. A reload that fails keeps the previous one,
+// since a certificate is often rotated one file at a time.
+func (r *fileReloader) get() interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) < certCheckInterval {
+		return r.value
+	}
+	r.checkedAt = time.Now()
+
+	modTimes, err := r.stat()
+	if err != nil || !r.changed(modTimes) {
+		return r.value
+	}
+
+	value, err := r.load()
+	if err != nil {
+		return r.value
+	}
+	r.value, r.modTimes = value, modTimes
+	return r.value
+}
+
+func (r *fileReloader) stat() ([]time.Time, error) {
+	var modTimes []time.Time
+	for _, f := range r.files {
+		info, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+	return modTimes, nil
+}
+
+func (r *fileReloader) changed(modTimes []time.Time) bool {
+	for i := range modTimes {
+		if !modTimes[i].Equal(r.modTimes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+type keyPairReloader struct{ *fileReloader }
+
+func newKeyPairReloader(certFile, keyFile string) (*keyPairReloader, error) {
+	r, err := newFileReloader(func() (interface{}, error) {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		return &cert, nil
+	}, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &keyPairReloader{r}, nil
+}
+
+func (r *keyPairReloader) get() (*tls.Certificate, error) {
+	return r.fileReloader.get().(*tls.Certificate), nil
+}
+
+type caReloader struct{ *fileReloader }
+
+func newCAReloader(caFile string) (*caReloader, error) {
+	r, err := newFileReloader(func() (interface{}, error) {
+		pem, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("tls: no certificate found in %s", caFile)
+		}
+		return pool, nil
+	}, caFile)
+	if err != nil {
+		return nil, err
+	}
+	return &caReloader{r}, nil
+}
+
+func (r *caReloader) get() (*x509.CertPool, error) {
+	return r.fileReloader.get().(*x509.CertPool), nil
+}
@@ -0,0 +1,216 @@
+package transports
+
+import (
+	pbFunc "amadeus-go/api/amadeus/func"
+	"amadeus-go/pkg/endpoints"
+	"amadeus-go/pkg/instrumenting"
+	sv "amadeus-go/pkg/services"
+	"amadeus-go/pkg/tracing"
+
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	remoteTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	remoteSpanID  = "00f067aa0ba902b7"
+	traceparent   = "00-" + remoteTraceID + "-" + remoteSpanID + "-01"
+)
+
+// tracedService is the whole stack, from the endpoints down to a fake
+// Amadeus, traced into an in-memory exporter.
+type tracedService struct {
+	exporter  *tracetest.InMemoryExporter
+	provider  *sdktrace.TracerProvider
+	endpoints *endpoints.AmadeusEndpointSet
+
+	mu          sync.Mutex
+	traceparent string // the one Amadeus got last
+}
+
+func newTracedService(t *testing.T) *tracedService {
+	ts := &tracedService{exporter: tracetest.NewInMemoryExporter()}
+	ts.provider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(ts.exporter))
+	tracer := tracing.Tracer(ts.provider)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/security/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"token_type":"Bearer","access_token":"token","expires_in":1800}`))
+	})
+	mux.HandleFunc("/v1/reference-data/airlines", func(w http.ResponseWriter, r *http.Request) {
+		ts.mu.Lock()
+		ts.traceparent = r.Header.Get("traceparent")
+		ts.mu.Unlock()
+		_, _ = w.Write([]byte(`{"data":[{"type":"airline","iataCode":"BA"}]}`))
+	})
+	amadeus := httptest.NewServer(mux)
+	t.Cleanup(amadeus.Close)
+
+	dir := t.TempDir()
+	configFilename := filepath.Join(dir, "config.json")
+	urlsFilename := filepath.Join(dir, "API-urls.json")
+	if err := os.WriteFile(configFilename, []byte(`{"API_KEY":"key","API_SECRET":"secret"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	urls := fmt.Sprintf(`{"ApiBaseUrl":%q,"AuthUrl":"/v1/security/oauth2/token","AirlineCodeLookup":"/v1/reference-data/airlines"}`, amadeus.URL)
+	if err := os.WriteFile(urlsFilename, []byte(urls), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	logger := log.NewNopLogger()
+	metrics := instrumenting.DiscardMetrics()
+	srv, lifecycle, err := sv.NewUnregisteredService(configFilename, urlsFilename, logger, metrics, tracer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = lifecycle.Close() })
+
+	ts.endpoints = endpoints.NewEndpointSet(srv, logger, metrics, tracer, nil, nil)
+	return ts
+}
+
+// spans returns the spans ended so far by name, failing unless there is
+// exactly one of each of names.
+func (ts *tracedService) spans(t *testing.T, names ...string) map[string]tracetest.SpanStub {
+	byName := make(map[string]tracetest.SpanStub)
+	count := make(map[string]int)
+	for _, span := range ts.exporter.GetSpans() {
+		byName[span.Name] = span
+		count[span.Name]++
+	}
+	for _, name := range names {
+		if count[name] != 1 {
+			t.Fatalf("got %d %s spans, want one", count[name], name)
+		}
+	}
+	return byName
+}
+
+func assertChild(t *testing.T, child, parent tracetest.SpanStub) {
+	t.Helper()
+	if child.SpanContext.TraceID() != parent.SpanContext.TraceID() || child.Parent.SpanID() != parent.SpanContext.SpanID() {
+		t.Errorf("%s isn't a child of %s", child.Name, parent.Name)
+	}
+}
+
+// assertContinued checks span continues the trace of traceparent.
+func assertContinued(t *testing.T, span tracetest.SpanStub) {
+	t.Helper()
+	if span.SpanContext.TraceID().String() != remoteTraceID {
+		t.Errorf("%s is in trace %s, want %s", span.Name, span.SpanContext.TraceID(), remoteTraceID)
+	}
+	if span.Parent.SpanID().String() != remoteSpanID || !span.Parent.IsRemote() {
+		t.Errorf("%s has parent %s, want the remote span %s", span.Name, span.Parent.SpanID(), remoteSpanID)
+	}
+}
+
+func TestTraceSpanHierarchy(t *testing.T) {
+	ts := newTracedService(t)
+
+	_, err := ts.endpoints.AirlineCodeLookupEndpoint(context.Background(), &sv.AirlineCodeLookupRequest{AirlineCodes: "BA"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spans := ts.spans(t, "endpoint.AirlineCodeLookup", "service.AirlineCodeLookup", "amadeus.AirlineCodeLookup")
+	ep, service, client := spans["endpoint.AirlineCodeLookup"], spans["service.AirlineCodeLookup"], spans["amadeus.AirlineCodeLookup"]
+
+	if ep.Parent.IsValid() {
+		t.Errorf("the endpoint span has parent %s, want a new trace", ep.Parent.SpanID())
+	}
+	assertChild(t, service, ep)
+	assertChild(t, client, service)
+	if client.SpanKind != trace.SpanKindClient {
+		t.Errorf("the amadeus span is a %s span, want a client one", client.SpanKind)
+	}
+
+	// and Amadeus is told about the client span
+	want := fmt.Sprintf("00-%s-%s-01", client.SpanContext.TraceID(), client.SpanContext.SpanID())
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.traceparent != want {
+		t.Errorf("Amadeus got traceparent %q, want %q", ts.traceparent, want)
+	}
+}
+
+func TestTraceContinuedFromHTTPHeaders(t *testing.T) {
+	ts := newTracedService(t)
+	server := httptest.NewServer(NewHTTPHandler(ts.endpoints, log.NewNopLogger()))
+	t.Cleanup(server.Close)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/v1/airlines?airlineCodes=BA", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("traceparent", traceparent)
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status %d", resp.StatusCode)
+	}
+
+	spans := ts.spans(t, "endpoint.AirlineCodeLookup", "service.AirlineCodeLookup", "amadeus.AirlineCodeLookup")
+	assertContinued(t, spans["endpoint.AirlineCodeLookup"])
+	assertChild(t, spans["service.AirlineCodeLookup"], spans["endpoint.AirlineCodeLookup"])
+	assertChild(t, spans["amadeus.AirlineCodeLookup"], spans["service.AirlineCodeLookup"])
+}
+
+func TestTraceContinuedFromGRPCMetadata(t *testing.T) {
+	ts := newTracedService(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// as cmd/srv sets the server up
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		otelgrpc.UnaryServerInterceptor(
+			otelgrpc.WithTracerProvider(ts.provider),
+			otelgrpc.WithPropagators(tracing.Propagator),
+		),
+	))
+	pbFunc.RegisterAmadeusServiceServer(server, NewGRPCServer(ts.endpoints, log.NewNopLogger()))
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "traceparent", traceparent)
+	_, err = pbFunc.NewAmadeusServiceClient(conn).AirlineCodeLookup(ctx, &pbFunc.AirlineCodeLookupRequest{AirlineCodes: "BA"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spans := ts.spans(t, "amadeus.func.AmadeusService/AirlineCodeLookup", "endpoint.AirlineCodeLookup", "service.AirlineCodeLookup", "amadeus.AirlineCodeLookup")
+	rpc := spans["amadeus.func.AmadeusService/AirlineCodeLookup"]
+	assertContinued(t, rpc)
+	if rpc.SpanKind != trace.SpanKindServer {
+		t.Errorf("the rpc span is a %s span, want a server one", rpc.SpanKind)
+	}
+	assertChild(t, spans["endpoint.AirlineCodeLookup"], rpc)
+	assertChild(t, spans["service.AirlineCodeLookup"], spans["endpoint.AirlineCodeLookup"])
+	assertChild(t, spans["amadeus.AirlineCodeLookup"], spans["service.AirlineCodeLookup"])
+}